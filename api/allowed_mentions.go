@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import "reflect"
+
+// SafeAllowedMentions - Returns an AllowedMentions that only pings users mentioned in the content, never @everyone, @here or roles
+//
+//goland:noinspection GoUnusedExportedFunction
+func SafeAllowedMentions() *AllowedMentions {
+	users := UserMentions
+
+	return &AllowedMentions{Parse: []*AllowedMentionType{&users}}
+}
+
+// WithDefaultAllowedMentions - Applies the AllowedMentions to every message, webhook and interaction payload sent through the RateLimiter that does not set its own
+//
+// An explicit AllowedMentions on a payload always wins; nil removes the default. Set it before issuing requests, e.g.
+//
+//	api.Rest.WithDefaultAllowedMentions(api.SafeAllowedMentions())
+func (r *RateLimiter) WithDefaultAllowedMentions(allowedMentions *AllowedMentions) {
+	r.defaultAllowedMentions.Store(allowedMentions)
+}
+
+// allowedMentionsDefaulter - a payload that can carry AllowedMentions; the method returns a copy with the default filled in when the payload has none
+type allowedMentionsDefaulter interface {
+	withDefaultAllowedMentions(allowedMentions *AllowedMentions) any
+}
+
+// applyDefaultAllowedMentions - fills in the default AllowedMentions on a copy of the payload, leaving the caller's payload untouched
+func (r *RateLimiter) applyDefaultAllowedMentions(payload any) any {
+	allowedMentions := r.defaultAllowedMentions.Load()
+	if allowedMentions == nil {
+		return payload
+	}
+
+	defaulter, ok := payload.(allowedMentionsDefaulter)
+	if !ok {
+		return payload
+	}
+	if v := reflect.ValueOf(payload); v.Kind() == reflect.Pointer && v.IsNil() {
+		return payload
+	}
+
+	return defaulter.withDefaultAllowedMentions(allowedMentions)
+}
+
+func (p CreateMessageJSON) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.AllowedMentions == nil {
+		p.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p EditMessageJSON) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.AllowedMentions == nil {
+		p.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p StartThreadInForumJSON) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.Message.AllowedMentions == nil {
+		p.Message.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p ExecuteWebhookJSON) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.AllowedMentions == nil {
+		p.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p EditWebhookMessageJSON) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.AllowedMentions == nil {
+		p.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p InteractionCallbackDataMessages) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.AllowedMentions == nil {
+		p.AllowedMentions = allowedMentions
+	}
+
+	return p
+}
+
+func (p InteractionResponseMessages) withDefaultAllowedMentions(allowedMentions *AllowedMentions) any {
+	if p.Data != nil && p.Data.AllowedMentions == nil {
+		data := *p.Data
+		data.AllowedMentions = allowedMentions
+		p.Data = &data
+	}
+
+	return p
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestApplyDefaultAllowedMentions(t *testing.T) {
+	r := NewRatelimiter()
+	r.WithDefaultAllowedMentions(SafeAllowedMentions())
+
+	explicit := &AllowedMentions{}
+	var nilEdit *EditWebhookMessageJSON
+
+	tests := []struct {
+		name    string
+		payload any
+		want    string
+	}{
+		{"message without mentions", CreateMessageJSON{Content: "@everyone"}, `{"content":"@everyone","allowed_mentions":{"parse":["users"]}}`},
+		{"message with mentions", CreateMessageJSON{Content: "@everyone", AllowedMentions: explicit}, `{"content":"@everyone","allowed_mentions":{"parse":null}}`},
+		{"webhook pointer", &ExecuteWebhookJSON{Content: "hi", AllowedMentions: nil}, `{"content":"hi","embeds":null,"allowed_mentions":{"parse":["users"]},"payload_json":""}`},
+		{"interaction response", &InteractionResponseMessages{Type: ChannelMessageWithSource, Data: &InteractionCallbackDataMessages{Content: "hi"}}, `{"type":4,"data":{"content":"hi","allowed_mentions":{"parse":["users"]}}}`},
+		{"interaction response without data", InteractionResponseMessages{Type: DeferredChannelMessageWithSource}, `{"type":5}`},
+		{"nil pointer", nilEdit, `null`},
+		{"other payload", ModifyThreadJSON{}, `{}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(r.applyDefaultAllowedMentions(tt.payload))
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("applyDefaultAllowedMentions() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyDefaultAllowedMentionsLeavesPayloadUntouched(t *testing.T) {
+	r := NewRatelimiter()
+	r.WithDefaultAllowedMentions(SafeAllowedMentions())
+
+	payload := &InteractionResponseMessages{Type: ChannelMessageWithSource, Data: &InteractionCallbackDataMessages{Content: "hi"}}
+	r.applyDefaultAllowedMentions(payload)
+
+	if payload.Data.AllowedMentions != nil {
+		t.Errorf("applyDefaultAllowedMentions() modified the caller's payload")
+	}
+}
+
+func TestFireMultipartUsesLimiterDefaults(t *testing.T) {
+	r := NewRatelimiter()
+	r.WithDefaultAllowedMentions(SafeAllowedMentions())
+
+	var sent string
+	r.Use(func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			sent = string(body)
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"id":"2"}`))}, nil
+		}
+	})
+
+	u := parseRoute(api + "/channels/1/messages")
+	files := []*File{{Name: "notes.txt", Reader: strings.NewReader("notes")}}
+	if _, err := r.fireMultipart(http.MethodPost, u, CreateMessageJSON{Content: "@everyone"}, files, DefaultMaxUploadSize, nil); err != nil {
+		t.Fatalf("fireMultipart() error = %v", err)
+	}
+	if !strings.Contains(sent, `"allowed_mentions":{"parse":["users"]}`) {
+		t.Errorf("fireMultipart() sent %s, want the RateLimiter's default allowed_mentions", sent)
+	}
+}
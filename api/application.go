@@ -40,12 +40,15 @@ type Application struct {
 	CoverImage                     string           `json:"cover_image,omitempty"`                       // App's default rich presence invite cover image hash
 	Flags                          ApplicationFlags `json:"flags,omitempty"`                             // App's public flags
 	ApproximateGuildCount          int64            `json:"approximate_guild_count,omitempty"`           // Approximate count of guilds the app has been added to
+	ApproximateUserInstallCount    int64            `json:"approximate_user_install_count,omitempty"`    // Approximate count of users that have installed the app
 	RedirectUris                   []string         `json:"redirect_uris,omitempty"`                     // Array of redirect URIs for the app
 	InteractionsEndpointUrl        string           `json:"interactions_endpoint_url,omitempty"`         // Interactions endpoint URL for the app
 	RoleConnectionsVerificationURL string           `json:"role_connections_verification_url,omitempty"` // Role connection verification URL for the app
 	Tags                           []string         `json:"tags,omitempty"`                              // List of tags describing the content and functionality of the app. Max of 5 tags.
 	InstallParams                  InstallParams    `json:"install_params,omitempty"`                    // Settings for the app's default in-app authorization link, if enabled
 	CustomInstallURL               string           `json:"custom_install_url,omitempty"`                // Default custom authorization URL for the app, if enabled
+
+	IntegrationTypesConfig map[ApplicationIntegrationType]*ApplicationIntegrationTypeConfiguration `json:"integration_types_config,omitempty"` // Default scopes and permissions for each supported installation context
 }
 
 // ApplicationFlags - the application's public ApplicationFlags
@@ -65,6 +68,20 @@ const (
 	ApplicationCommandBadge                  ApplicationFlags = 1 << 23 // Indicates if an app has registered global application commands
 )
 
+// ApplicationIntegrationType - where an app can be installed, also called its supported installation contexts
+type ApplicationIntegrationType int
+
+//goland:noinspection GoUnusedConst
+const (
+	GuildInstall ApplicationIntegrationType = iota // App is installable to servers
+	UserInstall                                    // App is installable to users
+)
+
+// ApplicationIntegrationTypeConfiguration - the default install settings for one installation context
+type ApplicationIntegrationTypeConfiguration struct {
+	OAuth2InstallParams *InstallParams `json:"oauth2_install_params,omitempty"` // Install params for each installation context's default in-app authorization link
+}
+
 // InstallParams - settings for the application's default in-app authorization link, if enabled
 type InstallParams struct {
 	Scopes      []*oauth2.Scopes `json:"scopes"`      // Scopes to add the application to the server with
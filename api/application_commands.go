@@ -30,16 +30,16 @@ import (
 type ApplicationCommand struct {
 	ID                       Snowflake                   `json:"id,omitempty"`                         // unique id of the command
 	Type                     ApplicationCommandType      `json:"type,omitempty"`                       // the type of command, defaults 1 if not set
-	ApplicationID            Snowflake                   `json:"application_id"`                       // unique id of the parent application
+	ApplicationID            Snowflake                   `json:"application_id,omitempty"`             // unique id of the parent application
 	GuildID                  Snowflake                   `json:"guild_id,omitempty"`                   // guild id of the command, if not global
 	Name                     string                      `json:"name"`                                 // max 32 chars, must follow ^[\w-]{1,32}$ regex
-	NameLocalizations        *LocalizationDict           `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
+	NameLocalizations        LocalizationMap             `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
 	Description              string                      `json:"description"`                          // 1-100 character description for CHAT_INPUT command, empty string for USER and MESSAGE command
-	DescriptionLocalizations *LocalizationDict           `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
+	DescriptionLocalizations LocalizationMap             `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
 	Options                  []*ApplicationCommandOption `json:"options,omitempty"`                    // the parameters for the command, max 25; CHAT_INPUT
 	DefaultMemberPermissions *string                     `json:"default_member_permissions,omitempty"` // Set of permissions represented as a bit set
 	DmPermission             *bool                       `json:"dm_permission,omitempty"`              // Indicates whether the command is available in DMs with the app, only for globally-scoped commands. By default, commands are visible.
-	Version                  Snowflake                   `json:"version"`                              // autoincrementing version identifier updated during substantial record changes
+	Version                  Snowflake                   `json:"version,omitempty"`                    // autoincrementing version identifier updated during substantial record changes
 }
 
 // ApplicationCommandType - The type of application command
@@ -56,9 +56,9 @@ const (
 type ApplicationCommandOption struct {
 	Type                     ApplicationCommandOptionType      `json:"type"`                                // the type of option
 	Name                     string                            `json:"name"`                                // 1-32 character name
-	NameLocalizations        *LocalizationDict                 `json:"name_localizations,omitempty"`        // Localization dictionary for the name field. Values follow the same restrictions as name
+	NameLocalizations        LocalizationMap                   `json:"name_localizations,omitempty"`        // Localization dictionary for the name field. Values follow the same restrictions as name
 	Description              string                            `json:"description"`                         // 1-100 character description
-	DescriptionLocalizations *LocalizationDict                 `json:"description_localizations,omitempty"` // Localization dictionary for the description field. Values follow the same restrictions as description
+	DescriptionLocalizations LocalizationMap                   `json:"description_localizations,omitempty"` // Localization dictionary for the description field. Values follow the same restrictions as description
 	Required                 bool                              `json:"required,omitempty"`                  // if the parameter is required or optional--default `false`
 	Choices                  []*ApplicationCommandOptionChoice `json:"choices,omitempty"`                   // choices for STRING, INTEGER, and NUMBER types for the user to pick from, max 25
 	Options                  []*ApplicationCommandOption       `json:"options,omitempty"`                   // if the option is a subcommand or subcommand group type, these nested options will be the parameters
@@ -90,9 +90,47 @@ const (
 
 // ApplicationCommandOptionChoice - If you specify choices for an option, they are the only valid values for a user to pick
 type ApplicationCommandOptionChoice struct {
-	Name              string            `json:"name"`                         // 1-100 character choice name
-	NameLocalizations *LocalizationDict `json:"name_localizations,omitempty"` // Localization dictionary for the name field. Values follow the same restrictions as name
-	Value             any               `json:"value"`                        // value of the choice, up to 100 characters if string
+	Name              string          `json:"name"`                         // 1-100 character choice name
+	NameLocalizations LocalizationMap `json:"name_localizations,omitempty"` // Localization dictionary for the name field. Values follow the same restrictions as name
+	Value             any             `json:"value"`                        // value of the choice, up to 100 characters if string
+}
+
+// Localization limits, matching the fields they translate
+const (
+	maxCommandNameLength        = 32
+	maxCommandDescriptionLength = 100
+	maxChoiceNameLength         = 100
+)
+
+// validateCommandLocalizations - Checks the localizations of a command and all of its options and choices
+func validateCommandLocalizations(nameLocalizations, descriptionLocalizations LocalizationMap, options []*ApplicationCommandOption) error {
+	if err := nameLocalizations.Validate(maxCommandNameLength); err != nil {
+		return err
+	}
+	if err := descriptionLocalizations.Validate(maxCommandDescriptionLength); err != nil {
+		return err
+	}
+
+	for _, option := range options {
+		if option == nil {
+			continue
+		}
+
+		for _, choice := range option.Choices {
+			if choice == nil {
+				continue
+			}
+			if err := choice.NameLocalizations.Validate(maxChoiceNameLength); err != nil {
+				return err
+			}
+		}
+
+		if err := validateCommandLocalizations(option.NameLocalizations, option.DescriptionLocalizations, option.Options); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GuildApplicationCommandPermissions - Returned when fetching the permissions for a command in a guild.
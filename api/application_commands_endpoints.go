@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -42,7 +41,7 @@ func GetGlobalApplicationCommands(applicationID Snowflake, withLocalizations boo
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -58,6 +57,10 @@ func CreateGlobalApplicationCommand(applicationID Snowflake, payload CreateAppli
 	*ApplicationCommand,
 	error,
 ) {
+	if err := validateCommandLocalizations(payload.NameLocalizations, payload.DescriptionLocalizations, payload.Options); err != nil {
+		return nil, err
+	}
+
 	u := parseRoute(fmt.Sprintf(createGlobalApplicationCommand, api, applicationID.String()))
 
 	var command *ApplicationCommand
@@ -67,7 +70,7 @@ func CreateGlobalApplicationCommand(applicationID Snowflake, payload CreateAppli
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &command)
+	err = decodeResponse(u, responseBytes, &command)
 
 	return command, err
 }
@@ -75,9 +78,9 @@ func CreateGlobalApplicationCommand(applicationID Snowflake, payload CreateAppli
 // CreateApplicationCommandJSON - JSON payload structure
 type CreateApplicationCommandJSON struct {
 	Name                     string                      `json:"name"`                                 // 1-32 character name
-	NameLocalizations        *LocalizationDict           `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
+	NameLocalizations        LocalizationMap             `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
 	Description              string                      `json:"description"`                          // 1-100 character description
-	DescriptionLocalizations *LocalizationDict           `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
+	DescriptionLocalizations LocalizationMap             `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
 	Options                  []*ApplicationCommandOption `json:"options,omitempty"`                    // the parameters for the command
 	DefaultMemberPermissions *string                     `json:"default_member_permissions,omitempty"` // Set of permissions represented as a bit set
 	DmPermission             *bool                       `json:"dm_permission,omitempty"`              // Indicates whether the command is available in DMs with the app, only for globally-scoped commands. By default, commands are visible.
@@ -100,7 +103,7 @@ func (i *Interaction) GetGlobalApplicationCommand() (*ApplicationCommand, error)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -111,26 +114,44 @@ func (i *Interaction) GetGlobalApplicationCommand() (*ApplicationCommand, error)
 //
 //	All JSON parameters for this endpoint are optional.
 func (i *Interaction) EditGlobalApplicationCommand(payload EditApplicationCommandJSON) (*ApplicationCommand, error) {
-	u := parseRoute(fmt.Sprintf(editGlobalApplicationCommand, api, i.ApplicationID.String(), i.Data.ID.String()))
+	return EditGlobalApplicationCommand(i.ApplicationID, i.Data.ID.String(), payload)
+}
 
-	var commands *ApplicationCommand
+// EditGlobalApplicationCommand - Edit a global command by ID. Updates will be available in all guilds after 1 hour.
+//
+// Returns 200 and an application command object.
+//
+//	All JSON parameters for this endpoint are optional.
+//
+//goland:noinspection GoUnusedExportedFunction
+func EditGlobalApplicationCommand(applicationID Snowflake, commandID string, payload EditApplicationCommandJSON) (
+	*ApplicationCommand,
+	error,
+) {
+	if err := validateCommandLocalizations(payload.NameLocalizations, payload.DescriptionLocalizations, payload.Options); err != nil {
+		return nil, err
+	}
+
+	u := parseRoute(fmt.Sprintf(editGlobalApplicationCommand, api, applicationID.String(), commandID))
+
+	var command *ApplicationCommand
 	responseBytes, err := firePatchRequest(u, payload, nil)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &command)
 
-	return commands, err
+	return command, err
 }
 
 // EditApplicationCommandJSON - JSON payload structure
 type EditApplicationCommandJSON struct {
 	Name                     string                      `json:"name"`                                 // 1-32 character name
-	NameLocalizations        *LocalizationDict           `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
+	NameLocalizations        LocalizationMap             `json:"name_localizations,omitempty"`         // Localization dictionary for the name field. Values follow the same restrictions as name
 	Description              string                      `json:"description"`                          // 1-100 character description
-	DescriptionLocalizations *LocalizationDict           `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
+	DescriptionLocalizations LocalizationMap             `json:"description_localizations,omitempty"`  // Localization dictionary for the description field. Values follow the same restrictions as description
 	Options                  []*ApplicationCommandOption `json:"options,omitempty"`                    // the parameters for the command
 	DefaultMemberPermissions *string                     `json:"default_member_permissions,omitempty"` // Set of permissions represented as a bit set
 	DmPermission             *bool                       `json:"dm_permission,omitempty"`              // Indicates whether the command is available in DMs with the app, only for globally-scoped commands. By default, commands are visible.
@@ -159,6 +180,15 @@ func BulkOverwriteGlobalApplicationCommands(
 	applicationID *Snowflake,
 	payload []*ApplicationCommand,
 ) ([]*ApplicationCommand, error) {
+	for _, command := range payload {
+		if command == nil {
+			continue
+		}
+		if err := validateCommandLocalizations(command.NameLocalizations, command.DescriptionLocalizations, command.Options); err != nil {
+			return nil, err
+		}
+	}
+
 	u := parseRoute(fmt.Sprintf(bulkOverwriteGlobalApplicationCommands, api, applicationID.String()))
 
 	var commands []*ApplicationCommand
@@ -168,7 +198,7 @@ func BulkOverwriteGlobalApplicationCommands(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -190,7 +220,7 @@ func (i *Interaction) GetGuildApplicationCommands(withLocalizations bool) ([]*Ap
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -218,7 +248,7 @@ func GetGuildApplicationCommands(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -237,6 +267,12 @@ func CreateGuildApplicationCommand(
 	guildID *Snowflake,
 	payload *CreateApplicationCommandJSON,
 ) (*ApplicationCommand, error) {
+	if payload != nil {
+		if err := validateCommandLocalizations(payload.NameLocalizations, payload.DescriptionLocalizations, payload.Options); err != nil {
+			return nil, err
+		}
+	}
+
 	u := parseRoute(fmt.Sprintf(createGuildApplicationCommand, api, applicationID.String(), guildID.String()))
 
 	var command *ApplicationCommand
@@ -246,7 +282,7 @@ func CreateGuildApplicationCommand(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &command)
+	err = decodeResponse(u, responseBytes, &command)
 
 	return command, err
 }
@@ -270,7 +306,7 @@ func (i *Interaction) GetGuildApplicationCommand() (*ApplicationCommand, error)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &command)
+	err = decodeResponse(u, responseBytes, &command)
 
 	return command, err
 }
@@ -281,13 +317,33 @@ func (i *Interaction) GetGuildApplicationCommand() (*ApplicationCommand, error)
 //
 //	All parameters for this endpoint are optional.
 func (i *Interaction) EditGuildApplicationCommand(payload *EditApplicationCommandJSON) (*ApplicationCommand, error) {
+	return EditGuildApplicationCommand(&i.ApplicationID, &i.GuildID, i.Data.ID.String(), payload)
+}
+
+// EditGuildApplicationCommand - Edit a guild command by ID. Updates for guild commands will be available immediately.
+//
+// Returns 200 and an application command object.
+//
+//	All parameters for this endpoint are optional.
+//
+//goland:noinspection GoUnusedExportedFunction
+func EditGuildApplicationCommand(applicationID *Snowflake,
+	guildID *Snowflake,
+	commandID string,
+	payload *EditApplicationCommandJSON) (*ApplicationCommand, error) {
+	if payload != nil {
+		if err := validateCommandLocalizations(payload.NameLocalizations, payload.DescriptionLocalizations, payload.Options); err != nil {
+			return nil, err
+		}
+	}
+
 	u := parseRoute(
 		fmt.Sprintf(
 			editGuildApplicationCommand,
 			api,
-			i.ApplicationID.String(),
-			i.GuildID.String(),
-			i.Data.ID.String(),
+			applicationID.String(),
+			guildID.String(),
+			commandID,
 		),
 	)
 
@@ -298,7 +354,7 @@ func (i *Interaction) EditGuildApplicationCommand(payload *EditApplicationComman
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &command)
+	err = decodeResponse(u, responseBytes, &command)
 
 	return command, err
 }
@@ -329,12 +385,32 @@ func (i *Interaction) BulkOverwriteGuildApplicationCommands(payload []*Applicati
 	[]*ApplicationCommand,
 	error,
 ) {
+	return BulkOverwriteGuildApplicationCommands(&i.ApplicationID, &i.GuildID, payload)
+}
+
+// BulkOverwriteGuildApplicationCommands - Takes a list of application commands, overwriting the existing command list for this application for the targeted guild.
+//
+// Returns 200 and a list of application command objects.
+//
+//goland:noinspection GoUnusedExportedFunction
+func BulkOverwriteGuildApplicationCommands(applicationID *Snowflake,
+	guildID *Snowflake,
+	payload []*ApplicationCommand) ([]*ApplicationCommand, error) {
+	for _, command := range payload {
+		if command == nil {
+			continue
+		}
+		if err := validateCommandLocalizations(command.NameLocalizations, command.DescriptionLocalizations, command.Options); err != nil {
+			return nil, err
+		}
+	}
+
 	u := parseRoute(
 		fmt.Sprintf(
 			bulkOverwriteGuildApplicationCommands,
 			api,
-			i.ApplicationID.String(),
-			i.GuildID.String(),
+			applicationID.String(),
+			guildID.String(),
 		),
 	)
 
@@ -345,7 +421,7 @@ func (i *Interaction) BulkOverwriteGuildApplicationCommands(payload []*Applicati
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commands)
+	err = decodeResponse(u, responseBytes, &commands)
 
 	return commands, err
 }
@@ -370,7 +446,7 @@ func (i *Interaction) GetGuildApplicationCommandPermissions() ([]*GuildApplicati
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commandPerms)
+	err = decodeResponse(u, responseBytes, &commandPerms)
 
 	return commandPerms, err
 }
@@ -396,7 +472,7 @@ func (i *Interaction) GetApplicationCommandPermissions() (*GuildApplicationComma
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commandPerms)
+	err = decodeResponse(u, responseBytes, &commandPerms)
 
 	return commandPerms, err
 }
@@ -413,7 +489,7 @@ func (i *Interaction) GetApplicationCommandPermissions() (*GuildApplicationComma
 //
 //	Deleting or renaming a command will permanently delete all permissions for the command
 //
-// TODO: Find the best way to handle the requirement for needing a Bearer Token to use this endpoint
+// Sent with the package-level Rest, so it only succeeds after Rest.SetBearerToken; use BearerClient.EditApplicationCommandPermissions to act for several users.
 func (i *Interaction) EditApplicationCommandPermissions(payload *EditApplicationCommandPermissionsJSON) (
 	*GuildApplicationCommandPermissions,
 	error,
@@ -435,7 +511,7 @@ func (i *Interaction) EditApplicationCommandPermissions(payload *EditApplication
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &commandPerms)
+	err = decodeResponse(u, responseBytes, &commandPerms)
 
 	return commandPerms, err
 }
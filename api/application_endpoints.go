@@ -15,15 +15,14 @@
 package api
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
 
 	log "github.com/veteran-software/nowlive-logging"
 )
 
+// GetCurrentApplication - Returns the Application object associated with the requesting bot user.
+//
 //goland:noinspection GoUnusedExportedFunction
 func GetCurrentApplication() (*Application, error) {
 	u := parseRoute(fmt.Sprintf(getCurrentApplication, api))
@@ -35,57 +34,54 @@ func GetCurrentApplication() (*Application, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &application)
+	err = decodeResponse(u, responseBytes, &application)
 
 	return application, err
 }
 
+// EditCurrentApplication - Edit properties of the app associated with the requesting bot user.
+//
+// Only properties that are passed will be updated.
+//
+// Returns the updated Application object on success.
+//
 //goland:noinspection GoUnusedExportedFunction
-func EditCurrentApplication(customInstallUrl, description, roleConnectionsVerificationUrl, interactionsEndpointUrl *string, flags *int, tags *[]string) (*Application, error) {
-	u := parseRoute(fmt.Sprintf(editCurrentApplication, api))
-
-	// Set the optional qsp
-	q := u.Query()
-	if customInstallUrl != nil {
-		q.Set("custom_install_url", *customInstallUrl)
-	}
-	if description != nil {
-		q.Set("description", *description)
-	}
-	if roleConnectionsVerificationUrl != nil {
-		q.Set("role_connections_verification_url", *roleConnectionsVerificationUrl)
+func EditCurrentApplication(payload EditCurrentApplicationJSON) (*Application, error) {
+	if len(payload.Tags) > 5 {
+		return nil, errors.New("you cannot have more than 5 tags")
 	}
-	if interactionsEndpointUrl != nil {
-		q.Set("interactions_endpoint_url", *interactionsEndpointUrl)
-	}
-	if flags != nil {
-		q.Set("flags", strconv.Itoa(*flags))
-	}
-	if tags != nil {
-		if len(*tags) > 5 {
-			return nil, errors.New("you cannot have more than 5 tags")
-		}
-		for _, tag := range *tags {
-			if len(tag) > 20 {
-				return nil, errors.New("tag cannot be longer than 20 characters long")
-			}
+	for _, tag := range payload.Tags {
+		if len(tag) > 20 {
+			return nil, errors.New("tag cannot be longer than 20 characters long")
 		}
-
-		q.Set("flags", fmt.Sprintf("[%v]", strings.Join(*tags, ",")))
-	}
-	// If there's any of the optional qsp present, encode and add to the URL
-	if len(q) != 0 {
-		u.RawQuery = q.Encode()
 	}
 
+	u := parseRoute(fmt.Sprintf(editCurrentApplication, api))
+
 	var application *Application
-	responseBytes, err := fireGetRequest(u, nil, nil)
+	responseBytes, err := firePatchRequest(u, payload, nil)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &application)
+	err = decodeResponse(u, responseBytes, &application)
 
 	return application, err
 }
+
+// EditCurrentApplicationJSON - All parameters to this endpoint are optional
+//
+//goland:noinspection SpellCheckingInspection
+type EditCurrentApplicationJSON struct {
+	CustomInstallURL               *string                                                                 `json:"custom_install_url,omitempty"`                // Default custom authorization URL for the app, if enabled
+	Description                    *string                                                                 `json:"description,omitempty"`                       // Description of the app
+	RoleConnectionsVerificationURL *string                                                                 `json:"role_connections_verification_url,omitempty"` // Role connection verification URL for the app
+	InstallParams                  *InstallParams                                                          `json:"install_params,omitempty"`                    // Settings for the app's default in-app authorization link, if enabled
+	IntegrationTypesConfig         map[ApplicationIntegrationType]*ApplicationIntegrationTypeConfiguration `json:"integration_types_config,omitempty"`          // Default scopes and permissions for each supported installation context
+	Flags                          *ApplicationFlags                                                       `json:"flags,omitempty"`                             // App's public flags; only limited intent flags can be updated
+	Icon                           *string                                                                 `json:"icon,omitempty"`                              // Icon for the app as a base64 data URI
+	CoverImage                     *string                                                                 `json:"cover_image,omitempty"`                       // Default rich presence invite cover image for the app as a base64 data URI
+	InteractionsEndpointURL        *string                                                                 `json:"interactions_endpoint_url,omitempty"`         // Interactions endpoint URL for the app
+	Tags                           []string                                                                `json:"tags,omitempty"`                              // List of tags describing the content and functionality of the app (max of 20 characters per tag). Max of 5 tags.
+}
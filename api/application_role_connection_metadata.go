@@ -26,12 +26,12 @@ If an application has configured role connection metadata, its metadata will app
 When a user connects their account using the bots RoleConnectionsVerificationURL, the bot will update a user's role connection with metadata using the OAuth2 scopes.RoleConnectionsWrite scope.
 */
 type ApplicationRoleConnectionMetadata struct {
-	Type                      ApplicationRoleConnectionMetadataType // type of metadata value
-	Key                       string                                // dictionary key for the metadata field (must be a-z, 0-9, or _ characters; 1-50 characters)
-	Name                      string                                // name of the metadata field (1-100 characters)
-	NameLocalizations         LocalizationDict                      // translations of the name
-	Description               string                                //	description of the metadata field (1-200 characters)
-	DescriptionsLocalizations LocalizationDict                      // translations of the description
+	Type                     ApplicationRoleConnectionMetadataType `json:"type"`                                // type of metadata value
+	Key                      string                                `json:"key"`                                 // dictionary key for the metadata field (must be a-z, 0-9, or _ characters; 1-50 characters)
+	Name                     string                                `json:"name"`                                // name of the metadata field (1-100 characters)
+	NameLocalizations        LocalizationMap                       `json:"name_localizations,omitempty"`        // translations of the name
+	Description              string                                `json:"description"`                         // description of the metadata field (1-200 characters)
+	DescriptionLocalizations LocalizationMap                       `json:"description_localizations,omitempty"` // translations of the description
 }
 
 // ApplicationRoleConnectionMetadataType - type of metadata value
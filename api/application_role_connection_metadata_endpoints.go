@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -34,7 +33,7 @@ func GetApplicationRoleConnectionMetadataRecords(appID string) ([]*ApplicationRo
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &m)
+	err = decodeResponse(u, responseBytes, &m)
 
 	return m, err
 }
@@ -52,7 +51,7 @@ func UpdateApplicationRoleConnectionMetadataRecords(appID string) ([]*Applicatio
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &m)
+	err = decodeResponse(u, responseBytes, &m)
 
 	return m, err
 }
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ErrAttachmentURLExpired - returned when the CDN rejects an attachment URL whose signature has expired; refresh it with RefreshAttachmentURLs
+var ErrAttachmentURLExpired = errors.New("attachment url has expired")
+
+// Download - Streams the attachment from the CDN. The caller must close the returned body.
+//
+// The URL is requested exactly as Discord returned it, so the ex, is and hm signing parameters are preserved.
+func (a *Attachment) Download() (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, a.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", UserAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+
+		if (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden) && a.Expired() {
+			return nil, ErrAttachmentURLExpired
+		}
+
+		return nil, fmt.Errorf("downloading attachment %s: %s", a.ID, resp.Status)
+	}
+
+	return resp.Body, nil
+}
+
+// ToFile - Downloads the attachment into memory and returns it as a File, ready to be uploaded again with CreateMessageWithFiles
+func (a *Attachment) ToFile() (*File, error) {
+	body, err := a.Download()
+	if err != nil {
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(body)
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &File{
+		Name:        a.Filename,
+		ContentType: a.ContentType,
+		Reader:      bytes.NewReader(data),
+	}, nil
+}
+
+// ExpiresAt - Returns when the signed CDN URL stops working; the zero value means the URL is not signed
+func (a *Attachment) ExpiresAt() time.Time {
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return time.Time{}
+	}
+
+	ex, err := strconv.ParseInt(u.Query().Get("ex"), 16, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(ex, 0)
+}
+
+// Expired - Helper function to check whether the signed CDN URL has expired and needs to be refreshed
+func (a *Attachment) Expired() bool {
+	expiresAt := a.ExpiresAt()
+
+	return !expiresAt.IsZero() && time.Now().After(expiresAt)
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// RefreshAttachmentURLs - Exchanges expired attachment CDN URLs for freshly signed ones.
+//
+// Returns a map of each original URL to its refreshed URL.
+//
+//goland:noinspection GoUnusedExportedFunction
+func RefreshAttachmentURLs(attachmentURLs []string) (map[string]string, error) {
+	u := parseRoute(fmt.Sprintf(refreshAttachmentURLs, api))
+
+	var response *refreshAttachmentURLsResponse
+	responseBytes, err := firePostRequest(u, refreshAttachmentURLsJSON{AttachmentURLs: attachmentURLs}, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshed := make(map[string]string, len(response.RefreshedURLs))
+	for _, r := range response.RefreshedURLs {
+		refreshed[r.Original] = r.Refreshed
+	}
+
+	return refreshed, nil
+}
+
+// RefreshURL - Refreshes the attachment's signed CDN URL in place
+func (a *Attachment) RefreshURL() error {
+	refreshed, err := RefreshAttachmentURLs([]string{a.URL})
+	if err != nil {
+		return err
+	}
+
+	if newURL, ok := refreshed[a.URL]; ok {
+		a.URL = newURL
+	}
+
+	return nil
+}
+
+type refreshAttachmentURLsJSON struct {
+	AttachmentURLs []string `json:"attachment_urls"` // the attachment urls to refresh; at most 50
+}
+
+type refreshAttachmentURLsResponse struct {
+	RefreshedURLs []struct {
+		Original  string `json:"original"`  // the url that was sent
+		Refreshed string `json:"refreshed"` // the newly signed url
+	} `json:"refreshed_urls"`
+}
@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -72,7 +71,7 @@ func (g *Guild) GetGuildAuditLog(userID *Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &auditLog)
+	err = decodeResponse(u, responseBytes, &auditLog)
 
 	return auditLog, err
 }
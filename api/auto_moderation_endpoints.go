@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -52,7 +51,7 @@ func ListAutoModerationRulesForGuild(guildID string,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &rules)
+	err = decodeResponse(u, responseBytes, &rules)
 
 	return rules, err
 }
@@ -84,7 +83,7 @@ func GetAutoModerationRule(guildID string, channel *Channel, userID, ruleID *Sno
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &rule)
+	err = decodeResponse(u, responseBytes, &rule)
 
 	return rule, err
 }
@@ -120,7 +119,7 @@ func CreateAutoModerationRule(guildID string,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &rule)
+	err = decodeResponse(u, responseBytes, &rule)
 
 	return rule, err
 }
@@ -167,7 +166,7 @@ func ModifyAutoModerationRule(guildID string,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &rule)
+	err = decodeResponse(u, responseBytes, &rule)
 
 	return rule, err
 }
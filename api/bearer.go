@@ -0,0 +1,177 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// BearerClient - Calls the endpoints that act on behalf of a user who authorized the application through OAuth2
+//
+// Each client has its own RateLimiter, as Discord rate limits Bearer tokens per token rather than per application.
+type BearerClient struct {
+	Rest *RateLimiter // the RateLimiter the client's requests are sent through; add middleware to it as with the package-level Rest
+}
+
+// NewBearerClient - Creates a BearerClient that authenticates with the OAuth2 access token
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewBearerClient(accessToken string) *BearerClient {
+	r := NewRatelimiter()
+	r.SetBearerToken(accessToken)
+
+	return &BearerClient{Rest: r}
+}
+
+// GetCurrentUser - Returns the User who authorized the token; requires the `identify` scope, and `email` to include their email
+func (c *BearerClient) GetCurrentUser() (*User, error) {
+	u := parseRoute(fmt.Sprintf(getCurrentUser, api))
+
+	var user *User
+	responseBytes, err := c.Rest.fire(http.MethodGet, u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &user)
+
+	return user, err
+}
+
+// GetCurrentUserGuilds - Returns a list of partial Guild objects the user is a member of; requires the `guilds` scope
+func (c *BearerClient) GetCurrentUserGuilds(before *Snowflake, after *Snowflake, limit *uint64) ([]*Guild, error) {
+	u := parseRoute(fmt.Sprintf(getCurrentUserGuilds, api))
+
+	q := u.Query()
+	if before != nil {
+		q.Set("before", before.String())
+	}
+	if after != nil {
+		q.Set("after", after.String())
+	}
+	if limit != nil {
+		q.Set("limit", strconv.FormatUint(*limit, 10))
+	}
+	if len(q) > 0 {
+		u.RawQuery = q.Encode()
+	}
+
+	var guilds []*Guild
+	responseBytes, err := c.Rest.fire(http.MethodGet, u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &guilds)
+
+	return guilds, err
+}
+
+// FetchAllGuilds - Returns every Guild the user is a member of, following GetCurrentUserGuilds page by page; requires the `guilds` scope
+func (c *BearerClient) FetchAllGuilds(ctx context.Context) ([]*Guild, error) {
+	return fetchAllGuilds(ctx, c.Rest, func(after *Snowflake, limit *uint64) ([]*Guild, error) {
+		return c.GetCurrentUserGuilds(nil, after, limit)
+	})
+}
+
+// GetCurrentUserGuildMember - Returns the user's GuildMember in the guild; requires the `guilds.members.read` scope
+func (c *BearerClient) GetCurrentUserGuildMember(guildID Snowflake) (*GuildMember, error) {
+	u := parseRoute(fmt.Sprintf(getCurrentUserGuildMember, api, guildID.String()))
+
+	var guildMember *GuildMember
+	responseBytes, err := c.Rest.fire(http.MethodGet, u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &guildMember)
+
+	return guildMember, err
+}
+
+// GetUserConnections - Returns the user's Connection objects; requires the `connections` scope
+func (c *BearerClient) GetUserConnections() ([]*Connection, error) {
+	u := parseRoute(fmt.Sprintf(getUserConnections, api))
+
+	var connections []*Connection
+	responseBytes, err := c.Rest.fire(http.MethodGet, u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &connections)
+
+	return connections, err
+}
+
+// GetUserApplicationRoleConnection - Returns the user's application role connection; requires the `role_connections.write` scope
+func (c *BearerClient) GetUserApplicationRoleConnection(applicationID Snowflake) (*ApplicationRoleConnection, error) {
+	u := parseRoute(fmt.Sprintf(getUserApplicationRoleConnection, api, applicationID.String()))
+
+	var connection *ApplicationRoleConnection
+	responseBytes, err := c.Rest.fire(http.MethodGet, u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &connection)
+
+	return connection, err
+}
+
+// UpdateUserApplicationRoleConnection - Updates and returns the user's application role connection; requires the `role_connections.write` scope
+func (c *BearerClient) UpdateUserApplicationRoleConnection(applicationID Snowflake, payload *ApplicationRoleConnection) (*ApplicationRoleConnection, error) {
+	u := parseRoute(fmt.Sprintf(modifyUserApplicationRoleConnection, api, applicationID.String()))
+
+	var connection *ApplicationRoleConnection
+	responseBytes, err := c.Rest.fire(http.MethodPut, u, payload, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &connection)
+
+	return connection, err
+}
+
+// EditApplicationCommandPermissions - Edits the permissions for the command in the guild, replacing its existing overwrites
+//
+// The token needs the `applications.commands.permissions.update` scope and its user must be able to manage the guild and its roles.
+func (c *BearerClient) EditApplicationCommandPermissions(applicationID, guildID, commandID Snowflake,
+	payload *EditApplicationCommandPermissionsJSON) (*GuildApplicationCommandPermissions, error) {
+	u := parseRoute(fmt.Sprintf(editApplicationCommandPermissions, api, applicationID.String(), guildID.String(), commandID.String()))
+
+	var commandPerms *GuildApplicationCommandPermissions
+	responseBytes, err := c.Rest.fire(http.MethodPut, u, payload, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &commandPerms)
+
+	return commandPerms, err
+}
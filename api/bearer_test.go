@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestBearerClientAuthorization(t *testing.T) {
+	var got string
+	capture := func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			got = req.Header.Get("Authorization")
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(`{"id":"1"}`))}, nil
+		}
+	}
+
+	client := NewBearerClient("access")
+	client.Rest.Use(capture)
+	if _, err := client.GetCurrentUser(); err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	if got != "Bearer access" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer access")
+	}
+
+	client.Rest.SetBearerToken("")
+	if _, err := client.GetCurrentUser(); err != nil {
+		t.Fatalf("GetCurrentUser() error = %v", err)
+	}
+	if got != "Bot "+Token {
+		t.Errorf("Authorization after clearing the token = %q, want %q", got, "Bot "+Token)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// BucketStore - persists rate limit bucket state, so a bot that restarts during a deploy does not spend buckets it exhausted before the restart
+//
+// Load is called the first time a route is requested and Save every time Discord reports new limits for it.
+// Both run while requests to the route wait, so they should return quickly.
+//
+// A store shared between processes, e.g. one backed by Redis, can implement Save as SET route <json> PXAT <Reset>
+// and Load as GET, so expired windows disappear on their own.
+type BucketStore interface {
+	Load(route string) (BucketState, bool, error) // returns the saved state of the route, if any
+	Save(state BucketState) error                 // stores the state under state.Route
+}
+
+// SharedBucketStore - a BucketStore that several processes use at once, so they draw on the same buckets instead of each assuming it has them to itself
+//
+// When the RateLimiter's Store is a SharedBucketStore, Take is called before every request, after the local bucket is ready.
+// A Redis implementation keeps each route's state in a key that expires at its Reset, and runs Take as a Lua script so
+// checking the global reset, reading Remaining and decrementing it happen atomically across processes.
+type SharedBucketStore interface {
+	BucketStore
+	Take(route string) (wait time.Duration, err error) // claims one request from the route's window, or returns how long until one is free
+	SetGlobalReset(resetAt time.Time) error            // holds every route in every process until resetAt
+}
+
+// MemoryBucketStore - a SharedBucketStore that keeps state in memory, for sharing between RateLimiters in one process and for tests
+type MemoryBucketStore struct {
+	mu     sync.Mutex
+	states map[string]BucketState
+	global time.Time
+}
+
+// NewMemoryBucketStore - Creates an empty MemoryBucketStore
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewMemoryBucketStore() *MemoryBucketStore {
+	return &MemoryBucketStore{states: make(map[string]BucketState)}
+}
+
+// Load - Returns the saved state of the route while its window has not reset
+func (s *MemoryBucketStore) Load(route string) (BucketState, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[route]
+	if !ok || !state.Reset.After(time.Now()) {
+		delete(s.states, route)
+		return BucketState{}, false, nil
+	}
+
+	return state, true, nil
+}
+
+// Save - Stores the state under its route
+func (s *MemoryBucketStore) Save(state BucketState) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[state.Route] = state
+
+	return nil
+}
+
+// Take - Claims one request from the route's window, or returns how long until the window or the global limit resets
+//
+// Routes with no saved window are not limited, as their limits are not known yet.
+func (s *MemoryBucketStore) Take(route string) (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if s.global.After(now) {
+		return s.global.Sub(now), nil
+	}
+
+	state, ok := s.states[route]
+	if !ok || !state.Reset.After(now) {
+		return 0, nil
+	}
+	if state.Remaining > 0 {
+		state.Remaining--
+		s.states[route] = state
+		return 0, nil
+	}
+
+	return state.Reset.Sub(now), nil
+}
+
+// SetGlobalReset - Holds every route until resetAt
+func (s *MemoryBucketStore) SetGlobalReset(resetAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if resetAt.After(s.global) {
+		s.global = resetAt
+	}
+
+	return nil
+}
+
+// FileBucketStore - a BucketStore kept in memory and written to a JSON file by Flush, e.g. when the bot shuts down
+//
+// Writing on every request would put disk I/O on the request path, so nothing is written until Flush is called.
+type FileBucketStore struct {
+	MemoryBucketStore
+
+	path string
+}
+
+// NewFileBucketStore - Creates a FileBucketStore and loads the windows saved at path that have not reset yet; a missing file is not an error
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewFileBucketStore(path string) (*FileBucketStore, error) {
+	s := &FileBucketStore{MemoryBucketStore: MemoryBucketStore{states: make(map[string]BucketState)}, path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var states []BucketState
+	if err = json.Unmarshal(data, &states); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, state := range states {
+		if state.Reset.After(now) {
+			s.states[state.Route] = state
+		}
+	}
+
+	return s, nil
+}
+
+// Flush - Writes the windows that have not reset yet to the file, replacing it atomically
+func (s *FileBucketStore) Flush() error {
+	s.mu.Lock()
+	now := time.Now()
+	states := make([]BucketState, 0, len(s.states))
+	for _, state := range s.states {
+		if state.Reset.After(now) {
+			states = append(states, state)
+		}
+	}
+	s.mu.Unlock()
+
+	data, err := json.Marshal(states)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err = tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// restore applies a saved window to a new bucket, so requests wait for its reset instead of assuming a fresh bucket
+func (b *bucket) restore(state BucketState) {
+	if !state.Reset.After(time.Now()) {
+		return
+	}
+
+	b.Hash = state.Hash
+	b.Limit = state.Limit
+	b.Remaining = state.Remaining
+	b.reset = state.Reset
+}
+
+// persist saves the bucket's published state once Discord has reported a window for it; the bucket must be locked
+func (b *bucket) persist() {
+	if b.store == nil || b.customRateLimit != nil {
+		return
+	}
+
+	state := b.snapshot()
+	if state.Reset.IsZero() {
+		return
+	}
+
+	if err := b.store.Save(state); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+	}
+}
+
+// takeShared waits until the shared store grants the bucket a request, so other processes' requests count against it too
+func (r *RateLimiter) takeShared(b *bucket) {
+	shared, ok := b.store.(SharedBucketStore)
+	if !ok {
+		return
+	}
+
+	for {
+		wait, err := shared.Take(b.Key)
+		if err != nil {
+			// Fall back to the local limits rather than stalling every request while the store is unreachable
+			log.Errorln(log.Discord, log.FuncName(), err)
+			return
+		}
+		if wait <= 0 {
+			return
+		}
+
+		r.notifyDelay(RateLimitDelay{Bucket: b.snapshot(), Wait: wait})
+		time.Sleep(wait)
+	}
+}
+
+// shareGlobalReset tells the other processes sharing the store about a global rate limit
+func (r *RateLimiter) shareGlobalReset(resetAt time.Time) {
+	shared, ok := r.Store.(SharedBucketStore)
+	if !ok {
+		return
+	}
+
+	if err := shared.SetGlobalReset(resetAt); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+	}
+}
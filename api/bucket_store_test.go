@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestBucketStoreRestoresWindow(t *testing.T) {
+	store := NewMemoryBucketStore()
+
+	first := NewRatelimiter()
+	first.Store = store
+	b := first.lockBucket("https://discord.com/api/v10/channels/1/messages", PriorityNormal)
+	headers := http.Header{}
+	headers.Set("X-RateLimit-Bucket", "abc")
+	headers.Set("X-RateLimit-Limit", "5")
+	headers.Set("X-RateLimit-Remaining", "0")
+	headers.Set("X-RateLimit-Reset-After", "30")
+	if err := b.release(headers); err != nil {
+		t.Fatalf("release() error = %v", err)
+	}
+
+	restarted := NewRatelimiter()
+	restarted.Store = store
+	b = restarted.getBucket("https://discord.com/api/v10/channels/1/messages")
+	if b.Hash != "abc" || b.Limit != 5 || b.Remaining != 0 {
+		t.Errorf("getBucket() = %+v, want the saved window", b.snapshot())
+	}
+	if wait, _ := restarted.getWaitTime(b, 1); wait < 29*time.Second {
+		t.Errorf("getWaitTime() = %v, want about 30s", wait)
+	}
+}
+
+func TestFileBucketStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buckets.json")
+
+	store, err := NewFileBucketStore(path)
+	if err != nil {
+		t.Fatalf("NewFileBucketStore() error = %v", err)
+	}
+	_ = store.Save(BucketState{Route: "live", Remaining: 1, Reset: time.Now().Add(time.Minute)})
+	_ = store.Save(BucketState{Route: "expired", Remaining: 0, Reset: time.Now().Add(-time.Minute)})
+	if err = store.Flush(); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+
+	reloaded, err := NewFileBucketStore(path)
+	if err != nil {
+		t.Fatalf("NewFileBucketStore() error = %v", err)
+	}
+	if state, ok, _ := reloaded.Load("live"); !ok || state.Remaining != 1 {
+		t.Errorf("Load(live) = %+v, %v, want the saved state", state, ok)
+	}
+	if _, ok, _ := reloaded.Load("expired"); ok {
+		t.Error("Load(expired) found a window that has already reset")
+	}
+}
+
+func TestMemoryBucketStoreTake(t *testing.T) {
+	store := NewMemoryBucketStore()
+
+	if wait, _ := store.Take("unknown"); wait != 0 {
+		t.Errorf("Take(unknown) = %v, want 0", wait)
+	}
+
+	_ = store.Save(BucketState{Route: "route", Remaining: 1, Reset: time.Now().Add(time.Minute)})
+	if wait, _ := store.Take("route"); wait != 0 {
+		t.Errorf("Take() with one request left = %v, want 0", wait)
+	}
+	if wait, _ := store.Take("route"); wait < 59*time.Second {
+		t.Errorf("Take() with none left = %v, want about 1m", wait)
+	}
+
+	_ = store.SetGlobalReset(time.Now().Add(2 * time.Minute))
+	if wait, _ := store.Take("unknown"); wait < 119*time.Second {
+		t.Errorf("Take() under a global limit = %v, want about 2m", wait)
+	}
+}
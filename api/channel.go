@@ -14,9 +14,7 @@
 
 package api
 
-import (
-	"time"
-)
+import "errors"
 
 // Channel - Represents a guild or DM channel within Discord.
 type Channel struct {
@@ -38,8 +36,9 @@ type Channel struct {
 	ApplicationID                 Snowflake        `json:"application_id,omitempty"`                     // application id of the group DM creator if it is bot-created
 	Managed                       bool             `json:"managed,omitempty"`                            // for group DM channels: whether the channel is managed by an application via the gdm.join OAuth2 scope
 	ParentID                      *Snowflake       `json:"parent_id,omitempty"`                          // for guild channels: id of the parent category for a channel (each parent category can contain up to 50 channels), for threads: id of the text channel this thread was created
-	LastPinTimestamp              *time.Time       `json:"last_pin_timestamp,omitempty"`                 // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
+	LastPinTimestamp              *Timestamp       `json:"last_pin_timestamp,omitempty"`                 // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
 	RtcRegion                     *string          `json:"rtc_region,omitempty"`                         // voice region id for the voice channel, automatic when set to null
+	Status                        string           `json:"status,omitempty"`                             // the voice channel status (up to 500 characters), shown under the channel name
 	VideoQualityMode              int64            `json:"video_quality_mode,omitempty"`                 // the camera video quality mode of the voice channel, 1 when not present
 	MessageCount                  int64            `json:"message_count,omitempty"`                      // an approximate count of messages in a thread, stops counting at 50
 	MemberCount                   int64            `json:"member_count,omitempty"`                       // an approximate count of users in a thread, stops counting at 50
@@ -67,7 +66,7 @@ type GuildTextChannel struct {
 	Topic                *string      `json:"topic,omitempty"`                 // the channel topic (0-1024 characters)
 	GuildID              Snowflake    `json:"guild_id,omitempty"`              // the id of the guild (maybe missing for some channel objects received over gateway guild dispatches)
 	PermissionOverwrites []*Overwrite `json:"permission_overwrites,omitempty"` // explicit permission overwrites for members and roles
-	LastPinTimestamp     *time.Time   `json:"last_pin_timestamp,omitempty"`    // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
+	LastPinTimestamp     *Timestamp   `json:"last_pin_timestamp,omitempty"`    // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
 	RateLimitPerUser     int64        `json:"rate_limit_per_user,omitempty"`   // amount of seconds a user has to wait before sending another Message (0-21600); bots, as well as users with the permission ManageMessages or ManageChannels, are unaffected
 	Nsfw                 bool         `json:"nsfw,omitempty"`                  // whether the channel is nsfw
 }
@@ -82,6 +81,7 @@ type GuildVoiceChannel struct {
 	Bitrate              int64        `json:"bitrate,omitempty"`               // the bitrate (in bits) of the voice channel
 	UserLimit            int64        `json:"user_limit,omitempty"`            // the user limit of the voice channel
 	RtcRegion            *string      `json:"rtc_region,omitempty"`            // voice region id for the voice channel, automatic when set to null
+	Status               string       `json:"status,omitempty"`                // the voice channel status (up to 500 characters), shown under the channel name
 	GuildID              Snowflake    `json:"guild_id,omitempty"`              // the id of the guild (may be missing for some channel objects received over gateway guild dispatches)
 	PermissionOverwrites []*Overwrite `json:"permission_overwrites,omitempty"` // explicit permission overwrites for members and roles
 	RateLimitPerUser     int64        `json:"rate_limit_per_user,omitempty"`   // amount of seconds a user has to wait before sending another Message (0-21600); bots, as well as users with the permission ManageMessages or ManageChannels, are unaffected
@@ -98,6 +98,18 @@ type GuildCategoryChannel struct {
 	PermissionOverwrites []*Overwrite `json:"permission_overwrites,omitempty"` // explicit permission overwrites for members and roles
 }
 
+// GuildDirectoryChannel - the channel in a Student Hub that lists the servers linked to it; see ListDirectoryEntries
+type GuildDirectoryChannel struct {
+	*Channel
+
+	LastMessageID        *Snowflake   `json:"last_message_id,omitempty"`       // the id of the last directory entry added to this channel
+	Position             int          `json:"position,omitempty"`              // sorting position of the channel
+	Flags                ChannelFlag  `json:"flags,omitempty"`                 // channel flags combined as a bitfield
+	ParentID             *Snowflake   `json:"parent_id,omitempty"`             // id of the parent category for a channel (each parent category can contain up to 50 channels)
+	GuildID              Snowflake    `json:"guild_id,omitempty"`              // the id of the guild (may be missing for some channel objects received over gateway guild dispatches)
+	PermissionOverwrites []*Overwrite `json:"permission_overwrites,omitempty"` // explicit permission overwrites for members and roles
+}
+
 type GuildAnnouncementChannel struct {
 	*Channel
 
@@ -108,7 +120,7 @@ type GuildAnnouncementChannel struct {
 	Topic                *string      `json:"topic,omitempty"`                 // the channel topic (0-1024 characters)
 	GuildID              Snowflake    `json:"guild_id,omitempty"`              // the id of the guild (may be missing for some channel objects received over gateway guild dispatches)
 	PermissionOverwrites []*Overwrite `json:"permission_overwrites,omitempty"` // explicit permission overwrites for members and roles
-	LastPinTimestamp     *time.Time   `json:"last_pin_timestamp,omitempty"`    // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
+	LastPinTimestamp     *Timestamp   `json:"last_pin_timestamp,omitempty"`    // when the last pinned message was pinned. This may be null in events such as GUILD_CREATE when a message is not pinned.
 	RateLimitPerUser     int64        `json:"rate_limit_per_user,omitempty"`   // amount of seconds a user has to wait before sending another Message (0-21600); bots, as well as users with the permission ManageMessages or ManageChannels, are unaffected
 	Nsfw                 bool         `json:"nsfw,omitempty"`                  // whether the channel is nsfw
 }
@@ -217,9 +229,39 @@ const (
 	GuildMedia                                     // Channel that can only contain threads, similar to GuildForum channels
 )
 
+// ErrNotTextBased - returned by the message helpers when the channel cannot hold messages, e.g. a category or forum
+var ErrNotTextBased = errors.New("channel is not text based")
+
+// IsThread - Checks if the channel is an announcement, public or private thread
+func (t ChannelType) IsThread() bool {
+	switch t {
+	case GuildAnnouncementThread, GuildPublicThread, GuildPrivateThread:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsVoice - Checks if users can connect to the channel, i.e. a voice or stage channel
+func (t ChannelType) IsVoice() bool {
+	return t == GuildVoice || t == GuildStageVoice
+}
+
+// IsTextBased - Checks if messages can be sent in the channel; voice and stage channels have a built-in text chat
+//
+// Categories, directories, forums and media channels hold other channels or threads, not messages.
+func (t ChannelType) IsTextBased() bool {
+	switch t {
+	case GuildText, DM, GuildVoice, GroupDM, GuildAnnouncement, GuildAnnouncementThread, GuildPublicThread, GuildPrivateThread, GuildStageVoice:
+		return true
+	default:
+		return false
+	}
+}
+
 func isTextChannel(channel *Channel) bool {
 	return channel.Type == GuildText || channel.Type == GuildAnnouncement || channel.Type == GuildAnnouncementThread || channel.Type == GuildPublicThread ||
-		channel.Type == GuildPrivateThread || channel.Type == GuildDirectory || channel.Type == GuildForum
+		channel.Type == GuildPrivateThread || channel.Type == GuildDirectory || channel.Type == GuildForum || channel.Type == GuildMedia
 }
 
 // VideoQualityMode - the camera video quality mode of the voice channel, 1 when not present
@@ -236,8 +278,9 @@ type ChannelFlag int
 
 //goland:noinspection GoUnusedConst
 const (
-	Pinned     ChannelFlag = 1 << 1 // this thread is pinned to the top of its parent GuildForum channel
-	RequireTag ChannelFlag = 1 << 4 // whether a tag is required to be specified when creating a thread in a GuildForum channel. Tags are specified in the AppliedTags field.
+	Pinned                   ChannelFlag = 1 << 1  // this thread is pinned to the top of its parent GuildForum channel
+	RequireTag               ChannelFlag = 1 << 4  // whether a tag is required to be specified when creating a thread in a GuildForum or a GuildMedia channel. Tags are specified in the AppliedTags field.
+	HideMediaDownloadOptions ChannelFlag = 1 << 15 // when set hides the embedded media download options. Available only for GuildMedia channels
 )
 
 // SortOrderType - the default sort order type used to order posts in GuildForum channels.
@@ -263,37 +306,41 @@ const (
 //
 //goland:noinspection SpellCheckingInspection
 type Message struct {
-	ID                   Snowflake          `json:"id,omitempty"`                     // id of the message
-	ChannelID            Snowflake          `json:"channel_id,omitempty"`             // id of the Channel the message was sent in
-	Author               User               `json:"author,omitempty"`                 // the author of this message (not guaranteed to be a valid user)
-	Content              string             `json:"content,omitempty"`                // contents of the message
-	Timestamp            time.Time          `json:"timestamp,omitempty"`              // when this message was sent
-	EditedTimestamp      *time.Time         `json:"edited_timestamp,omitempty"`       // when this message was edited (or null if never)
-	TTS                  bool               `json:"tts,omitempty"`                    // whether this was a TTS message
-	MentionEveryone      bool               `json:"mention_everyone,omitempty"`       // whether this message mentions everyone
-	Mentions             []*User            `json:"mentions,omitempty"`               // users specifically mentioned in the message
-	MentionRoles         []*Snowflake       `json:"mention_roles,omitempty"`          // roles specifically mentioned in this message
-	MentionChannels      []*Channel         `json:"mention_channels,omitempty"`       // channels specifically mentioned in this message
-	Attachments          []*Attachment      `json:"attachments,omitempty"`            // any attached files
-	Embeds               []*Embed           `json:"embeds,omitempty"`                 // any embedded content
-	Reactions            []*Reaction        `json:"reactions,omitempty"`              // reactions to the message
-	Nonce                any                `json:"nonce,omitempty"`                  // used for validating a message was sent
-	Pinned               bool               `json:"pinned,omitempty"`                 // whether this message is pinned
-	WebhookID            Snowflake          `json:"webhook_id,omitempty"`             // if the message is generated by a Webhook, this is the webhook's id
-	Type                 MessageType        `json:"type,omitempty"`                   // the MessageType
-	Activity             MessageActivity    `json:"activity,omitempty"`               // sent with Rich Presence-related chat embeds
-	Application          Application        `json:"application,omitempty"`            // sent with Rich Presence-related chat embeds
-	ApplicationID        Snowflake          `json:"application_id,omitempty"`         // if the message is an Interaction or application-owned webhook, this is the id of the application
-	MessageReference     MessageReference   `json:"message_reference,omitempty"`      // data showing the source of a crosspost, channel follow add, pin, or reply message
-	Flags                MessageFlags       `json:"flags,omitempty"`                  // MessageFlags combined as a bitfield
-	ReferencedMessage    *Message           `json:"referenced_message,omitempty"`     // the message associated with the MessageReference
-	Interaction          MessageInteraction `json:"interaction,omitempty"`            // sent if the message is a response to an Interaction
-	Thread               Channel            `json:"thread,omitempty"`                 // the thread that was started from this message, includes ThreadMember object
-	Components           []*Component       `json:"components,omitempty"`             // sent if the message contains components like buttons, action rows, or other interactive components
-	StickerItems         []string           `json:"sticker_items,omitempty"`          // sent if the message contains stickers
-	Stickers             []string           `json:"stickers,omitempty"`               // Deprecated: the stickers sent with the message
-	Position             int                `json:"position,omitempty"`               // A generally increasing integer (there may be gaps or duplicates) that represents the approximate position of the message in a thread, it can be used to estimate the relative position of the message in a thread in company with total_message_sent on parent thread
-	RoleSubscriptionData any                `json:"role_subscription_data,omitempty"` // data of the role subscription purchase or renewal that prompted this RoleSubscriptionPurchase message
+	ID                   Snowflake                   `json:"id,omitempty"`                     // id of the message
+	ChannelID            Snowflake                   `json:"channel_id,omitempty"`             // id of the Channel the message was sent in
+	GuildID              Snowflake                   `json:"guild_id,omitempty"`               // id of the Guild the message was sent in; sent with gateway message events, not REST responses
+	Author               User                        `json:"author,omitempty"`                 // the author of this message (not guaranteed to be a valid user)
+	Content              string                      `json:"content,omitempty"`                // contents of the message
+	Timestamp            Timestamp                   `json:"timestamp,omitempty"`              // when this message was sent
+	EditedTimestamp      *Timestamp                  `json:"edited_timestamp,omitempty"`       // when this message was edited (or null if never)
+	TTS                  bool                        `json:"tts,omitempty"`                    // whether this was a TTS message
+	MentionEveryone      bool                        `json:"mention_everyone,omitempty"`       // whether this message mentions everyone
+	Mentions             []*User                     `json:"mentions,omitempty"`               // users specifically mentioned in the message
+	MentionRoles         []*Snowflake                `json:"mention_roles,omitempty"`          // roles specifically mentioned in this message
+	MentionChannels      []*Channel                  `json:"mention_channels,omitempty"`       // channels specifically mentioned in this message
+	Attachments          []*Attachment               `json:"attachments,omitempty"`            // any attached files
+	Embeds               []*Embed                    `json:"embeds,omitempty"`                 // any embedded content
+	Reactions            []*Reaction                 `json:"reactions,omitempty"`              // reactions to the message
+	Nonce                any                         `json:"nonce,omitempty"`                  // used for validating a message was sent
+	Pinned               bool                        `json:"pinned,omitempty"`                 // whether this message is pinned
+	WebhookID            Snowflake                   `json:"webhook_id,omitempty"`             // if the message is generated by a Webhook, this is the webhook's id
+	Type                 MessageType                 `json:"type,omitempty"`                   // the MessageType
+	Activity             MessageActivity             `json:"activity,omitempty"`               // sent with Rich Presence-related chat embeds
+	Application          Application                 `json:"application,omitempty"`            // sent with Rich Presence-related chat embeds
+	ApplicationID        Snowflake                   `json:"application_id,omitempty"`         // if the message is an Interaction or application-owned webhook, this is the id of the application
+	MessageReference     MessageReference            `json:"message_reference,omitempty"`      // data showing the source of a crosspost, channel follow add, pin, or reply message
+	Flags                MessageFlags                `json:"flags,omitempty"`                  // MessageFlags combined as a bitfield
+	ReferencedMessage    *Message                    `json:"referenced_message,omitempty"`     // the message associated with the MessageReference
+	Interaction          MessageInteraction          `json:"interaction,omitempty"`            // Deprecated: use InteractionMetadata; sent if the message is a response to an Interaction
+	Thread               Channel                     `json:"thread,omitempty"`                 // the thread that was started from this message, includes ThreadMember object
+	Components           []*Component                `json:"components,omitempty"`             // sent if the message contains components like buttons, action rows, or other interactive components
+	StickerItems         []string                    `json:"sticker_items,omitempty"`          // sent if the message contains stickers
+	Stickers             []string                    `json:"stickers,omitempty"`               // Deprecated: the stickers sent with the message
+	Position             int                         `json:"position,omitempty"`               // A generally increasing integer (there may be gaps or duplicates) that represents the approximate position of the message in a thread, it can be used to estimate the relative position of the message in a thread in company with total_message_sent on parent thread
+	InteractionMetadata  *MessageInteractionMetadata `json:"interaction_metadata,omitempty"`   // sent if the message is sent as a result of an interaction
+	RoleSubscriptionData *RoleSubscriptionData       `json:"role_subscription_data,omitempty"` // data of the role subscription purchase or renewal that prompted this RoleSubscriptionPurchase message
+	MessageSnapshots     []*MessageSnapshot          `json:"message_snapshots,omitempty"`      // the message associated with the MessageReference when it is a Forward; currently limited to one
+	PurchaseNotification *PurchaseNotificationData   `json:"purchase_notification,omitempty"`  // the purchase that prompted this PurchaseNotification message
 }
 
 // MessageType - type of message
@@ -301,39 +348,69 @@ type MessageType int
 
 //goland:noinspection GoUnusedConst,SpellCheckingInspection
 const (
-	Default                                 MessageType = iota     // DEFAULT
-	RecipientAdd                                                   // RECIPIENT_ADD
-	RecipientRemove                                                // RECIPIENT_REMOVE
-	Call                                                           // CALL
-	ChannelNameChange                                              // CHANNEL_NAME_CHANGE
-	ChannelIconChange                                              // CHANNEL_ICON_CHANGE
-	ChannelPinnedMessage                                           // CHANNEL_PINNED_MESSAGE
-	UserJoin                                                       // USER_JOIN
-	GuildBoost                                                     // GUILD_BOOST
-	GuildBoostTier1                                                // GUILD_BOOST_TIER_1
-	GuildBoostTier2                                                // GUILD_BOOST_TIER_2
-	GuildBoostTier3                                                // GUILD_BOOST_TIER_3
-	ChannelFollowAdd                                               // CHANNEL_FOLLOW_ADD
-	GuildDiscoveryDisqualified              MessageType = iota + 1 // GUILD_DISCOVERY_DISQUALIFIED
-	GuildDiscoveryRequalified                                      // GUILD_DISCOVERY_REQUALIFIED
-	GuildDiscoveryGracePeriodInitialWarning                        // GUILD_DISCOVERY_GRACE_PERIOD_INITIAL_WARNING
-	GuildDiscoveryGracePeriodFinalWarning                          // GUILD_DISCOVERY_GRACE_PERIOD_FINAL_WARNING
-	ThreadCreated                                                  // THREAD_CREATED
-	Reply                                                          // REPLY
-	ChatInputCommand                                               // CHAT_INPUT_COMMAND
-	ThreadStarterMessage                                           // THREAD_STARTER_MESSAGE
-	GuildInviteReminder                                            // GUILD_INVITE_REMINDER
-	ContextMenuCommand                                             // CONTEXT_MENU_COMMAND
-	AutoModerationAction                                           // AUTO_MODERATION_ACTION
-	RoleSubscriptionPurchase                                       // ROLE_SUBSCRIPTION_PURCHASE
-	InteractionPremiumUpsell                                       // INTERACTION_PREMIUM_UPSELL
-	StageStart                                                     // STAGE_START
-	StageEnd                                                       // STAGE_END
-	StageSpeaker                                                   // STAGE_SPEAKER
-	StageTopic                              MessageType = iota + 2 // STAGE_TOPIC
-	GuildApplicationPremiumSubscription                            // GUILD_APPLICATION_PREMIUM_SUBSCRIPTION
+	Default                                 MessageType = iota      // DEFAULT
+	RecipientAdd                                                    // RECIPIENT_ADD
+	RecipientRemove                                                 // RECIPIENT_REMOVE
+	Call                                                            // CALL
+	ChannelNameChange                                               // CHANNEL_NAME_CHANGE
+	ChannelIconChange                                               // CHANNEL_ICON_CHANGE
+	ChannelPinnedMessage                                            // CHANNEL_PINNED_MESSAGE
+	UserJoin                                                        // USER_JOIN
+	GuildBoost                                                      // GUILD_BOOST
+	GuildBoostTier1                                                 // GUILD_BOOST_TIER_1
+	GuildBoostTier2                                                 // GUILD_BOOST_TIER_2
+	GuildBoostTier3                                                 // GUILD_BOOST_TIER_3
+	ChannelFollowAdd                                                // CHANNEL_FOLLOW_ADD
+	GuildDiscoveryDisqualified              MessageType = iota + 1  // GUILD_DISCOVERY_DISQUALIFIED
+	GuildDiscoveryRequalified                                       // GUILD_DISCOVERY_REQUALIFIED
+	GuildDiscoveryGracePeriodInitialWarning                         // GUILD_DISCOVERY_GRACE_PERIOD_INITIAL_WARNING
+	GuildDiscoveryGracePeriodFinalWarning                           // GUILD_DISCOVERY_GRACE_PERIOD_FINAL_WARNING
+	ThreadCreated                                                   // THREAD_CREATED
+	Reply                                                           // REPLY
+	ChatInputCommand                                                // CHAT_INPUT_COMMAND
+	ThreadStarterMessage                                            // THREAD_STARTER_MESSAGE
+	GuildInviteReminder                                             // GUILD_INVITE_REMINDER
+	ContextMenuCommand                                              // CONTEXT_MENU_COMMAND
+	AutoModerationAction                                            // AUTO_MODERATION_ACTION
+	RoleSubscriptionPurchase                                        // ROLE_SUBSCRIPTION_PURCHASE
+	InteractionPremiumUpsell                                        // INTERACTION_PREMIUM_UPSELL
+	StageStart                                                      // STAGE_START
+	StageEnd                                                        // STAGE_END
+	StageSpeaker                                                    // STAGE_SPEAKER
+	StageTopic                              MessageType = iota + 2  // STAGE_TOPIC
+	GuildApplicationPremiumSubscription                             // GUILD_APPLICATION_PREMIUM_SUBSCRIPTION
+	GuildIncidentAlertModeEnabled           MessageType = iota + 5  // GUILD_INCIDENT_ALERT_MODE_ENABLED
+	GuildIncidentAlertModeDisabled                                  // GUILD_INCIDENT_ALERT_MODE_DISABLED
+	GuildIncidentReportRaid                                         // GUILD_INCIDENT_REPORT_RAID
+	GuildIncidentReportFalseAlarm                                   // GUILD_INCIDENT_REPORT_FALSE_ALARM
+	PurchaseNotification                    MessageType = iota + 9  // PURCHASE_NOTIFICATION
+	PollResult                              MessageType = iota + 10 // POLL_RESULT
 )
 
+// IsSystem - Checks whether messages of this type are generated by Discord rather than written by a user or application
+//
+// Replies and application command responses carry user or application content, so they are not system messages.
+func (t MessageType) IsSystem() bool {
+	switch t {
+	case Default, Reply, ChatInputCommand, ContextMenuCommand:
+		return false
+	default:
+		return true
+	}
+}
+
+// IsDeletable - Checks whether messages of this type can be deleted
+//
+// AutoModerationAction messages can only be deleted by members with the ManageMessages permission.
+func (t MessageType) IsDeletable() bool {
+	switch t {
+	case RecipientAdd, RecipientRemove, Call, ChannelNameChange, ChannelIconChange, ThreadStarterMessage:
+		return false
+	default:
+		return true
+	}
+}
+
 // MessageActivity - sent with Rich Presence-related chat embeds
 type MessageActivity struct {
 	Type    MessageActivityType `json:"type"`               // type of message activity
@@ -366,14 +443,34 @@ const (
 	Loading                          MessageFlags = 1 << 7  // this message is an Interaction Response and the bot is "thinking"
 	FailedToMentionSomeRolesInThread MessageFlags = 1 << 8  // this message failed to mention some roles and add their members to the thread
 	SuppressNotifications            MessageFlags = 1 << 12 // this message will not trigger push and desktop notifications
+	IsVoiceMessage                   MessageFlags = 1 << 13 // this message is a voice message
+	HasSnapshot                      MessageFlags = 1 << 14 // this message has a snapshot (via Message Forwarding)
+	IsComponentsV2                   MessageFlags = 1 << 15 // allows you to create fully component-driven messages
 )
 
 // MessageReference - ChannelID is optional when creating a reply, but will always be present when receiving an event/response that includes this data model.
+//
+// When Type is Forward, ChannelID and MessageID are required and the forwarded message is returned in Message.MessageSnapshots.
 type MessageReference struct {
-	MessageID       Snowflake `json:"message_id,omitempty"`         // id of the originating message
-	ChannelID       Snowflake `json:"channel_id,omitempty"`         // id of the originating message's channel
-	GuildID         Snowflake `json:"guild_id,omitempty"`           // id of the originating message's guild
-	FailIfNotExists bool      `json:"fail_if_not_exists,omitempty"` // when sending, whether to error if the referenced message doesn't exist instead of sending as a normal (non-reply) message, default true
+	Type            MessageReferenceType `json:"type,omitempty"`               // type of reference; defaults to DefaultReference
+	MessageID       Snowflake            `json:"message_id,omitempty"`         // id of the originating message
+	ChannelID       Snowflake            `json:"channel_id,omitempty"`         // id of the originating message's channel
+	GuildID         Snowflake            `json:"guild_id,omitempty"`           // id of the originating message's guild
+	FailIfNotExists bool                 `json:"fail_if_not_exists,omitempty"` // when sending, whether to error if the referenced message doesn't exist instead of sending as a normal (non-reply) message, default true
+}
+
+// MessageReferenceType - determines how associated data is populated on a MessageReference
+type MessageReferenceType int
+
+//goland:noinspection GoUnusedConst
+const (
+	DefaultReference MessageReferenceType = iota // a standard reference used by replies
+	Forward                                      // reference used to point to a message at a point in time
+)
+
+// MessageSnapshot - a point-in-time copy of a forwarded message
+type MessageSnapshot struct {
+	Message *Message `json:"message"` // partial message containing only type, content, embeds, attachments, timestamp, edited_timestamp, flags, mentions, mention_roles, stickers, sticker_items, and components
 }
 
 // FollowedChannel - representation of a followed News Channel
@@ -384,9 +481,18 @@ type FollowedChannel struct {
 
 // Reaction - representation of a message reaction
 type Reaction struct {
-	Count int   `json:"count"` // times this Emoji has been used to react
-	Me    bool  `json:"me"`    // whether the current User reacted using this Emoji
-	Emoji Emoji `json:"emoji"` // Emoji information
+	Count        int                  `json:"count"`                  // total times this Emoji has been used to react, including super reactions
+	CountDetails ReactionCountDetails `json:"count_details"`          // the counts of normal and super reactions
+	Me           bool                 `json:"me"`                     // whether the current User reacted using this Emoji
+	MeBurst      bool                 `json:"me_burst"`               // whether the current User super-reacted using this Emoji
+	Emoji        Emoji                `json:"emoji"`                  // Emoji information
+	BurstColors  []string             `json:"burst_colors,omitempty"` // HEX colors used for the super reaction
+}
+
+// ReactionCountDetails - the normal and super reaction counts of a Reaction
+type ReactionCountDetails struct {
+	Burst  int `json:"burst"`  // count of super reactions
+	Normal int `json:"normal"` // count of normal reactions
 }
 
 // Overwrite - representation of a permissions overwrite
@@ -412,17 +518,17 @@ const (
 type ThreadMetadata struct {
 	Archived            bool       `json:"archived"`                   // whether the thread is archived
 	AutoArchiveDuration int        `json:"auto_archive_duration"`      // duration in minutes to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
-	ArchiveTimestamp    time.Time  `json:"archive_timestamp"`          // timestamp when the thread's archive status was last changed, used for calculating recent activity
+	ArchiveTimestamp    Timestamp  `json:"archive_timestamp"`          // timestamp when the thread's archive status was last changed, used for calculating recent activity
 	Locked              bool       `json:"locked"`                     // whether the thread is locked; when a thread is locked, only users with ManageThreads can unarchive it
 	Invitable           bool       `json:"invitable,omitempty"`        // whether non-moderators can add other non-moderators to a thread; only available on private threads
-	CreateTimestamp     *time.Time `json:"create_timestamp,omitempty"` // timestamp when the thread was created; only populated for threads created after 2022-01-09
+	CreateTimestamp     *Timestamp `json:"create_timestamp,omitempty"` // timestamp when the thread was created; only populated for threads created after 2022-01-09
 }
 
 // ThreadMember - A thread member is used to indicate whether a user has joined a thread or not.
 type ThreadMember struct {
 	ID            Snowflake   `json:"id,omitempty"`      // ID of the thread
 	UserID        Snowflake   `json:"user_id,omitempty"` // ID of the user
-	JoinTimestamp time.Time   `json:"join_timestamp"`    // Time the user last joined the thread
+	JoinTimestamp Timestamp   `json:"join_timestamp"`    // Time the user last joined the thread
 	Flags         int64       `json:"flags"`             // Any user-thread settings, currently only used for notifications
 	Member        GuildMember `json:"member,omitempty"`  // Additional information about the user
 }
@@ -439,11 +545,11 @@ type DefaultReaction struct {
 //
 // At most one of EmojiID and EmojiName may be set.
 type ForumTag struct {
-	ID        Snowflake `json:"id"`         // the id of the tag
-	Name      string    `json:"name"`       // the name of the tag (0-20 characters)
-	Moderated bool      `json:"moderated"`  // whether this tag can only be added to or removed from threads by a member with the ManageThreads permission
-	EmojiID   Snowflake `json:"emoji_id"`   // the id of a guild's custom emoji
-	EmojiName *string   `json:"emoji_name"` // the unicode character of the emoji
+	ID        Snowflake  `json:"id,omitempty"` // the id of the tag
+	Name      string     `json:"name"`         // the name of the tag (0-20 characters)
+	Moderated bool       `json:"moderated"`    // whether this tag can only be added to or removed from threads by a member with the ManageThreads permission
+	EmojiID   *Snowflake `json:"emoji_id"`     // the id of a guild's custom emoji
+	EmojiName *string    `json:"emoji_name"`   // the unicode character of the emoji
 }
 
 // Embed - contains rich content
@@ -452,7 +558,7 @@ type Embed struct {
 	Type        EmbedType  `json:"type,omitempty"`        // EmbedType (always RichEmbed for webhook embeds)
 	Description string     `json:"description,omitempty"` // description of embed
 	URL         string     `json:"url,omitempty"`         // url of embed
-	Timestamp   string     `json:"timestamp,omitempty"`   // timestamp of embed content
+	Timestamp   *Timestamp `json:"timestamp,omitempty"`   // timestamp of embed content
 	Color       int64      `json:"color,omitempty"`       // color code of the embed
 	Footer      *Footer    `json:"footer,omitempty"`      // footer information
 	Image       *Image     `json:"image,omitempty"`       // image information
@@ -531,16 +637,18 @@ type Field struct {
 //
 //goland:noinspection SpellCheckingInspection
 type Attachment struct {
-	ID          Snowflake `json:"id"`                     // attachment id
-	Filename    string    `json:"filename"`               // name of file attached
-	Description string    `json:"description,omitempty"`  // description for the file
-	ContentType string    `json:"content_type,omitempty"` // the attachment's media type
-	Size        int       `json:"size"`                   // size of file in bytes
-	URL         string    `json:"url"`                    // source url of file
-	ProxyURL    string    `json:"proxy_url"`              // a proxied url of file
-	Height      *int      `json:"height,omitempty"`       // height of file (if image)
-	Width       *int      `json:"width,omitempty"`        // width of file (if image)
-	Ephemeral   bool      `json:"ephemeral,omitempty"`    // whether this attachment is ephemeral
+	ID           Snowflake `json:"id"`                      // attachment id
+	Filename     string    `json:"filename"`                // name of file attached
+	Description  string    `json:"description,omitempty"`   // description for the file
+	ContentType  string    `json:"content_type,omitempty"`  // the attachment's media type
+	Size         int       `json:"size"`                    // size of file in bytes
+	URL          string    `json:"url"`                     // source url of file
+	ProxyURL     string    `json:"proxy_url"`               // a proxied url of file
+	Height       *int      `json:"height,omitempty"`        // height of file (if image)
+	Width        *int      `json:"width,omitempty"`         // width of file (if image)
+	Ephemeral    bool      `json:"ephemeral,omitempty"`     // whether this attachment is ephemeral
+	DurationSecs *float64  `json:"duration_secs,omitempty"` // the duration of the audio file (currently for voice messages)
+	Waveform     *string   `json:"waveform,omitempty"`      // base64 encoded bytearray representing a sampled waveform (currently for voice messages)
 }
 
 // ChannelMention - representation of a Channel mention
@@ -571,7 +679,7 @@ const (
 	EveryoneMentions AllowedMentionType = "everyone" // Controls @everyone and @here mentions
 )
 
-// RoleSubscriptionData - data of the role subscription purchase or renewal that prompted this RoleSubscriptionData message
+// RoleSubscriptionData - data of the role subscription purchase or renewal that prompted this RoleSubscriptionPurchase message
 type RoleSubscriptionData struct {
 	RoleSubscriptionListingID Snowflake `json:"role_subscription_listing_id"` // the id of the sku and listing that the user is subscribed to
 	TierName                  string    `json:"tier_name"`                    // the name of the tier that the user is subscribed to
@@ -579,6 +687,26 @@ type RoleSubscriptionData struct {
 	IsRenewal                 bool      `json:"is_renewal"`                   // whether this notification is for a renewal rather than a new purchase
 }
 
+// PurchaseNotificationData - the purchase that prompted a PurchaseNotification message
+type PurchaseNotificationData struct {
+	Type                 PurchaseNotificationType `json:"type"`                             // the type of purchase
+	GuildProductPurchase *GuildProductPurchase    `json:"guild_product_purchase,omitempty"` // the guild product that was purchased, for GuildProductPurchaseNotification
+}
+
+// PurchaseNotificationType - the type of purchase a PurchaseNotificationData is for
+type PurchaseNotificationType int
+
+//goland:noinspection GoUnusedConst
+const (
+	GuildProductPurchaseNotification PurchaseNotificationType = iota // GUILD_PRODUCT; a guild product was purchased
+)
+
+// GuildProductPurchase - a guild product that was purchased
+type GuildProductPurchase struct {
+	ListingID   Snowflake `json:"listing_id"`   // the id of the listing
+	ProductName string    `json:"product_name"` // the name of the product
+}
+
 // Additionally, the combined sum of characters in all title, description, field.name, field.value, footer.text, and author.name fields across all embeds attached to a message must not exceed 6000 characters.
 //
 // Violating any of these constraints will result in a Bad Request response.
@@ -21,17 +21,7 @@ import (
 
 // IsValidLength - Checks that the total size of an Embed is valid for sending
 func (e *Embed) IsValidLength() bool {
-	if len(e.Title) <= TitleLimit && len(e.Description) <= DescriptionLimit && len(e.Fields) <= FieldCount && len(e.Footer.Text) <= FooterTextLimit && len(e.Author.Name) <= AuthorNameLimit {
-		for _, field := range e.Fields {
-			if len(field.Name) > FieldNameLimit || len(field.Value) > FieldValueLimit {
-				return false
-			}
-		}
-
-		return true
-	}
-
-	return false
+	return e.Validate() == nil
 }
 
 // String - Converts a Channel into a string for easy output
@@ -53,6 +43,8 @@ func (c *Channel) String() string {
 		chanType = "GPuT:"
 	case GuildPrivateThread:
 		chanType = "GPrT:"
+	case GuildDirectory:
+		chanType = "GDir:"
 	}
 
 	return chanType + c.Name + "(" + c.ID.String() + ")"
@@ -67,7 +59,7 @@ func NewEmbed() *Embed {
 		Type:        RichEmbed,
 		Description: "",
 		URL:         "",
-		Timestamp:   time.Now().Format(time.RFC3339),
+		Timestamp:   NewTimestamp(time.Now()),
 		Color:       16711680,
 		Footer:      nil,
 		Image:       nil,
@@ -110,7 +102,7 @@ func (e *Embed) SetURL(u string) *Embed {
 
 // SetTimestamp - Set the Embed timestamp
 func (e *Embed) SetTimestamp(ts time.Time) *Embed {
-	e.Timestamp = ts.UTC().Format(time.RFC3339)
+	e.Timestamp = NewTimestamp(ts)
 
 	return e
 }
@@ -308,3 +300,10 @@ func (c *Channel) getSelfMember() (*GuildMember, error) {
 
 	return g.GetGuildMember(&ApplicationID)
 }
+
+// FollowInto - Follows the announcement channel into the target channel, so its crossposted messages are sent there by a webhook
+//
+// A shorthand for FollowAnnouncementChannel; requires the ManageWebhooks permission in the target channel.
+func (c *Channel) FollowInto(targetChannelID Snowflake, reason *string) (*FollowedChannel, error) {
+	return c.FollowAnnouncementChannel(FollowAnnouncementChannelJSON{WebhookChannelID: targetChannelID}, reason)
+}
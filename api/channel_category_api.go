@@ -0,0 +1,126 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrNoParent - returned when syncing the permissions of a channel that is not in a category
+var ErrNoParent = errors.New("channel is not in a category")
+
+// ChildrenOf - Returns the channels in the category, in the order they are listed in the client
+//
+//goland:noinspection GoUnusedExportedFunction
+func ChildrenOf(channels []*Channel, categoryID Snowflake) []*Channel {
+	var children []*Channel
+	for _, channel := range channels {
+		if channel.ParentID != nil && *channel.ParentID == categoryID {
+			children = append(children, channel)
+		}
+	}
+
+	sort.SliceStable(children, func(i, j int) bool {
+		if children[i].Position != children[j].Position {
+			return children[i].Position < children[j].Position
+		}
+		return children[i].ID.Compare(children[j].ID) < 0
+	})
+
+	return children
+}
+
+// GetCategoryChildren - Returns the channels in the category, in the order they are listed in the client. Does not include threads.
+func (g *Guild) GetCategoryChildren(categoryID Snowflake) ([]*Channel, error) {
+	channels, err := g.GetGuildChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	return ChildrenOf(channels, categoryID), nil
+}
+
+// MoveChannel - Moves a channel into a category, or out of its category when categoryID is nil.
+//
+// With syncPermissions the channel takes the overwrites of its new category; otherwise its own overwrites are kept.
+//
+// Requires the ManageChannels permission. Fires a ChannelUpdate Gateway event.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (g *Guild) MoveChannel(channelID Snowflake, categoryID *Snowflake, syncPermissions bool, reason *string) error {
+	parentID := Null[Snowflake]()
+	if categoryID != nil {
+		parentID = NewNullable(*categoryID)
+	}
+
+	return g.ModifyGuildChannelPositions([]*ModifyGuildChannelPositionsJSON{{
+		ID:              channelID,
+		ParentID:        parentID,
+		LockPermissions: &syncPermissions,
+	}}, reason)
+}
+
+// SyncPermissions - Replaces the channel's permission overwrites with those of its category, as "Sync Now" does in the client.
+//
+// The category is fetched unless it is passed as parent. Requires the ManageRoles permission. Returns the updated channel.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (c *Channel) SyncPermissions(parent *Channel, reason *string) (*Channel, error) {
+	if c.ParentID == nil {
+		return nil, ErrNoParent
+	}
+
+	if parent == nil {
+		var err error
+		if parent, err = (&Channel{ID: *c.ParentID}).GetChannel(); err != nil {
+			return nil, err
+		}
+	}
+	if parent.ID != *c.ParentID {
+		return nil, fmt.Errorf("channel %s is not in category %s", c.ID, parent.ID)
+	}
+
+	// Always send the list, as an empty one clears the channel's overwrites
+	overwrites := parent.PermissionOverwrites
+	if overwrites == nil {
+		overwrites = []*Overwrite{}
+	}
+
+	return c.modifyChannel(struct {
+		PermissionOverwrites []*Overwrite `json:"permission_overwrites"`
+	}{overwrites}, reason)
+}
+
+// IsSynced - Checks whether the channel has exactly the permission overwrites of the category, in any order
+func (c *Channel) IsSynced(parent *Channel) bool {
+	if c.ParentID == nil || *c.ParentID != parent.ID || len(c.PermissionOverwrites) != len(parent.PermissionOverwrites) {
+		return false
+	}
+
+	overwrites := make(map[Snowflake]Overwrite, len(parent.PermissionOverwrites))
+	for _, overwrite := range parent.PermissionOverwrites {
+		overwrites[overwrite.ID] = *overwrite
+	}
+
+	for _, overwrite := range c.PermissionOverwrites {
+		if other, ok := overwrites[overwrite.ID]; !ok || other != *overwrite {
+			return false
+		}
+	}
+
+	return true
+}
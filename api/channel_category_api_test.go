@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import "testing"
+
+func TestChildrenOf(t *testing.T) {
+	category := Snowflake("10")
+	channels := []*Channel{
+		{ID: "3", ParentID: &category, Position: 1},
+		{ID: "10", Type: GuildCategory},
+		{ID: "2", ParentID: &category, Position: 0},
+		{ID: "4"},
+	}
+
+	children := ChildrenOf(channels, category)
+	if len(children) != 2 || children[0].ID != "2" || children[1].ID != "3" {
+		t.Errorf("ChildrenOf() = %v", children)
+	}
+}
+
+func TestChannelIsSynced(t *testing.T) {
+	category := &Channel{ID: "10", PermissionOverwrites: []*Overwrite{
+		{ID: "1", Type: PermissionRole, Allow: "1024", Deny: "0"},
+		{ID: "2", Type: PermissionMember, Allow: "0", Deny: "2048"},
+	}}
+
+	tests := []struct {
+		name       string
+		overwrites []*Overwrite
+		want       bool
+	}{
+		{
+			name:       "Same, reordered",
+			overwrites: []*Overwrite{category.PermissionOverwrites[1], category.PermissionOverwrites[0]},
+			want:       true,
+		},
+		{
+			name:       "Different bits",
+			overwrites: []*Overwrite{category.PermissionOverwrites[0], {ID: "2", Type: PermissionMember, Allow: "0", Deny: "0"}},
+		},
+		{
+			name:       "Missing overwrite",
+			overwrites: []*Overwrite{category.PermissionOverwrites[0]},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			channel := &Channel{ID: "3", ParentID: &category.ID, PermissionOverwrites: tt.overwrites}
+			if got := channel.IsSynced(category); got != tt.want {
+				t.Errorf("IsSynced() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
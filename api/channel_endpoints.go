@@ -15,12 +15,14 @@
 package api
 
 import (
-	"encoding/json"
+	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
 	"time"
+	"unicode/utf8"
 
 	log "github.com/veteran-software/nowlive-logging"
 )
@@ -38,7 +40,7 @@ func GetChannel(channelID *Snowflake) (*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -58,7 +60,7 @@ func (c *Channel) GetChannel() (*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -69,8 +71,8 @@ func (c *Channel) ModifyGroupDm(payload ModifyGroupDmJSON, reason *string) (*Cha
 }
 
 type ModifyGroupDmJSON struct {
-	Name string `json:"name"` // 1-100 character channel name
-	Icon string `json:"icon"` // base64 encoded icon
+	Name string            `json:"name,omitempty"` // 1-100 character channel name
+	Icon *Nullable[string] `json:"icon,omitempty"` // the icon as a data URI; Null removes it
 }
 
 func (c *Channel) ModifyGuildTextChannel(payload ModifyTextChannelJSON, reason *string) (*Channel, error) {
@@ -86,40 +88,62 @@ func (c *Channel) ModifyThread(payload ModifyThreadJSON, reason *string) (*Chann
 	return c.modifyChannel(payload, reason)
 }
 
+// ModifyGuildForumChannel - Update the settings of a GuildForum or GuildMedia channel, including its available tags.
+func (c *Channel) ModifyGuildForumChannel(payload ModifyGuildForumChannelJSON, reason *string) (*Channel, error) {
+	return c.modifyChannel(payload, reason)
+}
+
 func (c *Channel) ModifyGuildVoiceChannel(payload ModifyGuildVoiceChannelJSON, reason *string) (*Channel, error) {
 	return c.modifyChannel(payload, reason)
 }
 
 type ModifyAllChannelJSON struct {
-	Name                 string       `json:"name"`                  // 1-100 character channel name
-	Position             *int         `json:"position"`              // the position of the channel in the left-hand listing
-	PermissionOverwrites []*Overwrite `json:"permission_overwrites"` // channel or category-specific permissions
+	Name                 string         `json:"name,omitempty"`                  // 1-100 character channel name
+	Position             *Nullable[int] `json:"position,omitempty"`              // the position of the channel in the left-hand listing
+	PermissionOverwrites []*Overwrite   `json:"permission_overwrites,omitempty"` // channel or category-specific permissions
 }
 
 type ModifyAnnouncementChannelJSON struct {
 	ModifyAllChannelJSON
 
-	Type                       ChannelType `json:"type"`                          // the type of channel; only conversion between text and announcement is supported and only in guilds with the "NEWS" feature
-	Topic                      *string     `json:"topic"`                         // 0-1024 character channel topic
-	Nsfw                       *bool       `json:"nsfw"`                          // whether the channel is nsfw
-	ParentID                   *Snowflake  `json:"parent_id"`                     // id of the new parent category for a channel
-	DefaultAutoArchiveDuration *uint64     `json:"default_auto_archive_duration"` // the default duration that the clients use (not the API) for newly created threads in the channel, in minutes, to automatically archive the thread after recent activity
+	Type                       *ChannelType         `json:"type,omitempty"`                          // the type of channel; only conversion between text and announcement is supported and only in guilds with the "NEWS" feature
+	Topic                      *Nullable[string]    `json:"topic,omitempty"`                         // 0-1024 character channel topic; Null removes it
+	Nsfw                       *bool                `json:"nsfw,omitempty"`                          // whether the channel is nsfw
+	ParentID                   *Nullable[Snowflake] `json:"parent_id,omitempty"`                     // id of the new parent category for a channel; Null moves it out of its category
+	DefaultAutoArchiveDuration *Nullable[uint64]    `json:"default_auto_archive_duration,omitempty"` // the default duration that the clients use (not the API) for newly created threads in the channel, in minutes, to automatically archive the thread after recent activity
 }
 
 type ModifyTextChannelJSON struct {
 	ModifyAnnouncementChannelJSON
 
-	RateLimitPerUser *uint64 `json:"rate_limit_per_user"` // amount of seconds a user has to wait before sending another message (0-21600); bots, as well as users with the permission ManageMessages, or ManageChannels, are unaffected
+	RateLimitPerUser *Nullable[uint64] `json:"rate_limit_per_user,omitempty"` // amount of seconds a user has to wait before sending another message (0-21600); bots, as well as users with the permission ManageMessages, or ManageChannels, are unaffected
 }
 
 type ModifyGuildVoiceChannelJSON struct {
 	ModifyAllChannelJSON
 
-	Bitrate          *uint64          `json:"bitrate"`            // the bitrate (in bits) of the voice channel; 8000 to 96000 (128000 for VIP servers)
-	UserLimit        *uint            `json:"user_limit"`         // the user limit of the voice channel; 0 refers to no limit, 1 to 99 refers to a user limit
-	ParentID         *Snowflake       `json:"parent_id"`          // id of the new parent category for a channel
-	RtcRegion        *string          `json:"rtc_region"`         // channel voice region id, automatic when set to null
-	VideoQualityMode VideoQualityMode `json:"video_quality_mode"` // the camera video quality mode of the voice channel
+	Bitrate          *Nullable[uint64]    `json:"bitrate,omitempty"`            // the bitrate (in bits) of the voice channel; 8000 to 96000 (128000 for VIP servers)
+	UserLimit        *Nullable[uint]      `json:"user_limit,omitempty"`         // the user limit of the voice channel; 0 refers to no limit, 1 to 99 refers to a user limit
+	ParentID         *Nullable[Snowflake] `json:"parent_id,omitempty"`          // id of the new parent category for a channel; Null moves it out of its category
+	RtcRegion        *Nullable[string]    `json:"rtc_region,omitempty"`         // channel voice region id; Null sets it to automatic
+	VideoQualityMode VideoQualityMode     `json:"video_quality_mode,omitempty"` // the camera video quality mode of the voice channel
+}
+
+// ModifyGuildForumChannelJSON - fields that can be updated on a GuildForum or GuildMedia channel
+type ModifyGuildForumChannelJSON struct {
+	ModifyAllChannelJSON
+
+	Topic                         *Nullable[string]          `json:"topic,omitempty"`                              // 0-4096 character channel topic; Null removes it
+	Nsfw                          *bool                      `json:"nsfw,omitempty"`                               // whether the channel is nsfw
+	RateLimitPerUser              *uint64                    `json:"rate_limit_per_user,omitempty"`                // amount of seconds a user has to wait before creating another thread (0-21600)
+	ParentID                      *Nullable[Snowflake]       `json:"parent_id,omitempty"`                          // id of the new parent category for a channel; Null moves it out of its category
+	DefaultAutoArchiveDuration    *uint64                    `json:"default_auto_archive_duration,omitempty"`      // the default duration that the clients use (not the API) for newly created threads in the channel, in minutes
+	Flags                         *ChannelFlag               `json:"flags,omitempty"`                              // channel flags combined as a bitfield; RequireTag and HideMediaDownloadOptions are supported
+	AvailableTags                 []*ForumTag                `json:"available_tags,omitempty"`                     // the set of tags that can be used in the channel; limited to 20
+	DefaultReactionEmoji          *Nullable[DefaultReaction] `json:"default_reaction_emoji,omitempty"`             // the emoji to show in the add reaction button on a thread; Null removes it
+	DefaultThreadRateLimitPerUser *uint64                    `json:"default_thread_rate_limit_per_user,omitempty"` // the initial RateLimitPerUser to set on newly created threads in the channel
+	DefaultSortOrder              *Nullable[SortOrderType]   `json:"default_sort_order,omitempty"`                 // the default SortOrderType used to order posts; Null resets it
+	DefaultForumLayout            *ForumLayoutType           `json:"default_forum_layout,omitempty"`               // the default ForumLayoutType used to display posts; GuildForum channels only
 }
 
 // modifyChannel - Update a channel's settings. Returns a channel on success, and a 400 BAD REQUEST on invalid parameters. All JSON parameters are optional.
@@ -134,7 +158,7 @@ func (c *Channel) modifyChannel(payload any, reason *string) (*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -146,12 +170,14 @@ func (c *Channel) modifyChannel(payload any, reason *string) (*Channel, error) {
 //
 //goland:noinspection SpellCheckingInspection
 type ModifyThreadJSON struct {
-	Name                string `json:"name"`                  // 1-100 character channel name
-	Archived            bool   `json:"archived"`              // whether the thread is archived
-	AutoArchiveDuration int    `json:"auto_archive_duration"` // duration in minutes to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
-	Locked              bool   `json:"locked"`                // whether the thread is locked; when a thread is locked, only users with MANAGE_THREADS can unarchive it
-	Invitable           bool   `json:"invitable"`             // whether non-moderators can add other non-moderators to a thread; only available on private threads
-	RateLimitPerUser    *int   `json:"rate_limit_per_user"`   // amount of seconds a user has to wait before sending another message (0-21600); bots, as well as users with the permission manage_messages, manage_thread, or manage_channel, are unaffected
+	Name                string      `json:"name,omitempty"`                  // 1-100 character channel name
+	Archived            *bool       `json:"archived,omitempty"`              // whether the thread is archived
+	AutoArchiveDuration *int        `json:"auto_archive_duration,omitempty"` // duration in minutes to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
+	Locked              *bool       `json:"locked,omitempty"`                // whether the thread is locked; when a thread is locked, only users with MANAGE_THREADS can unarchive it
+	Invitable           *bool       `json:"invitable,omitempty"`             // whether non-moderators can add other non-moderators to a thread; only available on private threads
+	RateLimitPerUser    *int        `json:"rate_limit_per_user,omitempty"`   // amount of seconds a user has to wait before sending another message (0-21600); bots, as well as users with the permission manage_messages, manage_thread, or manage_channel, are unaffected
+	Flags               ChannelFlag `json:"flags,omitempty"`                 // channel flags combined as a bitfield; Pinned can only be set for threads in forum and media channels
+	AppliedTags         []Snowflake `json:"applied_tags,omitempty"`          // the IDs of the set of tags that have been applied to a thread in a GuildForum or a GuildMedia channel; limited to 5
 }
 
 // DeleteChannel - Delete a channel, or close a private message.
@@ -184,6 +210,8 @@ func (c *Channel) DeleteChannel(reason *string) error {
 // SUPPORTS: "around : Snowflake"; "before : Snowflake"; "after : Snowflake"; "limit : int" ; nil
 //
 //	The before, after, and around keys are mutually exclusive, only one may be passed at a time.
+//	Limit must be between 1 and 100; Discord defaults to 50 when it is omitted.
+//	Without the MessageContent privileged intent, Content, Embeds, Attachments and Components are empty for messages that do not mention the bot.
 //
 // TODO: Check permissions; required ViewChannel and ReadMessageHistory
 func (c *Channel) GetChannelMessages(around *Snowflake,
@@ -193,6 +221,19 @@ func (c *Channel) GetChannelMessages(around *Snowflake,
 	[]*Message,
 	error,
 ) {
+	cursors := 0
+	for _, cursor := range []*Snowflake{around, before, after} {
+		if cursor != nil {
+			cursors++
+		}
+	}
+	if cursors > 1 {
+		return nil, errors.New("around, before, and after are mutually exclusive")
+	}
+	if limit != nil && (*limit < 1 || *limit > maxMessagesPerPage) {
+		return nil, errors.New("limit must be between 1 and 100")
+	}
+
 	u := parseRoute(fmt.Sprintf(getChannelMessages, api, c.ID.String()))
 
 	q := u.Query()
@@ -219,11 +260,46 @@ func (c *Channel) GetChannelMessages(around *Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &messages)
+	err = decodeResponse(u, responseBytes, &messages)
 
 	return messages, err
 }
 
+// maxMessagesPerPage - the largest page GetChannelMessages will return
+const maxMessagesPerPage = 100
+
+// FetchAllMessagesSince - Walks the channel history backwards from the newest message, calling fn for each message sent at or after since.
+//
+// Pages are requested 100 at a time through the shared rate limiter, so long histories are throttled rather than rejected.
+//
+// Iteration stops when a message older than since is reached, the history is exhausted, or fn returns false.
+func (c *Channel) FetchAllMessagesSince(since time.Time, fn func(message *Message) bool) error {
+	var before *Snowflake
+	limit := maxMessagesPerPage
+
+	for {
+		messages, err := c.GetChannelMessages(nil, before, nil, &limit)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if message.Timestamp.Before(since) {
+				return nil
+			}
+			if !fn(message) {
+				return nil
+			}
+		}
+
+		if len(messages) < maxMessagesPerPage {
+			return nil
+		}
+
+		before = &messages[len(messages)-1].ID
+	}
+}
+
 // GetChannelMessage - Returns a specific message in the channel.
 //
 // If operating on a guild channel, this endpoint requires the 'READ_MESSAGE_HISTORY' permission to be present on the current user.
@@ -239,7 +315,7 @@ func (c *Channel) GetChannelMessage(messageID string) (*Message, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
@@ -277,6 +353,10 @@ func (c *Channel) GetChannelMessage(messageID string) (*Message, error) {
 //
 // If you supply a payload_json form value, all fields except for file fields will be ignored in the form data.
 func (c *Channel) CreateMessage(payload CreateMessageJSON) (*Message, error) {
+	if !c.Type.IsTextBased() {
+		return nil, ErrNotTextBased
+	}
+
 	u := parseRoute(fmt.Sprintf(createMessage, api, c.ID.String()))
 
 	var message *Message
@@ -286,24 +366,121 @@ func (c *Channel) CreateMessage(payload CreateMessageJSON) (*Message, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
 
+// CreateMessageWithFiles - Post a message with files attached, sent as multipart/form-data.
+//
+// Each file is sent as files[n]; when payload.Attachments is empty it is built from the files, including their descriptions.
+//
+// Files are checked against the channel's upload limit before anything is sent; see maxUploadSize.
+func (c *Channel) CreateMessageWithFiles(payload CreateMessageJSON, files []*File) (*Message, error) {
+	if !c.Type.IsTextBased() {
+		return nil, ErrNotTextBased
+	}
+	if len(payload.Attachments) == 0 {
+		payload.Attachments = attachmentsFor(files)
+	}
+
+	u := parseRoute(fmt.Sprintf(createMessage, api, c.ID.String()))
+
+	var message *Message
+	responseBytes, err := firePostMultipartRequest(u, payload, files, c.maxUploadSize(files), nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &message)
+
+	return message, err
+}
+
+// maxWaveformSamples - Discord renders at most 256 waveform samples for a voice message
+const maxWaveformSamples = 256
+
+// SendVoiceMessage - Post an OGG/Opus audio file as a voice message.
+//
+// The waveform is up to 256 samples of one byte each, rendered as the bars of the voice message; it may be nil.
+//
+// Voice messages cannot have content, embeds, stickers or components, so only the audio file is sent.
+func (c *Channel) SendVoiceMessage(file *File, durationSecs float64, waveform []byte) (*Message, error) {
+	if file == nil || file.Reader == nil {
+		return nil, errors.New("a voice message requires an audio file")
+	}
+	if len(waveform) > maxWaveformSamples {
+		return nil, errors.New("waveform cannot have more than 256 samples")
+	}
+
+	voice := *file
+	if voice.Name == "" {
+		voice.Name = "voice-message.ogg"
+	}
+	if voice.ContentType == "" {
+		voice.ContentType = "audio/ogg"
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(waveform)
+	payload := CreateMessageJSON{
+		Flags: IsVoiceMessage,
+		Attachments: []*Attachment{
+			{
+				ID:           "0",
+				Filename:     voice.Name,
+				DurationSecs: &durationSecs,
+				Waveform:     &encoded,
+			},
+		},
+	}
+
+	return c.CreateMessageWithFiles(payload, []*File{&voice})
+}
+
+// maxUploadSize - Returns the upload limit for the channel.
+//
+// The guild is only fetched when a file is larger than DefaultMaxUploadSize or its size is unknown, as only boosted guilds allow more.
+func (c *Channel) maxUploadSize(files []*File) int64 {
+	if c.GuildID == "" || fitsUploadSize(files, DefaultMaxUploadSize) {
+		return DefaultMaxUploadSize
+	}
+
+	guild, err := (&Guild{ID: c.GuildID}).GetGuild(nil)
+	if err != nil || guild == nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return DefaultMaxUploadSize
+	}
+
+	return guild.MaxUploadSize()
+}
+
+// ForwardMessage - Forwards a message into this channel as a message snapshot.
+//
+// The current user must be able to read the original message.
+func (c *Channel) ForwardMessage(message *Message) (*Message, error) {
+	return c.CreateMessage(CreateMessageJSON{
+		MessageReference: &MessageReference{
+			Type:      Forward,
+			MessageID: message.ID,
+			ChannelID: message.ChannelID,
+		},
+	})
+}
+
 // CreateMessageJSON - JSON payload structure
 // TODO: files[n]
 type CreateMessageJSON struct {
 	Content          string            `json:"content,omitempty"`           // the message contents (up to 2000 characters)
 	TTS              bool              `json:"tts,omitempty"`               // true if this is a TTS message
 	Embeds           []*Embed          `json:"embeds,omitempty"`            // embedded rich content (up to 6000 characters)
-	AllowedMentions  AllowedMentions   `json:"allowed_mentions,omitempty"`  // allowed mentions for the message
+	AllowedMentions  *AllowedMentions  `json:"allowed_mentions,omitempty"`  // allowed mentions for the message
 	MessageReference *MessageReference `json:"message_reference,omitempty"` // include to make your message a reply
 	Components       []*Component      `json:"components,omitempty"`        // the components to include with the message
 	StickerIDs       []*Snowflake      `json:"sticker_ids,omitempty"`       // IDs of up to 3 stickers in the server to send in the message
 	PayloadJson      string            `json:"payload_json,omitempty"`      // JSON encoded body of non-file params
 	Attachments      []*Attachment     `json:"attachments,omitempty"`       // attachment objects with filename and description
-	Flags            MessageFlags      `json:"flags,omitempty"`             // message flags combined as a bitfield (only SUPPRESS_EMBEDS can be set)
+	Flags            MessageFlags      `json:"flags,omitempty"`             // message flags combined as a bitfield (only SUPPRESS_EMBEDS, SUPPRESS_NOTIFICATIONS and IS_VOICE_MESSAGE can be set)
 }
 
 // CrosspostMessage - Crosspost a message in an GuildAnnouncement Channel to following channels.
@@ -323,7 +500,7 @@ func (c *Channel) CrosspostMessage(messageID string) (*Message, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
@@ -419,7 +596,7 @@ func (c *Channel) GetReactions(messageID Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &users)
+	err = decodeResponse(u, responseBytes, &users)
 
 	return users, err
 }
@@ -482,7 +659,7 @@ func (c *Channel) EditMessage(messageID string, payload EditMessageJSON) (*Messa
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
@@ -535,7 +712,7 @@ func (c *Channel) BulkDeleteMessages(payload BulkDeleteJSON, reason *string) err
 	}
 
 	for _, message := range payload.Messages {
-		if time.Since(time.Unix(message.ParseSnowflake().Timestamp, 0)).Hours() > float64(14*24) {
+		if !canBulkDelete(*message) {
 			return errors.New("cannot bulk delete message older than 2 weeks")
 		}
 	}
@@ -604,7 +781,7 @@ func (c *Channel) GetChannelInvites() ([]*Invite, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &invites)
+	err = decodeResponse(u, responseBytes, &invites)
 
 	return invites, err
 }
@@ -632,7 +809,7 @@ func (c *Channel) CreateChannelInvite(payload CreateChannelInviteJSON, reason *s
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &invite)
+	err = decodeResponse(u, responseBytes, &invite)
 
 	return invite, err
 }
@@ -670,17 +847,21 @@ func (c *Channel) DeleteChannelPermission(overwriteID Snowflake, reason *string)
 // Requires the ManageWebhooks permission in the target channel.
 //
 // Returns a followed channel object.
-func (c *Channel) FollowAnnouncementChannel(payload FollowAnnouncementChannelJSON) (*FollowedChannel, error) {
+//
+// Fires a WebhooksUpdate Gateway event for the target channel.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (c *Channel) FollowAnnouncementChannel(payload FollowAnnouncementChannelJSON, reason *string) (*FollowedChannel, error) {
 	u := parseRoute(fmt.Sprintf(followAnnouncementChannel, api, c.ID.String()))
 
 	var followedChannel *FollowedChannel
-	responseBytes, err := firePostRequest(u, payload, nil)
+	responseBytes, err := firePostRequest(u, payload, reason)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &followedChannel)
+	err = decodeResponse(u, responseBytes, &followedChannel)
 
 	return followedChannel, err
 }
@@ -699,6 +880,10 @@ type FollowAnnouncementChannelJSON struct {
 //
 // Fires a Typing Start Gateway event.
 func (c *Channel) TriggerTypingIndicator() error {
+	if !c.Type.IsTextBased() {
+		return ErrNotTextBased
+	}
+
 	u := parseRoute(fmt.Sprintf(triggerTypingIndicator, api, c.ID.String()))
 
 	_, err := firePostRequest(u, nil, nil)
@@ -710,6 +895,61 @@ func (c *Channel) TriggerTypingIndicator() error {
 	return nil
 }
 
+// typingRefreshInterval - Discord clears a typing indicator after roughly 10 seconds, so refresh a little before that
+const typingRefreshInterval = 8 * time.Second
+
+// KeepTyping - Triggers the typing indicator immediately and refreshes it every few seconds until the context is cancelled.
+//
+// Intended to wrap long-running handlers:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	go channel.KeepTyping(ctx)
+//	defer cancel()
+//
+// Errors from individual refreshes are logged and do not stop the loop.
+func (c *Channel) KeepTyping(ctx context.Context) {
+	ticker := time.NewTicker(typingRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.TriggerTypingIndicator(); err != nil {
+			log.Errorln(log.Discord, log.FuncName(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// VoiceStatusLimit - A voice channel status is up to 500 characters
+const VoiceStatusLimit = 500
+
+// SetVoiceChannelStatus - Sets the status shown under a voice channel's name, e.g. what is being played in it; an empty status clears it.
+//
+// Requires the SetVoiceChannelStatus permission, and ManageChannels when the current user is not connected to the channel.
+//
+// Returns a 204 empty response on success. Fires a Voice Channel Status Update Gateway event.
+func (c *Channel) SetVoiceChannelStatus(status string, reason *string) error {
+	if length := utf8.RuneCountInString(status); length > VoiceStatusLimit {
+		return &LimitError{Field: "status", Length: length, Limit: VoiceStatusLimit}
+	}
+
+	u := parseRoute(fmt.Sprintf(setVoiceChannelStatus, api, c.ID.String()))
+
+	_, err := firePutRequest(u, struct {
+		Status string `json:"status"`
+	}{Status: status}, reason)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return err
+	}
+
+	return nil
+}
+
 // GetPinnedMessages - Returns all pinned messages in the channel as an array of message objects.
 func (c *Channel) GetPinnedMessages() ([]*Message, error) {
 	u := parseRoute(fmt.Sprintf(getPinnedMessages, api, c.ID.String()))
@@ -721,7 +961,7 @@ func (c *Channel) GetPinnedMessages() ([]*Message, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &messages)
+	err = decodeResponse(u, responseBytes, &messages)
 
 	return messages, err
 }
@@ -827,7 +1067,7 @@ func (c *Channel) StartThreadWithMessage(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -860,7 +1100,7 @@ func (c *Channel) StartThreadWithoutMessage(payload StartThreadWithoutMessageJSO
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -890,7 +1130,7 @@ type StartThreadWithoutMessageJSON struct {
 //	Discord may strip certain characters from message content, like invalid unicode characters or characters which cause unexpected message formatting. If you are passing user-generated strings into message content, consider sanitizing the data to prevent unexpected behavior and utilizing allowed_mentions to prevent unexpected mentions.
 //
 //	This endpoint supports the X-Audit-Log-Reason header.
-func (c *Channel) StartThreadInForumOrMediaChannel(payload StartThreadWithoutMessageJSON, reason *string) (*Channel, error) {
+func (c *Channel) StartThreadInForumOrMediaChannel(payload StartThreadInForumJSON, reason *string) (*Channel, error) {
 	u := parseRoute(fmt.Sprintf(startThreadInForumChannel, api, c.ID.String()))
 
 	var channel *Channel
@@ -900,7 +1140,7 @@ func (c *Channel) StartThreadInForumOrMediaChannel(payload StartThreadWithoutMes
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -911,7 +1151,7 @@ type StartThreadInForumJSON struct {
 	AutoArchiveDuration uint64                          `json:"auto_archive_duration"`         // duration in minutes to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
 	RateLimitPerUser    *uint64                         `json:"rate_limit_per_user,omitempty"` // amount of seconds a user has to wait before sending another message (0-21600)
 	Message             ForumOrMediaThreadMessageParams `json:"message"`                       // contents of the first message in the forum thread
-	AppliedTags         []Snowflake                     `json:"applied_tags"`                  // the IDs of the set of tags that have been applied to a thread in a GuildForum or a GuildMedia channel
+	AppliedTags         []Snowflake                     `json:"applied_tags,omitempty"`        // the IDs of the set of tags that have been applied to a thread in a GuildForum or a GuildMedia channel
 	Files               []string                        `json:"files"`                         // Contents of the file being sent. See Uploading Files
 	PayloadJson         string                          `json:"payload_json"`                  // JSON-encoded body of non-file params, only for multipart/form-data requests. See Uploading Files
 }
@@ -920,13 +1160,13 @@ type StartThreadInForumJSON struct {
 //
 // TODO: files[n]
 type ForumOrMediaThreadMessageParams struct {
-	Content         string          `json:"content"`          // Message contents (up to 2000 characters)
-	Embeds          []*Embed        `json:"embeds"`           // Up to 10 rich embeds (up to 6000 characters)
-	AllowedMentions AllowedMentions `json:"allowed_mentions"` // Allowed mentions for the message
-	Components      []*Component    `json:"components"`       // Components to include with the message
-	StickerIDs      []*Snowflake    `json:"sticker_ids"`      // IDs of up to 3 stickers in the server to send in the message
-	Attachments     []*Attachment   `json:"attachments"`      // attachment objects with filename and description
-	Flags           MessageFlags    `json:"flags"`            // Message flags combined as a bitfield (only SuppressEmbeds and SuppressNotifications can be set)
+	Content         string           `json:"content"`                    // Message contents (up to 2000 characters)
+	Embeds          []*Embed         `json:"embeds"`                     // Up to 10 rich embeds (up to 6000 characters)
+	AllowedMentions *AllowedMentions `json:"allowed_mentions,omitempty"` // Allowed mentions for the message
+	Components      []*Component     `json:"components"`                 // Components to include with the message
+	StickerIDs      []*Snowflake     `json:"sticker_ids"`                // IDs of up to 3 stickers in the server to send in the message
+	Attachments     []*Attachment    `json:"attachments"`                // attachment objects with filename and description
+	Flags           MessageFlags     `json:"flags"`                      // Message flags combined as a bitfield (only SuppressEmbeds and SuppressNotifications can be set)
 }
 
 // JoinThread - Adds the current user to a thread.
@@ -1008,7 +1248,7 @@ func (c *Channel) GetThreadMember(userID Snowflake) (*ThreadMember, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadMember)
+	err = decodeResponse(u, responseBytes, &threadMember)
 
 	return threadMember, err
 }
@@ -1026,7 +1266,7 @@ func (c *Channel) ListThreadMembers() ([]*ThreadMember, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadMembers)
+	err = decodeResponse(u, responseBytes, &threadMembers)
 
 	return threadMembers, err
 }
@@ -1061,7 +1301,7 @@ func (c *Channel) ListPublicArchivedThreads(before *time.Time, limit *int) (*Thr
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadListResponse)
+	err = decodeResponse(u, responseBytes, &threadListResponse)
 
 	return threadListResponse, err
 }
@@ -1098,7 +1338,7 @@ func (c *Channel) ListPrivateArchivedThreads(before *time.Time, limit *int) (*Th
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadListResponse)
+	err = decodeResponse(u, responseBytes, &threadListResponse)
 
 	return threadListResponse, err
 }
@@ -1130,7 +1370,7 @@ func (c *Channel) ListJoinedPrivateArchivedThreads(before *Snowflake, limit *int
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadListResponse)
+	err = decodeResponse(u, responseBytes, &threadListResponse)
 
 	return threadListResponse, err
 }
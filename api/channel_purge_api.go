@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// bulkDeleteMaxAge - Discord refuses to bulk delete messages older than two weeks; a minute is kept in hand for clock skew and slow batches
+const bulkDeleteMaxAge = 14*24*time.Hour - time.Minute
+
+// canBulkDelete - Checks the message is young enough to be bulk deleted
+func canBulkDelete(messageID Snowflake) bool {
+	return time.Since(messageID.Timestamp()) < bulkDeleteMaxAge
+}
+
+// PurgeResult - counts of what Channel.Purge did
+type PurgeResult struct {
+	Scanned     int // messages read from the history
+	BulkDeleted int // messages removed with BulkDeleteMessages
+	Deleted     int // messages removed one at a time, as they were too old to bulk delete or were left alone in a batch
+}
+
+// Total - Returns the number of messages removed
+func (r PurgeResult) Total() int {
+	return r.BulkDeleted + r.Deleted
+}
+
+// Purge - Deletes up to limit messages matching the filter, newest first; a nil filter matches every message and a limit of 0 has no limit
+//
+// Messages younger than two weeks are bulk deleted 100 at a time; older ones are deleted one by one, paced by the bucket Discord shares between them.
+// Requires the ManageMessages permission. On error or cancellation, the result counts what was deleted before it.
+func (c *Channel) Purge(ctx context.Context, filter func(message *Message) bool, limit int, reason *string) (PurgeResult, error) {
+	var result PurgeResult
+	var batch []*Snowflake
+
+	flush := func() error {
+		switch len(batch) {
+		case 0:
+			return nil
+		case 1:
+			if err := c.DeleteMessage(batch[0].String(), reason); err != nil {
+				return err
+			}
+			result.Deleted++
+		default:
+			if err := c.BulkDeleteMessages(BulkDeleteJSON{Messages: batch}, reason); err != nil {
+				return err
+			}
+			result.BulkDeleted += len(batch)
+		}
+		batch = nil
+
+		return nil
+	}
+
+	var lastRoute string
+	deleteOld := func(messageID Snowflake) error {
+		if err := Rest.waitForBucket(ctx, lastRoute); err != nil {
+			return err
+		}
+		if err := c.DeleteMessage(messageID.String(), reason); err != nil {
+			return err
+		}
+		lastRoute = fmt.Sprintf(deleteMessage, api, c.ID.String(), messageID.String())
+		result.Deleted++
+
+		return nil
+	}
+
+	var before *Snowflake
+	pageSize := maxMessagesPerPage
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		messages, err := c.GetChannelMessages(nil, before, nil, &pageSize)
+		if err != nil {
+			return result, err
+		}
+
+		for _, message := range messages {
+			result.Scanned++
+			if filter != nil && !filter(message) {
+				continue
+			}
+			if limit > 0 && result.Total()+len(batch) >= limit {
+				break
+			}
+
+			id := message.ID
+			if canBulkDelete(id) {
+				batch = append(batch, &id)
+				if len(batch) == maxMessagesPerPage {
+					if err = flush(); err != nil {
+						return result, err
+					}
+				}
+				continue
+			}
+
+			// History is newest first, so every message from here on is too old to bulk delete
+			if err = flush(); err != nil {
+				return result, err
+			}
+			if err = deleteOld(id); err != nil {
+				return result, err
+			}
+		}
+
+		if len(messages) < maxMessagesPerPage || (limit > 0 && result.Total()+len(batch) >= limit) {
+			break
+		}
+		before = &messages[len(messages)-1].ID
+	}
+
+	return result, flush()
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// snowflakeAt - Returns a Snowflake created at the given time
+func snowflakeAt(t time.Time) Snowflake {
+	return Snowflake(strconv.FormatUint(uint64(t.UnixMilli()-discordEpoch)<<22, 10))
+}
+
+func TestChannelPurge(t *testing.T) {
+	now := time.Now()
+	page := []*Message{
+		{ID: snowflakeAt(now.Add(-time.Minute)), Content: "spam"},
+		{ID: snowflakeAt(now.Add(-2 * time.Minute)), Content: "keep"},
+		{ID: snowflakeAt(now.Add(-time.Hour)), Content: "spam"},
+		{ID: snowflakeAt(now.Add(-30 * 24 * time.Hour)), Content: "spam"},
+	}
+
+	var requests []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		requests = append(requests, req.Method+" "+req.URL.Path[strings.Index(req.URL.Path, "/channels/"):])
+
+		body := ""
+		if req.Method == http.MethodGet {
+			data, _ := json.Marshal(page)
+			body = string(data)
+		}
+		return http.StatusOK, body
+	})
+
+	result, err := (&Channel{ID: "1"}).Purge(context.Background(), func(m *Message) bool { return m.Content == "spam" }, 0, nil)
+	if err != nil {
+		t.Fatalf("Purge() error = %v", err)
+	}
+
+	if result.Scanned != 4 || result.BulkDeleted != 2 || result.Deleted != 1 || result.Total() != 3 {
+		t.Errorf("Purge() = %+v, want 4 scanned, 2 bulk deleted and 1 deleted", result)
+	}
+	want := []string{
+		"GET /channels/1/messages",
+		"POST /channels/1/messages/bulk-delete",
+		"DELETE /channels/1/messages/" + page[3].ID.String(),
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Purge() requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestChannelTypePredicates(t *testing.T) {
+	tests := []struct {
+		channelType ChannelType
+		thread      bool
+		voice       bool
+		textBased   bool
+	}{
+		{GuildText, false, false, true},
+		{DM, false, false, true},
+		{GuildVoice, false, true, true},
+		{GroupDM, false, false, true},
+		{GuildCategory, false, false, false},
+		{GuildAnnouncement, false, false, true},
+		{GuildAnnouncementThread, true, false, true},
+		{GuildPublicThread, true, false, true},
+		{GuildPrivateThread, true, false, true},
+		{GuildStageVoice, false, true, true},
+		{GuildDirectory, false, false, false},
+		{GuildForum, false, false, false},
+		{GuildMedia, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channelType.String(), func(t *testing.T) {
+			if got := tt.channelType.IsThread(); got != tt.thread {
+				t.Errorf("IsThread() = %v, want %v", got, tt.thread)
+			}
+			if got := tt.channelType.IsVoice(); got != tt.voice {
+				t.Errorf("IsVoice() = %v, want %v", got, tt.voice)
+			}
+			if got := tt.channelType.IsTextBased(); got != tt.textBased {
+				t.Errorf("IsTextBased() = %v, want %v", got, tt.textBased)
+			}
+		})
+	}
+}
+
+func TestCreateMessageNotTextBased(t *testing.T) {
+	channel := &Channel{ID: "1", Type: GuildForum}
+
+	if _, err := channel.CreateMessage(CreateMessageJSON{Content: "hi"}); !errors.Is(err, ErrNotTextBased) {
+		t.Errorf("CreateMessage() error = %v, want %v", err, ErrNotTextBased)
+	}
+	if err := channel.TriggerTypingIndicator(); !errors.Is(err, ErrNotTextBased) {
+		t.Errorf("TriggerTypingIndicator() error = %v, want %v", err, ErrNotTextBased)
+	}
+}
+
+func TestModifyThreadJSONOmitsUnset(t *testing.T) {
+	archived := false
+	got, err := json.Marshal(ModifyThreadJSON{Archived: &archived})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if string(got) != `{"archived":false}` {
+		t.Errorf("Marshal() = %s, want {\"archived\":false}", got)
+	}
+
+	if _, err := (&Channel{ID: "1"}).SetAutoArchiveDuration(30, nil); !errors.Is(err, ErrInvalidAutoArchiveDuration) {
+		t.Errorf("SetAutoArchiveDuration() error = %v, want %v", err, ErrInvalidAutoArchiveDuration)
+	}
+}
+
+func TestChannelMaxUploadSize(t *testing.T) {
+	var fetched int
+	stubRest(t, func(*http.Request) (int, string) {
+		fetched++
+		return http.StatusOK, `{"id":"7","premium_tier":2}`
+	})
+
+	tests := []struct {
+		name    string
+		channel *Channel
+		file    *File
+		want    int64
+		fetched int
+	}{
+		{name: "DM", channel: &Channel{ID: "1"}, file: &File{Reader: io.MultiReader()}, want: DefaultMaxUploadSize},
+		{name: "small file", channel: &Channel{ID: "1", GuildID: "7"}, file: &File{Reader: strings.NewReader("hi")}, want: DefaultMaxUploadSize},
+		{name: "unknown size", channel: &Channel{ID: "1", GuildID: "7"}, file: &File{Reader: io.MultiReader()}, want: 50 << 20, fetched: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetched = 0
+			if got := tt.channel.maxUploadSize([]*File{tt.file}); got != tt.want || fetched != tt.fetched {
+				t.Errorf("maxUploadSize() = %d after %d guild requests, want %d after %d", got, fetched, tt.want, tt.fetched)
+			}
+		})
+	}
+}
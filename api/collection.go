@@ -0,0 +1,197 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"sort"
+	"sync"
+)
+
+// Collection - a set of values keyed by Snowflake that is safe for concurrent use
+//
+// Reads return copies: Get returns the stored value and All, Values, Filter and SortBy build new maps and slices,
+// so callers can change what they read without racing other readers or writers. Values holding pointers still share what they point to.
+// A nil *Collection is empty; it can be read but not written.
+type Collection[T any] struct {
+	mu    sync.RWMutex
+	items map[Snowflake]T
+}
+
+// NewCollection - Creates an empty Collection
+func NewCollection[T any]() *Collection[T] {
+	return &Collection[T]{items: make(map[Snowflake]T)}
+}
+
+// CollectionOf - Creates a Collection holding a copy of the map
+func CollectionOf[T any](items map[Snowflake]T) *Collection[T] {
+	c := &Collection[T]{items: make(map[Snowflake]T, len(items))}
+	for id, v := range items {
+		c.items[id] = v
+	}
+
+	return c
+}
+
+// Get - Returns the value stored for the ID, and whether there is one
+func (c *Collection[T]) Get(id Snowflake) (T, bool) {
+	if c == nil {
+		var zero T
+		return zero, false
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	v, ok := c.items[id]
+	return v, ok
+}
+
+// Has - Reports whether a value is stored for the ID
+func (c *Collection[T]) Has(id Snowflake) bool {
+	_, ok := c.Get(id)
+	return ok
+}
+
+// Set - Stores the value for the ID, replacing any value already stored
+func (c *Collection[T]) Set(id Snowflake, v T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.items == nil {
+		c.items = make(map[Snowflake]T)
+	}
+	c.items[id] = v
+}
+
+// Delete - Removes the value stored for the ID
+func (c *Collection[T]) Delete(id Snowflake) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.items, id)
+}
+
+// Len - Returns the number of values stored
+func (c *Collection[T]) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return len(c.items)
+}
+
+// All - Returns a copy of the stored values, keyed by ID
+func (c *Collection[T]) All() map[Snowflake]T {
+	if c == nil {
+		return map[Snowflake]T{}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	items := make(map[Snowflake]T, len(c.items))
+	for id, v := range c.items {
+		items[id] = v
+	}
+
+	return items
+}
+
+// Values - Returns the stored values in ID order
+func (c *Collection[T]) Values() []T {
+	return c.Filter(nil)
+}
+
+// Filter - Returns the values the function keeps, in ID order; a nil function keeps every value
+//
+// The function runs after the lock is released, on a copy of the values, so it may read or change the Collection.
+func (c *Collection[T]) Filter(keep func(v T) bool) []T {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.RLock()
+	ids := make([]Snowflake, 0, len(c.items))
+	for id := range c.items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Compare(ids[j]) < 0 })
+
+	snapshot := make([]T, len(ids))
+	for i, id := range ids {
+		snapshot[i] = c.items[id]
+	}
+	c.mu.RUnlock()
+
+	if keep == nil {
+		return snapshot
+	}
+
+	values := make([]T, 0, len(snapshot))
+	for _, v := range snapshot {
+		if keep(v) {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// SortBy - Returns the values ordered by the less function; values it considers equal stay in ID order
+func (c *Collection[T]) SortBy(less func(a, b T) bool) []T {
+	values := c.Values()
+	sort.SliceStable(values, func(i, j int) bool { return less(values[i], values[j]) })
+
+	return values
+}
+
+// MapCollection - Returns the result of the function for each value of the Collection, in ID order
+//
+//goland:noinspection GoUnusedExportedFunction
+func MapCollection[T, R any](c *Collection[T], fn func(v T) R) []R {
+	values := c.Values()
+	results := make([]R, len(values))
+	for i, v := range values {
+		results[i] = fn(v)
+	}
+
+	return results
+}
+
+// MarshalJSON - Encodes the Collection as a JSON object keyed by ID, as Discord sends it
+func (c *Collection[T]) MarshalJSON() ([]byte, error) {
+	return JSONMarshal(c.All())
+}
+
+// UnmarshalJSON - Decodes a JSON object keyed by ID, replacing the stored values
+func (c *Collection[T]) UnmarshalJSON(data []byte) error {
+	var items map[Snowflake]T
+	if err := JSONUnmarshal(data, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		items = make(map[Snowflake]T)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.items = items
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCollection(t *testing.T) {
+	var resolved ResolvedData
+	if err := json.Unmarshal([]byte(`{"roles":{"3":{"id":"3","name":"Mods","position":2},"1":{"id":"1","name":"Admins","position":5},"2":{"id":"2","name":"Bots","position":1}}}`), &resolved); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	roles := resolved.Roles
+	if role, ok := roles.Get("3"); !ok || role.Name != "Mods" {
+		t.Errorf("Get(3) = %+v, %v", role, ok)
+	}
+	if _, ok := resolved.Users.Get("1"); ok || resolved.Users.Len() != 0 {
+		t.Error("Get() on a nil Collection found a value")
+	}
+
+	names := MapCollection(roles, func(r Role) string { return r.Name })
+	if len(names) != 3 || names[0] != "Admins" || names[2] != "Mods" {
+		t.Errorf("MapCollection() = %v, want ID order", names)
+	}
+	if high := roles.Filter(func(r Role) bool { return r.Position > 1 }); len(high) != 2 {
+		t.Errorf("Filter() = %v, want 2 roles", high)
+	}
+	if sorted := roles.SortBy(func(a, b Role) bool { return a.Position > b.Position }); sorted[0].Name != "Admins" || sorted[2].Name != "Bots" {
+		t.Errorf("SortBy() = %v", sorted)
+	}
+
+	all := roles.All()
+	delete(all, "1")
+	if !roles.Has("1") {
+		t.Error("All() returned the Collection's own map")
+	}
+
+	data, err := json.Marshal(&resolved)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded ResolvedData
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Roles.Len() != 3 || decoded.Users != nil {
+		t.Errorf("round trip = %s, %v", data, err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			roles.Set(Snowflake(strconv.Itoa(10+i)), Role{Name: "new"})
+			_ = roles.Values()
+			roles.Delete("2")
+		}(i)
+	}
+	wg.Wait()
+	if roles.Len() != 10 {
+		t.Errorf("Len() after concurrent writes = %d, want 10", roles.Len())
+	}
+}
+
+func TestCollectionFilterCallbackWrites(t *testing.T) {
+	c := CollectionOf(map[Snowflake]int{"1": 1, "2": 2, "3": 3})
+
+	done := make(chan []int)
+	go func() {
+		done <- c.Filter(func(v int) bool {
+			c.Set(Snowflake(strconv.Itoa(v*10)), v*10)
+			return v%2 == 1
+		})
+	}()
+
+	select {
+	case kept := <-done:
+		if len(kept) != 2 || kept[0] != 1 || kept[1] != 3 {
+			t.Errorf("Filter() = %v, want [1 3]", kept)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Filter() deadlocked when the function wrote to the Collection")
+	}
+
+	if got := c.Len(); got != 6 {
+		t.Errorf("Len() = %d, want 6", got)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// DecodeMode - how REST responses and gateway events treat JSON fields this package does not model
+type DecodeMode int32
+
+//goland:noinspection GoUnusedConst
+const (
+	DecodeLenient    DecodeMode = iota // unknown fields are dropped silently; the default
+	DecodeLogUnknown                   // unknown fields are logged as warnings with the route or event they came from, then dropped
+	DecodeStrict                       // unknown fields fail decoding with an UnknownFieldError
+)
+
+var decodeMode atomic.Int32
+
+// SetDecodeMode - Sets how unknown JSON fields are handled, e.g. DecodeLogUnknown in development to spot fields Discord has added
+//
+//goland:noinspection GoUnusedExportedFunction
+func SetDecodeMode(mode DecodeMode) {
+	decodeMode.Store(int32(mode))
+}
+
+// GetDecodeMode - Returns how unknown JSON fields are handled
+func GetDecodeMode() DecodeMode {
+	return DecodeMode(decodeMode.Load())
+}
+
+// UnknownFieldError - returned in DecodeStrict mode when a payload has a field its type does not model
+//
+// Only the first unknown field is reported, as encoding/json stops at it.
+type UnknownFieldError struct {
+	Source string // the route or event the payload came from
+	Field  string // the unknown field, as named in the payload
+	Err    error  // the error from encoding/json
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("%s: unknown field %s", e.Source, e.Field)
+}
+
+func (e *UnknownFieldError) Unwrap() error {
+	return e.Err
+}
+
+// decodeReaders - readers reused by the DecodeLogUnknown and DecodeStrict modes, which decode every event through a json.Decoder
+var decodeReaders = sync.Pool{New: func() any { return new(bytes.Reader) }}
+
+// Unmarshal - Decodes a payload according to the DecodeMode; source names the route or event it came from, for logs and errors
+//
+// Custom UnmarshalJSON methods decode their own fields, so unknown fields inside those types are not detected.
+func Unmarshal(source string, data []byte, v any) error {
+	mode := GetDecodeMode()
+	if mode == DecodeLenient {
+		return JSONUnmarshal(data, v)
+	}
+
+	reader := decodeReaders.Get().(*bytes.Reader)
+	reader.Reset(data)
+	defer func() {
+		reader.Reset(nil)
+		decodeReaders.Put(reader)
+	}()
+
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(v)
+	if err == nil {
+		return nil
+	}
+
+	field, ok := strings.CutPrefix(err.Error(), "json: unknown field ")
+	if !ok {
+		return err
+	}
+	if mode == DecodeStrict {
+		return &UnknownFieldError{Source: source, Field: field, Err: err}
+	}
+
+	log.Warnln(log.Discord, log.FuncName(), source, "has unknown field", field)
+
+	// Decode again, keeping the fields that are known
+	return JSONUnmarshal(data, v)
+}
+
+// decodeResponse - decodes a REST response, naming the route in unknown field reports
+func decodeResponse(u *url.URL, data []byte, v any) error {
+	source := "response"
+	if u != nil {
+		source = u.Path
+	}
+
+	return Unmarshal(source, data, v)
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalDecodeModes(t *testing.T) {
+	defer SetDecodeMode(GetDecodeMode())
+
+	data := []byte(`{"id":"1","username":"Nelly","brand_new_field":true}`)
+
+	tests := []struct {
+		name    string
+		mode    DecodeMode
+		wantErr bool
+	}{
+		{name: "Lenient", mode: DecodeLenient},
+		{name: "Log unknown", mode: DecodeLogUnknown},
+		{name: "Strict", mode: DecodeStrict, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDecodeMode(tt.mode)
+
+			var user User
+			err := Unmarshal("/users/1", data, &user)
+
+			var unknown *UnknownFieldError
+			if tt.wantErr {
+				if !errors.As(err, &unknown) || unknown.Field != `"brand_new_field"` || unknown.Source != "/users/1" {
+					t.Errorf("Unmarshal() error = %v, want an UnknownFieldError", err)
+				}
+				return
+			}
+			if err != nil || user.Username != "Nelly" {
+				t.Errorf("Unmarshal() = %+v, %v", user, err)
+			}
+		})
+	}
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	defer SetJSONCodec(nil, nil)
+
+	var marshalled, unmarshalled int
+	SetJSONCodec(func(v any) ([]byte, error) {
+		marshalled++
+		return json.Marshal(v)
+	}, func(data []byte, v any) error {
+		unmarshalled++
+		return json.Unmarshal(data, v)
+	})
+
+	var user User
+	if err := Unmarshal("/users/1", []byte(`{"id":"1","username":"Nelly"}`), &user); err != nil || user.Username != "Nelly" {
+		t.Errorf("Unmarshal() = %+v, %v", user, err)
+	}
+	if _, err := processBody(&user, nil); err != nil {
+		t.Errorf("processBody() error = %v", err)
+	}
+	if marshalled != 1 || unmarshalled != 1 {
+		t.Errorf("codec called %d times to marshal and %d to unmarshal, want 1 each", marshalled, unmarshalled)
+	}
+
+	SetJSONCodec(nil, nil)
+	if _, err := JSONMarshal(&user); err != nil || marshalled != 1 {
+		t.Errorf("JSONMarshal() after restoring encoding/json used the old codec")
+	}
+}
+
+func BenchmarkUnmarshalStrict(b *testing.B) {
+	defer SetDecodeMode(GetDecodeMode())
+	SetDecodeMode(DecodeStrict)
+
+	data := []byte(`{"id":"1","username":"Nelly","discriminator":"0","global_name":"Nelly"}`)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var user User
+		if err := Unmarshal("/users/1", data, &user); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
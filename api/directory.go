@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+// DirectoryEntry - a server or event listed in a GuildDirectory channel of a Student Hub
+//
+// Undocumented as of 10/15/2026; the structure follows what the Discord client receives.
+type DirectoryEntry struct {
+	Type                DirectoryEntryType   `json:"type"`                            // whether the entry is a guild or a scheduled event
+	DirectoryChannelID  Snowflake            `json:"directory_channel_id"`            // the GuildDirectory channel the entry is listed in
+	EntityID            Snowflake            `json:"entity_id"`                       // the id of the listed guild or scheduled event
+	CreatedAt           Timestamp            `json:"created_at"`                      // when the entry was listed
+	PrimaryCategoryID   DirectoryCategory    `json:"primary_category_id"`             // the category the entry is listed under
+	Description         *string              `json:"description,omitempty"`           // the description shown for the entry (up to 200 characters)
+	AuthorID            Snowflake            `json:"author_id"`                       // the user who listed the entry
+	Guild               *Guild               `json:"guild,omitempty"`                 // the listed guild, for DirectoryEntryGuild entries
+	GuildScheduledEvent *GuildScheduledEvent `json:"guild_scheduled_event,omitempty"` // the listed event, for DirectoryEntryScheduledEvent entries
+}
+
+// DirectoryEntryType - what a DirectoryEntry lists
+type DirectoryEntryType int
+
+//goland:noinspection GoUnusedConst
+const (
+	DirectoryEntryGuild          DirectoryEntryType = iota // the entry lists a guild
+	DirectoryEntryScheduledEvent                           // the entry lists a guild scheduled event
+)
+
+// DirectoryCategory - the category a Student Hub entry is listed under
+type DirectoryCategory int
+
+//goland:noinspection GoUnusedConst
+const (
+	DirectoryCategoryUncategorized DirectoryCategory = iota     // the entry has no category
+	DirectoryCategorySchoolClub                                 // a school club or organization
+	DirectoryCategoryClass                                      // a class or course
+	DirectoryCategoryStudySocial                                // a study or social group
+	DirectoryCategoryMiscellaneous DirectoryCategory = iota + 1 // anything else
+)
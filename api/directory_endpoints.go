@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// The endpoints in this file are undocumented as of 10/15/2026; Discord may change or remove them without notice.
+
+// ListDirectoryEntries - Returns the entries listed in a GuildDirectory channel, optionally only those of one type or category.
+func (c *Channel) ListDirectoryEntries(entryType *DirectoryEntryType, category *DirectoryCategory) ([]*DirectoryEntry, error) {
+	u := parseRoute(fmt.Sprintf(listDirectoryEntries, api, c.ID.String()))
+
+	q := u.Query()
+	if entryType != nil {
+		q.Set("type", strconv.Itoa(int(*entryType)))
+	}
+	if category != nil {
+		q.Set("category_id", strconv.Itoa(int(*category)))
+	}
+	if len(q) > 0 {
+		u.RawQuery = q.Encode()
+	}
+
+	var entries []*DirectoryEntry
+	responseBytes, err := fireGetRequest(u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &entries)
+
+	return entries, err
+}
+
+// SearchDirectoryEntries - Returns the entries in a GuildDirectory channel whose name or description matches the query.
+func (c *Channel) SearchDirectoryEntries(query string, entryType *DirectoryEntryType, category *DirectoryCategory) (
+	[]*DirectoryEntry,
+	error,
+) {
+	u := parseRoute(fmt.Sprintf(searchDirectoryEntries, api, c.ID.String()))
+
+	q := u.Query()
+	q.Set("query", query)
+	if entryType != nil {
+		q.Set("type", strconv.Itoa(int(*entryType)))
+	}
+	if category != nil {
+		q.Set("category_id", strconv.Itoa(int(*category)))
+	}
+	u.RawQuery = q.Encode()
+
+	var entries []*DirectoryEntry
+	responseBytes, err := fireGetRequest(u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &entries)
+
+	return entries, err
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestChannelListDirectoryEntries(t *testing.T) {
+	var route string
+	stubRest(t, func(req *http.Request) (int, string) {
+		route = strings.TrimPrefix(req.URL.String(), api)
+
+		body := `[{"type":0,"directory_channel_id":"1","entity_id":"2","created_at":"2024-01-02T03:04:05.000000+00:00",` +
+			`"primary_category_id":5,"author_id":"3","guild":{"id":"2","name":"Chess club","hub_type":2}}]`
+		return http.StatusOK, body
+	})
+
+	category := DirectoryCategoryMiscellaneous
+	entries, err := (&Channel{ID: "1", Type: GuildDirectory}).ListDirectoryEntries(nil, &category)
+	if err != nil {
+		t.Fatalf("ListDirectoryEntries() error = %v", err)
+	}
+
+	if route != "/channels/1/directory-entries?category_id=5" {
+		t.Errorf("ListDirectoryEntries() route = %q, want /channels/1/directory-entries?category_id=5", route)
+	}
+	if len(entries) != 1 || entries[0].PrimaryCategoryID != DirectoryCategoryMiscellaneous || entries[0].Guild.Name != "Chess club" {
+		t.Fatalf("ListDirectoryEntries() = %+v, want the Chess club entry", entries)
+	}
+	if hub := entries[0].Guild.HubType; hub == nil || *hub != HubTypeCollege {
+		t.Errorf("Guild.HubType = %v, want %v", hub, HubTypeCollege)
+	}
+}
@@ -19,15 +19,16 @@ package api
 // These routes are specifically limited on a per-guild basis to prevent abuse.
 //
 // This means that the quota returned by our APIs may be inaccurate, and you may encounter 429s.
+// The RateLimiter paces create, modify and delete requests per guild to avoid them; see RateLimiter.EmojiInterval.
 type Emoji struct {
-	ID            *Snowflake `json:"id"`                       // ID - emoji id
-	Name          string     `json:"name"`                     // Name - emoji name
-	Roles         []*Role    `json:"roles,omitempty"`          // Roles - roles allowed to use this emoji
-	User          *User      `json:"user,omitempty"`           // User - user that created this emoji
-	RequireColons bool       `json:"require_colons,omitempty"` // RequireColons - whether this emoji must be wrapped in colons
-	Managed       bool       `json:"managed,omitempty"`        // Managed - whether this emoji is managed
-	Animated      bool       `json:"animated,omitempty"`       // Animated - whether this emoji is animated
-	Available     bool       `json:"available,omitempty"`      // Available - whether this emoji can be used, may be false due to loss of Server Boosts
+	ID            *Snowflake  `json:"id"`                       // ID - emoji id
+	Name          string      `json:"name"`                     // Name - emoji name
+	Roles         []Snowflake `json:"roles,omitempty"`          // Roles - ids of the roles allowed to use this emoji
+	User          *User       `json:"user,omitempty"`           // User - user that created this emoji
+	RequireColons bool        `json:"require_colons,omitempty"` // RequireColons - whether this emoji must be wrapped in colons
+	Managed       bool        `json:"managed,omitempty"`        // Managed - whether this emoji is managed
+	Animated      bool        `json:"animated,omitempty"`       // Animated - whether this emoji is animated
+	Available     bool        `json:"available,omitempty"`      // Available - whether this emoji can be used, may be false due to loss of Server Boosts
 }
 
 /*
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrInvalidEmoji - returned by ParseEmoji for text that is neither a custom emoji nor a unicode emoji
+var ErrInvalidEmoji = errors.New("not a custom or unicode emoji")
+
+var (
+	// customEmojiMention - matches <:name:id> and <a:name:id>
+	customEmojiMention = regexp.MustCompile(`^<(a)?:([\w~]{2,32}):(\d{17,20})>$`)
+	// customEmojiRoute - matches the name:id form used in reaction routes
+	customEmojiRoute = regexp.MustCompile(`^()([\w~]{2,32}):(\d{17,20})$`)
+)
+
+// ParseEmoji - Parses a custom emoji as written in messages (<:name:id> or <a:name:id>), in the name:id form of reaction routes, or a unicode emoji
+//
+// Only ID, Name and Animated are set. Unicode emoji are not checked against the emoji Discord supports,
+// but text containing whitespace or only ASCII characters is rejected.
+func ParseEmoji(s string) (*Emoji, error) {
+	s = strings.TrimSpace(s)
+
+	matches := customEmojiMention.FindStringSubmatch(s)
+	if matches == nil {
+		matches = customEmojiRoute.FindStringSubmatch(s)
+	}
+	if matches != nil {
+		id := Snowflake(matches[3])
+		return &Emoji{ID: &id, Name: matches[2], Animated: matches[1] != ""}, nil
+	}
+
+	if s == "" || !utf8.ValidString(s) || strings.ContainsAny(s, "<>:") {
+		return nil, ErrInvalidEmoji
+	}
+
+	ascii := true
+	for _, r := range s {
+		if unicode.IsSpace(r) {
+			return nil, ErrInvalidEmoji
+		}
+		if r > unicode.MaxASCII {
+			ascii = false
+		}
+	}
+	if ascii {
+		return nil, ErrInvalidEmoji
+	}
+
+	return &Emoji{Name: s}, nil
+}
+
+// IsCustom - Checks whether the emoji is a guild's custom emoji rather than a unicode emoji
+func (e *Emoji) IsCustom() bool {
+	return e.ID != nil && *e.ID != ""
+}
+
+// Mention - Returns the emoji as it is written in message content, e.g. <:name:id>, <a:name:id> or the unicode emoji itself
+func (e *Emoji) Mention() string {
+	if !e.IsCustom() {
+		return e.Name
+	}
+
+	if e.Animated {
+		return "<a:" + e.Name + ":" + e.ID.String() + ">"
+	}
+
+	return "<:" + e.Name + ":" + e.ID.String() + ">"
+}
+
+// APIName - Returns the emoji in the form the reaction endpoints take, name:id or the unicode emoji itself
+//
+// The reaction endpoints URL-encode it, so pass it as is, e.g. channel.CreateReaction(messageID, emoji.APIName()).
+func (e *Emoji) APIName() string {
+	if !e.IsCustom() {
+		return e.Name
+	}
+
+	return e.Name + ":" + e.ID.String()
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseEmoji(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantMention string
+		wantAPIName string
+		wantErr     bool
+	}{
+		{
+			name:        "Custom",
+			input:       "<:blobcat:123456789012345678>",
+			wantMention: "<:blobcat:123456789012345678>",
+			wantAPIName: "blobcat:123456789012345678",
+		},
+		{
+			name:        "Animated",
+			input:       "<a:party_blob:123456789012345678>",
+			wantMention: "<a:party_blob:123456789012345678>",
+			wantAPIName: "party_blob:123456789012345678",
+		},
+		{
+			name:        "Route form",
+			input:       "blobcat:123456789012345678",
+			wantMention: "<:blobcat:123456789012345678>",
+			wantAPIName: "blobcat:123456789012345678",
+		},
+		{
+			name:        "Unicode",
+			input:       "👍🏽",
+			wantMention: "👍🏽",
+			wantAPIName: "👍🏽",
+		},
+		{
+			name:    "Unbalanced brackets",
+			input:   "<:blobcat:123456789012345678",
+			wantErr: true,
+		},
+		{
+			name:    "Plain text",
+			input:   "thumbsup",
+			wantErr: true,
+		},
+		{
+			name:    "Shortcode",
+			input:   ":thumbsup:",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emoji, err := ParseEmoji(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidEmoji) {
+					t.Errorf("ParseEmoji() error = %v, want %v", err, ErrInvalidEmoji)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseEmoji() error = %v", err)
+			}
+			if got := emoji.Mention(); got != tt.wantMention {
+				t.Errorf("Mention() = %q, want %q", got, tt.wantMention)
+			}
+			if got := emoji.APIName(); got != tt.wantAPIName {
+				t.Errorf("APIName() = %q, want %q", got, tt.wantAPIName)
+			}
+		})
+	}
+}
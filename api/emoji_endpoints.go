@@ -16,7 +16,6 @@ package api
 
 import (
 	"encoding/base64"
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -33,7 +32,7 @@ func (g *Guild) ListGuildEmojis() ([]*Emoji, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &emojis)
+	err = decodeResponse(u, responseBytes, &emojis)
 
 	return emojis, err
 }
@@ -49,7 +48,7 @@ func (g *Guild) GetGuildEmoji(emoji *Emoji) (*Emoji, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &e)
+	err = decodeResponse(u, responseBytes, &e)
 
 	return e, err
 }
@@ -77,7 +76,7 @@ func (g *Guild) CreateGuildEmoji(payload *CreateEmojiJSON, reason *string) (*Emo
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &emoji)
+	err = decodeResponse(u, responseBytes, &emoji)
 
 	return emoji, err
 }
@@ -116,7 +115,7 @@ func (g *Guild) ModifyGuildEmoji(emoji *Emoji, payload *ModifyGuildEmojiJSON, re
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &e)
+	err = decodeResponse(u, responseBytes, &e)
 
 	return e, err
 }
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// DefaultEmojiInterval - the least time between emoji requests for one guild when RateLimiter.EmojiInterval is zero
+const DefaultEmojiInterval = time.Second
+
+// guildEmojiRoute - the create, modify and delete emoji routes, capturing the guild ID
+var guildEmojiRoute = regexp.MustCompile(`/guilds/(\d+)/emojis(?:/|$|\?)`)
+
+// emojiGate - paces the emoji requests for one guild, which Discord limits per guild beyond what the rate limit headers report
+type emojiGate struct {
+	slots chan struct{}
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// emojiGuild returns the guild a mutating emoji request is for
+func emojiGuild(method, route string) (Snowflake, bool) {
+	if method == http.MethodGet {
+		return "", false
+	}
+
+	match := guildEmojiRoute.FindStringSubmatch(route)
+	if match == nil {
+		return "", false
+	}
+
+	return Snowflake(match[1]), true
+}
+
+// waitEmojiGate holds a creating, modifying or deleting emoji request until the guild has a free slot and the interval has passed
+//
+// The returned function frees the slot once the response has arrived.
+func (r *RateLimiter) waitEmojiGate(method, route string) func() {
+	guildID, ok := emojiGuild(method, route)
+	if !ok {
+		return func() {}
+	}
+
+	gate := r.emojiGate(guildID)
+	gate.slots <- struct{}{}
+
+	interval := r.EmojiInterval
+	if interval == 0 {
+		interval = DefaultEmojiInterval
+	}
+
+	gate.mu.Lock()
+	now := time.Now()
+	start := now
+	if gate.next.After(now) {
+		start = gate.next
+	}
+	if interval > 0 {
+		gate.next = start.Add(interval)
+	}
+	gate.mu.Unlock()
+
+	if wait := start.Sub(now); wait > 0 {
+		r.notifyDelay(RateLimitDelay{Bucket: BucketState{Route: "/guilds/" + guildID.String() + "/emojis"}, Wait: wait})
+		time.Sleep(wait)
+	}
+
+	return func() { <-gate.slots }
+}
+
+func (r *RateLimiter) emojiGate(guildID Snowflake) *emojiGate {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.emojiGates == nil {
+		r.emojiGates = make(map[Snowflake]*emojiGate)
+	}
+
+	gate, ok := r.emojiGates[guildID]
+	if !ok {
+		concurrency := r.EmojiConcurrency
+		if concurrency < 1 {
+			concurrency = 1
+		}
+		gate = &emojiGate{slots: make(chan struct{}, concurrency)}
+		r.emojiGates[guildID] = gate
+	}
+
+	return gate
+}
@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEmojiGatePacesPerGuild(t *testing.T) {
+	limiter := NewRatelimiter()
+	limiter.EmojiInterval = 40 * time.Millisecond
+
+	var mu sync.Mutex
+	started := map[string][]time.Time{}
+	limiter.Use(func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			guildID := strings.Split(strings.TrimPrefix(req.URL.Path, "/api/v10/guilds/"), "/")[0]
+			mu.Lock()
+			started[guildID] = append(started[guildID], time.Now())
+			mu.Unlock()
+			return &http.Response{StatusCode: http.StatusNoContent, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+	})
+
+	routes := []string{"/guilds/1/emojis/10", "/guilds/1/emojis/11", "/guilds/1/emojis", "/guilds/2/emojis/20"}
+	begin := time.Now()
+
+	var wg sync.WaitGroup
+	for _, route := range routes {
+		wg.Add(1)
+		go func(route string) {
+			defer wg.Done()
+			method := http.MethodDelete
+			if strings.HasSuffix(route, "/emojis") {
+				method = http.MethodPost
+			}
+			if _, err := limiter.Request(method, api+route, &CreateEmojiJSON{Name: "x"}, nil); err != nil {
+				t.Errorf("Request(%s) error = %v", route, err)
+			}
+		}(route)
+	}
+	wg.Wait()
+
+	if len(started["1"]) != 3 || len(started["2"]) != 1 {
+		t.Fatalf("started = %v", started)
+	}
+	last := started["1"][0]
+	for _, at := range started["1"][1:] {
+		if at.Sub(last) < 35*time.Millisecond {
+			t.Errorf("emoji requests for guild 1 started %v apart, want at least the interval", at.Sub(last))
+		}
+		last = at
+	}
+	if wait := started["2"][0].Sub(begin); wait > 30*time.Millisecond {
+		t.Errorf("guild 2 waited %v behind guild 1", wait)
+	}
+
+	if _, ok := emojiGuild(http.MethodGet, api+"/guilds/1/emojis"); ok {
+		t.Error("emojiGuild() paced a GET request")
+	}
+}
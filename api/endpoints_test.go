@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/discordtest"
+)
+
+func TestCreateMessageAgainstMockServer(t *testing.T) {
+	s := discordtest.Start(t)
+	s.Reply(http.MethodPost, "/channels/{channel.id}/messages", http.StatusOK, api.Message{ID: "2", ChannelID: "1", Content: "hello"})
+
+	message, err := (&api.Channel{ID: "1", Type: api.GuildText}).CreateMessage(api.CreateMessageJSON{Content: "hello"})
+	if err != nil {
+		t.Fatalf("CreateMessage() error = %v", err)
+	}
+	if message.ID != "2" {
+		t.Errorf("CreateMessage() = %+v, want message 2", message)
+	}
+
+	requests := s.RequestsTo(http.MethodPost, "/channels/1/messages")
+	if len(requests) != 1 {
+		t.Fatalf("RequestsTo() = %d requests, want 1", len(requests))
+	}
+	var sent api.CreateMessageJSON
+	if err = requests[0].Decode(&sent); err != nil || sent.Content != "hello" {
+		t.Errorf("sent payload = %+v (%v), want content hello", sent, err)
+	}
+}
@@ -0,0 +1,44 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+// Entitlement - represents that a user or guild has access to a premium offering in your application
+type Entitlement struct {
+	ID            Snowflake       `json:"id"`                 // ID of the entitlement
+	SkuID         Snowflake       `json:"sku_id"`             // ID of the SKU
+	ApplicationID Snowflake       `json:"application_id"`     // ID of the parent application
+	UserID        *Snowflake      `json:"user_id,omitempty"`  // ID of the user that is granted access to the entitlement's sku
+	Type          EntitlementType `json:"type"`               // type of entitlement
+	Deleted       bool            `json:"deleted"`            // entitlement was deleted
+	StartsAt      *Timestamp      `json:"starts_at"`          // start date at which the entitlement is valid
+	EndsAt        *Timestamp      `json:"ends_at"`            // date at which the entitlement is no longer valid
+	GuildID       *Snowflake      `json:"guild_id,omitempty"` // ID of the guild that is granted access to the entitlement's sku
+	Consumed      *bool           `json:"consumed,omitempty"` // for consumable items, whether the entitlement has been consumed
+}
+
+// EntitlementType - how the entitlement was acquired
+type EntitlementType int
+
+//goland:noinspection GoUnusedConst
+const (
+	EntitlementPurchase                EntitlementType = iota + 1 // entitlement was purchased by user
+	EntitlementPremiumSubscription                                // entitlement for Discord Nitro subscription
+	EntitlementDeveloperGift                                      // entitlement was gifted by developer
+	EntitlementTestModePurchase                                   // entitlement was purchased by a dev in application test mode
+	EntitlementFreePurchase                                       // entitlement was granted when the SKU was free
+	EntitlementUserGift                                           // entitlement was gifted by another user
+	EntitlementPremiumPurchase                                    // entitlement was claimed by user for free as a Nitro Subscriber
+	EntitlementApplicationSubscription                            // entitlement was purchased as an app subscription
+)
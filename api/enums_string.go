@@ -0,0 +1,1160 @@
+// Code generated by "go run ../internal/enumgen -output enums_string.go -flags ApplicationFlags,ChannelFlag,GuildMemberFlag,MessageFlags,Permission,SystemChannelFlags,UserFlags"; DO NOT EDIT.
+
+package api
+
+import "strconv"
+
+// String - Returns the name of the ApplicationCommandOptionType constant, or ApplicationCommandOptionType(n) for values this package does not know
+func (i ApplicationCommandOptionType) String() string {
+	switch i {
+	case OptionTypeSubCommand:
+		return "OptionTypeSubCommand"
+	case OptionTypeSubCommandGroup:
+		return "OptionTypeSubCommandGroup"
+	case OptionTypeString:
+		return "OptionTypeString"
+	case OptionTypeInteger:
+		return "OptionTypeInteger"
+	case OptionTypeBoolean:
+		return "OptionTypeBoolean"
+	case OptionTypeUser:
+		return "OptionTypeUser"
+	case OptionTypeChannel:
+		return "OptionTypeChannel"
+	case OptionTypeRole:
+		return "OptionTypeRole"
+	case OptionTypeMentionable:
+		return "OptionTypeMentionable"
+	case OptionTypeNumber:
+		return "OptionTypeNumber"
+	case OptionTypeAttachment:
+		return "OptionTypeAttachment"
+	default:
+		return "ApplicationCommandOptionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ApplicationCommandPermissionType constant, or ApplicationCommandPermissionType(n) for values this package does not know
+func (i ApplicationCommandPermissionType) String() string {
+	switch i {
+	case PermissionTypeRole:
+		return "PermissionTypeRole"
+	case PermissionTypeUser:
+		return "PermissionTypeUser"
+	case PermissionTypeChannel:
+		return "PermissionTypeChannel"
+	default:
+		return "ApplicationCommandPermissionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ApplicationCommandType constant, or ApplicationCommandType(n) for values this package does not know
+func (i ApplicationCommandType) String() string {
+	switch i {
+	case CommandTypeChatInput:
+		return "CommandTypeChatInput"
+	case CommandTypeUser:
+		return "CommandTypeUser"
+	case CommandTypeMessage:
+		return "CommandTypeMessage"
+	default:
+		return "ApplicationCommandType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var applicationFlagsNames = []flagName{
+	{uint64(ApplicationAutoModerationRuleCreateBadge), "ApplicationAutoModerationRuleCreateBadge"},
+	{uint64(GatewayPresence), "GatewayPresence"},
+	{uint64(GatewayPresenceLimited), "GatewayPresenceLimited"},
+	{uint64(GatewayGuildMembers), "GatewayGuildMembers"},
+	{uint64(GatewayGuildMembersLimited), "GatewayGuildMembersLimited"},
+	{uint64(VerificationPendingGuildLimit), "VerificationPendingGuildLimit"},
+	{uint64(Embedded), "Embedded"},
+	{uint64(GatewayMessageContent), "GatewayMessageContent"},
+	{uint64(GatewayMessageContentLimited), "GatewayMessageContentLimited"},
+	{uint64(ApplicationCommandBadge), "ApplicationCommandBadge"},
+}
+
+// String - Returns the names of the ApplicationFlags bits that are set joined with "|", with unknown bits as ApplicationFlags(n)
+func (i ApplicationFlags) String() string {
+	return flagString("ApplicationFlags", "", uint64(i), applicationFlagsNames)
+}
+
+// String - Returns the name of the ApplicationIntegrationType constant, or ApplicationIntegrationType(n) for values this package does not know
+func (i ApplicationIntegrationType) String() string {
+	switch i {
+	case GuildInstall:
+		return "GuildInstall"
+	case UserInstall:
+		return "UserInstall"
+	default:
+		return "ApplicationIntegrationType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ApplicationRoleConnectionMetadataType constant, or ApplicationRoleConnectionMetadataType(n) for values this package does not know
+func (i ApplicationRoleConnectionMetadataType) String() string {
+	switch i {
+	case IntegerLessThanOrEqual:
+		return "IntegerLessThanOrEqual"
+	case IntegerGreaterThanOrEqual:
+		return "IntegerGreaterThanOrEqual"
+	case IntegerEqual:
+		return "IntegerEqual"
+	case IntegerNotEqual:
+		return "IntegerNotEqual"
+	case DateTimeLessThanOrEqual:
+		return "DateTimeLessThanOrEqual"
+	case DateTimeGreaterThanOrEqual:
+		return "DateTimeGreaterThanOrEqual"
+	case BooleanEqual:
+		return "BooleanEqual"
+	case BooleanNotEqual:
+		return "BooleanNotEqual"
+	default:
+		return "ApplicationRoleConnectionMetadataType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the AuditLogEvent constant, or AuditLogEvent(n) for values this package does not know
+func (i AuditLogEvent) String() string {
+	switch i {
+	case GuildUpdate:
+		return "GuildUpdate"
+	case ChannelCreate:
+		return "ChannelCreate"
+	case ChannelUpdate:
+		return "ChannelUpdate"
+	case ChannelDelete:
+		return "ChannelDelete"
+	case ChannelOverwriteCreate:
+		return "ChannelOverwriteCreate"
+	case ChannelOverwriteUpdate:
+		return "ChannelOverwriteUpdate"
+	case ChannelOverwriteDelete:
+		return "ChannelOverwriteDelete"
+	case MemberKick:
+		return "MemberKick"
+	case MemberPrune:
+		return "MemberPrune"
+	case MemberBanAdd:
+		return "MemberBanAdd"
+	case MemberBanRemove:
+		return "MemberBanRemove"
+	case MemberUpdate:
+		return "MemberUpdate"
+	case MemberRoleUpdate:
+		return "MemberRoleUpdate"
+	case MemberMove:
+		return "MemberMove"
+	case MemberDisconnect:
+		return "MemberDisconnect"
+	case BotAdd:
+		return "BotAdd"
+	case RoleCreate:
+		return "RoleCreate"
+	case RoleUpdate:
+		return "RoleUpdate"
+	case RoleDelete:
+		return "RoleDelete"
+	case InviteCreate:
+		return "InviteCreate"
+	case InviteUpdate:
+		return "InviteUpdate"
+	case InviteDelete:
+		return "InviteDelete"
+	case WebhookCreate:
+		return "WebhookCreate"
+	case WebhookUpdate:
+		return "WebhookUpdate"
+	case WebhookDelete:
+		return "WebhookDelete"
+	case EmojiCreate:
+		return "EmojiCreate"
+	case EmojiUpdate:
+		return "EmojiUpdate"
+	case EmojiDelete:
+		return "EmojiDelete"
+	case MessageDelete:
+		return "MessageDelete"
+	case MessageBulkDelete:
+		return "MessageBulkDelete"
+	case MessagePin:
+		return "MessagePin"
+	case MessageUnpin:
+		return "MessageUnpin"
+	case IntegrationCreate:
+		return "IntegrationCreate"
+	case IntegrationUpdate:
+		return "IntegrationUpdate"
+	case IntegrationDelete:
+		return "IntegrationDelete"
+	case StageInstanceCreate:
+		return "StageInstanceCreate"
+	case StageInstanceUpdate:
+		return "StageInstanceUpdate"
+	case StageInstanceDelete:
+		return "StageInstanceDelete"
+	case StickerCreate:
+		return "StickerCreate"
+	case StickerUpdate:
+		return "StickerUpdate"
+	case StickerDelete:
+		return "StickerDelete"
+	case GuildScheduledEventCreate:
+		return "GuildScheduledEventCreate"
+	case GuildScheduledEventUpdate:
+		return "GuildScheduledEventUpdate"
+	case GuildScheduledEventDelete:
+		return "GuildScheduledEventDelete"
+	case ThreadCreate:
+		return "ThreadCreate"
+	case ThreadUpdate:
+		return "ThreadUpdate"
+	case ThreadDelete:
+		return "ThreadDelete"
+	default:
+		return "AuditLogEvent(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the AutoModerationActionType constant, or AutoModerationActionType(n) for values this package does not know
+func (i AutoModerationActionType) String() string {
+	switch i {
+	case BlockMessage:
+		return "BlockMessage"
+	case SendMessage:
+		return "SendMessage"
+	case Timeout:
+		return "Timeout"
+	default:
+		return "AutoModerationActionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ButtonStyle constant, or ButtonStyle(n) for values this package does not know
+func (i ButtonStyle) String() string {
+	switch i {
+	case ButtonPrimary:
+		return "ButtonPrimary"
+	case ButtonSecondary:
+		return "ButtonSecondary"
+	case ButtonSuccess:
+		return "ButtonSuccess"
+	case ButtonDanger:
+		return "ButtonDanger"
+	case ButtonLink:
+		return "ButtonLink"
+	case ButtonPremium:
+		return "ButtonPremium"
+	default:
+		return "ButtonStyle(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var channelFlagNames = []flagName{
+	{uint64(Pinned), "Pinned"},
+	{uint64(RequireTag), "RequireTag"},
+	{uint64(HideMediaDownloadOptions), "HideMediaDownloadOptions"},
+}
+
+// String - Returns the names of the ChannelFlag bits that are set joined with "|", with unknown bits as ChannelFlag(n)
+func (i ChannelFlag) String() string {
+	return flagString("ChannelFlag", "", uint64(i), channelFlagNames)
+}
+
+// String - Returns the name of the ChannelType constant, or ChannelType(n) for values this package does not know
+func (i ChannelType) String() string {
+	switch i {
+	case GuildText:
+		return "GuildText"
+	case DM:
+		return "DM"
+	case GuildVoice:
+		return "GuildVoice"
+	case GroupDM:
+		return "GroupDM"
+	case GuildCategory:
+		return "GuildCategory"
+	case GuildAnnouncement:
+		return "GuildAnnouncement"
+	case GuildAnnouncementThread:
+		return "GuildAnnouncementThread"
+	case GuildPublicThread:
+		return "GuildPublicThread"
+	case GuildPrivateThread:
+		return "GuildPrivateThread"
+	case GuildStageVoice:
+		return "GuildStageVoice"
+	case GuildDirectory:
+		return "GuildDirectory"
+	case GuildForum:
+		return "GuildForum"
+	case GuildMedia:
+		return "GuildMedia"
+	default:
+		return "ChannelType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ComponentType constant, or ComponentType(n) for values this package does not know
+func (i ComponentType) String() string {
+	switch i {
+	case ComponentTypeActionRow:
+		return "ComponentTypeActionRow"
+	case ComponentTypeButton:
+		return "ComponentTypeButton"
+	case ComponentTypeSelectMenu:
+		return "ComponentTypeSelectMenu"
+	case ComponentTypeTextInput:
+		return "ComponentTypeTextInput"
+	case ComponentTypeUserSelect:
+		return "ComponentTypeUserSelect"
+	case ComponentTypeRoleSelect:
+		return "ComponentTypeRoleSelect"
+	case ComponentTypeMentionableSelect:
+		return "ComponentTypeMentionableSelect"
+	case ComponentTypeChannelSelect:
+		return "ComponentTypeChannelSelect"
+	case ComponentTypeSection:
+		return "ComponentTypeSection"
+	case ComponentTypeTextDisplay:
+		return "ComponentTypeTextDisplay"
+	case ComponentTypeThumbnail:
+		return "ComponentTypeThumbnail"
+	case ComponentTypeMediaGallery:
+		return "ComponentTypeMediaGallery"
+	case ComponentTypeFile:
+		return "ComponentTypeFile"
+	case ComponentTypeSeparator:
+		return "ComponentTypeSeparator"
+	case ComponentTypeContainer:
+		return "ComponentTypeContainer"
+	default:
+		return "ComponentType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ConnectionVisibilityType constant, or ConnectionVisibilityType(n) for values this package does not know
+func (i ConnectionVisibilityType) String() string {
+	switch i {
+	case ConnectionVisibilityTypeNone:
+		return "ConnectionVisibilityTypeNone"
+	case ConnectionVisibilityTypeEveryone:
+		return "ConnectionVisibilityTypeEveryone"
+	default:
+		return "ConnectionVisibilityType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the DecodeMode constant, or DecodeMode(n) for values this package does not know
+func (i DecodeMode) String() string {
+	switch i {
+	case DecodeLenient:
+		return "DecodeLenient"
+	case DecodeLogUnknown:
+		return "DecodeLogUnknown"
+	case DecodeStrict:
+		return "DecodeStrict"
+	default:
+		return "DecodeMode(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the DefaultMessageNotificationLevel constant, or DefaultMessageNotificationLevel(n) for values this package does not know
+func (i DefaultMessageNotificationLevel) String() string {
+	switch i {
+	case AllMessages:
+		return "AllMessages"
+	case OnlyMentions:
+		return "OnlyMentions"
+	default:
+		return "DefaultMessageNotificationLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the DirectoryCategory constant, or DirectoryCategory(n) for values this package does not know
+func (i DirectoryCategory) String() string {
+	switch i {
+	case DirectoryCategoryUncategorized:
+		return "DirectoryCategoryUncategorized"
+	case DirectoryCategorySchoolClub:
+		return "DirectoryCategorySchoolClub"
+	case DirectoryCategoryClass:
+		return "DirectoryCategoryClass"
+	case DirectoryCategoryStudySocial:
+		return "DirectoryCategoryStudySocial"
+	case DirectoryCategoryMiscellaneous:
+		return "DirectoryCategoryMiscellaneous"
+	default:
+		return "DirectoryCategory(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the DirectoryEntryType constant, or DirectoryEntryType(n) for values this package does not know
+func (i DirectoryEntryType) String() string {
+	switch i {
+	case DirectoryEntryGuild:
+		return "DirectoryEntryGuild"
+	case DirectoryEntryScheduledEvent:
+		return "DirectoryEntryScheduledEvent"
+	default:
+		return "DirectoryEntryType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the EntitlementType constant, or EntitlementType(n) for values this package does not know
+func (i EntitlementType) String() string {
+	switch i {
+	case EntitlementPurchase:
+		return "EntitlementPurchase"
+	case EntitlementPremiumSubscription:
+		return "EntitlementPremiumSubscription"
+	case EntitlementDeveloperGift:
+		return "EntitlementDeveloperGift"
+	case EntitlementTestModePurchase:
+		return "EntitlementTestModePurchase"
+	case EntitlementFreePurchase:
+		return "EntitlementFreePurchase"
+	case EntitlementUserGift:
+		return "EntitlementUserGift"
+	case EntitlementPremiumPurchase:
+		return "EntitlementPremiumPurchase"
+	case EntitlementApplicationSubscription:
+		return "EntitlementApplicationSubscription"
+	default:
+		return "EntitlementType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the EventType constant, or EventType(n) for values this package does not know
+func (i EventType) String() string {
+	switch i {
+	case MessageSend:
+		return "MessageSend"
+	default:
+		return "EventType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ExplicitContentFilterLevel constant, or ExplicitContentFilterLevel(n) for values this package does not know
+func (i ExplicitContentFilterLevel) String() string {
+	switch i {
+	case Disabled:
+		return "Disabled"
+	case MembersWithoutRoles:
+		return "MembersWithoutRoles"
+	case AllMembers:
+		return "AllMembers"
+	default:
+		return "ExplicitContentFilterLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the ForumLayoutType constant, or ForumLayoutType(n) for values this package does not know
+func (i ForumLayoutType) String() string {
+	switch i {
+	case NotSet:
+		return "NotSet"
+	case ListView:
+		return "ListView"
+	case GalleryView:
+		return "GalleryView"
+	default:
+		return "ForumLayoutType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var guildMemberFlagNames = []flagName{
+	{uint64(DidRejoin), "DidRejoin"},
+	{uint64(CompletedOnboarding), "CompletedOnboarding"},
+	{uint64(BypassesVerification), "BypassesVerification"},
+	{uint64(StartedOnboarding), "StartedOnboarding"},
+	{uint64(IsGuest), "IsGuest"},
+	{uint64(StartedHomeActions), "StartedHomeActions"},
+	{uint64(CompletedHomeActions), "CompletedHomeActions"},
+	{uint64(AutomodQuarantinedUsername), "AutomodQuarantinedUsername"},
+	{uint64(DmSettingsUpsellAcknowledged), "DmSettingsUpsellAcknowledged"},
+}
+
+// String - Returns the names of the GuildMemberFlag bits that are set joined with "|", with unknown bits as GuildMemberFlag(n)
+func (i GuildMemberFlag) String() string {
+	return flagString("GuildMemberFlag", "", uint64(i), guildMemberFlagNames)
+}
+
+// String - Returns the name of the GuildNsfwLevel constant, or GuildNsfwLevel(n) for values this package does not know
+func (i GuildNsfwLevel) String() string {
+	switch i {
+	case NsfwDefault:
+		return "NsfwDefault"
+	case NsfwExplicit:
+		return "NsfwExplicit"
+	case NsfwSafe:
+		return "NsfwSafe"
+	case NsfwAgeRestricted:
+		return "NsfwAgeRestricted"
+	default:
+		return "GuildNsfwLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the GuildScheduledEventPrivacyLevel constant, or GuildScheduledEventPrivacyLevel(n) for values this package does not know
+func (i GuildScheduledEventPrivacyLevel) String() string {
+	switch i {
+	case GuildScheduledEventPrivacyLevelGuildOnly:
+		return "GuildScheduledEventPrivacyLevelGuildOnly"
+	default:
+		return "GuildScheduledEventPrivacyLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the GuildScheduledEventStatus constant, or GuildScheduledEventStatus(n) for values this package does not know
+func (i GuildScheduledEventStatus) String() string {
+	switch i {
+	case Scheduled:
+		return "Scheduled"
+	case Active:
+		return "Active"
+	case Completed:
+		return "Completed"
+	case Cancelled:
+		return "Cancelled"
+	default:
+		return "GuildScheduledEventStatus(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the GuildScheduledEventType constant, or GuildScheduledEventType(n) for values this package does not know
+func (i GuildScheduledEventType) String() string {
+	switch i {
+	case GuildScheduledEventTypeStageInstance:
+		return "GuildScheduledEventTypeStageInstance"
+	case GuildScheduledEventTypeVoice:
+		return "GuildScheduledEventTypeVoice"
+	case GuildScheduledEventTypeExternal:
+		return "GuildScheduledEventTypeExternal"
+	default:
+		return "GuildScheduledEventType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the HubType constant, or HubType(n) for values this package does not know
+func (i HubType) String() string {
+	switch i {
+	case HubTypeDefault:
+		return "HubTypeDefault"
+	case HubTypeHighSchool:
+		return "HubTypeHighSchool"
+	case HubTypeCollege:
+		return "HubTypeCollege"
+	default:
+		return "HubType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the IntegrationExpireBehavior constant, or IntegrationExpireBehavior(n) for values this package does not know
+func (i IntegrationExpireBehavior) String() string {
+	switch i {
+	case RemoveRole:
+		return "RemoveRole"
+	case Kick:
+		return "Kick"
+	default:
+		return "IntegrationExpireBehavior(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the InteractionCallbackType constant, or InteractionCallbackType(n) for values this package does not know
+func (i InteractionCallbackType) String() string {
+	switch i {
+	case Pong:
+		return "Pong"
+	case ChannelMessageWithSource:
+		return "ChannelMessageWithSource"
+	case DeferredChannelMessageWithSource:
+		return "DeferredChannelMessageWithSource"
+	case DeferredUpdateMessage:
+		return "DeferredUpdateMessage"
+	case UpdateMessage:
+		return "UpdateMessage"
+	case AutocompleteResult:
+		return "AutocompleteResult"
+	case Modal:
+		return "Modal"
+	case PremiumRequired:
+		return "PremiumRequired"
+	case LaunchActivity:
+		return "LaunchActivity"
+	default:
+		return "InteractionCallbackType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the InteractionContextType constant, or InteractionContextType(n) for values this package does not know
+func (i InteractionContextType) String() string {
+	switch i {
+	case ContextGuild:
+		return "ContextGuild"
+	case ContextBotDm:
+		return "ContextBotDm"
+	case ContextPrivateChannel:
+		return "ContextPrivateChannel"
+	default:
+		return "InteractionContextType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the InteractionType constant, or InteractionType(n) for values this package does not know
+func (i InteractionType) String() string {
+	switch i {
+	case InteractionTypePing:
+		return "InteractionTypePing"
+	case InteractionTypeApplicationCommand:
+		return "InteractionTypeApplicationCommand"
+	case InteractionTypeMessageComponent:
+		return "InteractionTypeMessageComponent"
+	case InteractionTypeApplicationCommandAutocomplete:
+		return "InteractionTypeApplicationCommandAutocomplete"
+	case InteractionTypeModalSubmit:
+		return "InteractionTypeModalSubmit"
+	default:
+		return "InteractionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the InviteTargetType constant, or InviteTargetType(n) for values this package does not know
+func (i InviteTargetType) String() string {
+	switch i {
+	case TargetTypeStream:
+		return "TargetTypeStream"
+	case TargetTypeEmbeddedApplication:
+		return "TargetTypeEmbeddedApplication"
+	default:
+		return "InviteTargetType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the KeyWordPresetType constant, or KeyWordPresetType(n) for values this package does not know
+func (i KeyWordPresetType) String() string {
+	switch i {
+	case Profanity:
+		return "Profanity"
+	case SexualContent:
+		return "SexualContent"
+	case Slurs:
+		return "Slurs"
+	default:
+		return "KeyWordPresetType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the MembershipState constant, or MembershipState(n) for values this package does not know
+func (i MembershipState) String() string {
+	switch i {
+	case Invited:
+		return "Invited"
+	case Accepted:
+		return "Accepted"
+	default:
+		return "MembershipState(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the MentionType constant, or MentionType(n) for values this package does not know
+func (i MentionType) String() string {
+	switch i {
+	case MentionTypeUser:
+		return "MentionTypeUser"
+	case MentionTypeRole:
+		return "MentionTypeRole"
+	case MentionTypeChannel:
+		return "MentionTypeChannel"
+	case MentionTypeCommand:
+		return "MentionTypeCommand"
+	default:
+		return "MentionType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the MessageActivityType constant, or MessageActivityType(n) for values this package does not know
+func (i MessageActivityType) String() string {
+	switch i {
+	case MessageActivityTypeJoin:
+		return "MessageActivityTypeJoin"
+	case MessageActivityTypeSpectate:
+		return "MessageActivityTypeSpectate"
+	case MessageActivityTypeListen:
+		return "MessageActivityTypeListen"
+	case MessageActivityTypeJoinRequest:
+		return "MessageActivityTypeJoinRequest"
+	default:
+		return "MessageActivityType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var messageFlagsNames = []flagName{
+	{uint64(CrossPosted), "CrossPosted"},
+	{uint64(IsCrossPost), "IsCrossPost"},
+	{uint64(SuppressEmbeds), "SuppressEmbeds"},
+	{uint64(SourceMessageDeleted), "SourceMessageDeleted"},
+	{uint64(Urgent), "Urgent"},
+	{uint64(HasThread), "HasThread"},
+	{uint64(Ephemeral), "Ephemeral"},
+	{uint64(Loading), "Loading"},
+	{uint64(FailedToMentionSomeRolesInThread), "FailedToMentionSomeRolesInThread"},
+	{uint64(SuppressNotifications), "SuppressNotifications"},
+	{uint64(IsVoiceMessage), "IsVoiceMessage"},
+	{uint64(HasSnapshot), "HasSnapshot"},
+	{uint64(IsComponentsV2), "IsComponentsV2"},
+}
+
+// String - Returns the names of the MessageFlags bits that are set joined with "|", with unknown bits as MessageFlags(n)
+func (i MessageFlags) String() string {
+	return flagString("MessageFlags", "", uint64(i), messageFlagsNames)
+}
+
+// String - Returns the name of the MessageReferenceType constant, or MessageReferenceType(n) for values this package does not know
+func (i MessageReferenceType) String() string {
+	switch i {
+	case DefaultReference:
+		return "DefaultReference"
+	case Forward:
+		return "Forward"
+	default:
+		return "MessageReferenceType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the MessageType constant, or MessageType(n) for values this package does not know
+func (i MessageType) String() string {
+	switch i {
+	case Default:
+		return "Default"
+	case RecipientAdd:
+		return "RecipientAdd"
+	case RecipientRemove:
+		return "RecipientRemove"
+	case Call:
+		return "Call"
+	case ChannelNameChange:
+		return "ChannelNameChange"
+	case ChannelIconChange:
+		return "ChannelIconChange"
+	case ChannelPinnedMessage:
+		return "ChannelPinnedMessage"
+	case UserJoin:
+		return "UserJoin"
+	case GuildBoost:
+		return "GuildBoost"
+	case GuildBoostTier1:
+		return "GuildBoostTier1"
+	case GuildBoostTier2:
+		return "GuildBoostTier2"
+	case GuildBoostTier3:
+		return "GuildBoostTier3"
+	case ChannelFollowAdd:
+		return "ChannelFollowAdd"
+	case GuildDiscoveryDisqualified:
+		return "GuildDiscoveryDisqualified"
+	case GuildDiscoveryRequalified:
+		return "GuildDiscoveryRequalified"
+	case GuildDiscoveryGracePeriodInitialWarning:
+		return "GuildDiscoveryGracePeriodInitialWarning"
+	case GuildDiscoveryGracePeriodFinalWarning:
+		return "GuildDiscoveryGracePeriodFinalWarning"
+	case ThreadCreated:
+		return "ThreadCreated"
+	case Reply:
+		return "Reply"
+	case ChatInputCommand:
+		return "ChatInputCommand"
+	case ThreadStarterMessage:
+		return "ThreadStarterMessage"
+	case GuildInviteReminder:
+		return "GuildInviteReminder"
+	case ContextMenuCommand:
+		return "ContextMenuCommand"
+	case AutoModerationAction:
+		return "AutoModerationAction"
+	case RoleSubscriptionPurchase:
+		return "RoleSubscriptionPurchase"
+	case InteractionPremiumUpsell:
+		return "InteractionPremiumUpsell"
+	case StageStart:
+		return "StageStart"
+	case StageEnd:
+		return "StageEnd"
+	case StageSpeaker:
+		return "StageSpeaker"
+	case StageTopic:
+		return "StageTopic"
+	case GuildApplicationPremiumSubscription:
+		return "GuildApplicationPremiumSubscription"
+	case GuildIncidentAlertModeEnabled:
+		return "GuildIncidentAlertModeEnabled"
+	case GuildIncidentAlertModeDisabled:
+		return "GuildIncidentAlertModeDisabled"
+	case GuildIncidentReportRaid:
+		return "GuildIncidentReportRaid"
+	case GuildIncidentReportFalseAlarm:
+		return "GuildIncidentReportFalseAlarm"
+	case PurchaseNotification:
+		return "PurchaseNotification"
+	case PollResult:
+		return "PollResult"
+	default:
+		return "MessageType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the MfaLevel constant, or MfaLevel(n) for values this package does not know
+func (i MfaLevel) String() string {
+	switch i {
+	case MfaNone:
+		return "MfaNone"
+	case MfaElevated:
+		return "MfaElevated"
+	default:
+		return "MfaLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the OverwriteType constant, or OverwriteType(n) for values this package does not know
+func (i OverwriteType) String() string {
+	switch i {
+	case PermissionRole:
+		return "PermissionRole"
+	case PermissionMember:
+		return "PermissionMember"
+	default:
+		return "OverwriteType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var permissionNames = []flagName{
+	{uint64(CreateInstantInvite), "CreateInstantInvite"},
+	{uint64(KickMembers), "KickMembers"},
+	{uint64(BanMembers), "BanMembers"},
+	{uint64(Administrator), "Administrator"},
+	{uint64(ManageChannels), "ManageChannels"},
+	{uint64(ManageGuild), "ManageGuild"},
+	{uint64(AddReactions), "AddReactions"},
+	{uint64(ViewAuditLog), "ViewAuditLog"},
+	{uint64(PrioritySpeaker), "PrioritySpeaker"},
+	{uint64(Stream), "Stream"},
+	{uint64(ViewChannel), "ViewChannel"},
+	{uint64(SendMessages), "SendMessages"},
+	{uint64(SendTtsMessages), "SendTtsMessages"},
+	{uint64(ManageMessages), "ManageMessages"},
+	{uint64(EmbedLinks), "EmbedLinks"},
+	{uint64(AttachFiles), "AttachFiles"},
+	{uint64(ReadMessageHistory), "ReadMessageHistory"},
+	{uint64(MentionEveryone), "MentionEveryone"},
+	{uint64(UseExternalEmojis), "UseExternalEmojis"},
+	{uint64(ViewGuildInsights), "ViewGuildInsights"},
+	{uint64(Connect), "Connect"},
+	{uint64(Speak), "Speak"},
+	{uint64(MuteMembers), "MuteMembers"},
+	{uint64(DeafenMembers), "DeafenMembers"},
+	{uint64(MoveMembers), "MoveMembers"},
+	{uint64(UseVoiceActivity), "UseVoiceActivity"},
+	{uint64(ChangeNickname), "ChangeNickname"},
+	{uint64(ManageNicknames), "ManageNicknames"},
+	{uint64(ManageRoles), "ManageRoles"},
+	{uint64(ManageWebhooks), "ManageWebhooks"},
+	{uint64(ManageGuildExpressions), "ManageGuildExpressions"},
+	{uint64(UseApplicationCommands), "UseApplicationCommands"},
+	{uint64(RequestToSpeak), "RequestToSpeak"},
+	{uint64(ManageEvents), "ManageEvents"},
+	{uint64(ManageThreads), "ManageThreads"},
+	{uint64(CreatePublicThreads), "CreatePublicThreads"},
+	{uint64(CreatePrivateThreads), "CreatePrivateThreads"},
+	{uint64(UseExternalStickers), "UseExternalStickers"},
+	{uint64(SendMessagesInThreads), "SendMessagesInThreads"},
+	{uint64(UseEmbeddedActivities), "UseEmbeddedActivities"},
+	{uint64(ModerateMembers), "ModerateMembers"},
+	{uint64(ViewCreatorMonetizationAnalytics), "ViewCreatorMonetizationAnalytics"},
+	{uint64(UseSoundboard), "UseSoundboard"},
+	{uint64(CreateGuildExpressions), "CreateGuildExpressions"},
+	{uint64(CreateEvents), "CreateEvents"},
+	{uint64(UseExternalSounds), "UseExternalSounds"},
+	{uint64(SendVoiceMessages), "SendVoiceMessages"},
+	{uint64(SetVoiceChannelStatus), "SetVoiceChannelStatus"},
+	{uint64(SendPolls), "SendPolls"},
+	{uint64(UseExternalApps), "UseExternalApps"},
+}
+
+// String - Returns the names of the Permission bits that are set joined with "|", with unknown bits as Permission(n)
+func (i Permission) String() string {
+	return flagString("Permission", "NoPermissions", uint64(i), permissionNames)
+}
+
+// String - Returns the name of the PremiumTier constant, or PremiumTier(n) for values this package does not know
+func (i PremiumTier) String() string {
+	switch i {
+	case PremiumNone:
+		return "PremiumNone"
+	case PremiumTier1:
+		return "PremiumTier1"
+	case PremiumTier2:
+		return "PremiumTier2"
+	case PremiumTier3:
+		return "PremiumTier3"
+	default:
+		return "PremiumTier(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the PremiumType constant, or PremiumType(n) for values this package does not know
+func (i PremiumType) String() string {
+	switch i {
+	case None:
+		return "None"
+	case NitroClassic:
+		return "NitroClassic"
+	case Nitro:
+		return "Nitro"
+	case NitroBasic:
+		return "NitroBasic"
+	default:
+		return "PremiumType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the PrivacyLevel constant, or PrivacyLevel(n) for values this package does not know
+func (i PrivacyLevel) String() string {
+	switch i {
+	case GuildOnly:
+		return "GuildOnly"
+	default:
+		return "PrivacyLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the PromptType constant, or PromptType(n) for values this package does not know
+func (i PromptType) String() string {
+	switch i {
+	case MultipleChoice:
+		return "MultipleChoice"
+	case Dropdown:
+		return "Dropdown"
+	default:
+		return "PromptType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the PurchaseNotificationType constant, or PurchaseNotificationType(n) for values this package does not know
+func (i PurchaseNotificationType) String() string {
+	switch i {
+	case GuildProductPurchaseNotification:
+		return "GuildProductPurchaseNotification"
+	default:
+		return "PurchaseNotificationType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the RequestPriority constant, or RequestPriority(n) for values this package does not know
+func (i RequestPriority) String() string {
+	switch i {
+	case PriorityLow:
+		return "PriorityLow"
+	case PriorityNormal:
+		return "PriorityNormal"
+	case PriorityHigh:
+		return "PriorityHigh"
+	default:
+		return "RequestPriority(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the RoleFlags constant, or RoleFlags(n) for values this package does not know
+func (i RoleFlags) String() string {
+	switch i {
+	case RoleInPrompt:
+		return "RoleInPrompt"
+	default:
+		return "RoleFlags(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the SortOrderType constant, or SortOrderType(n) for values this package does not know
+func (i SortOrderType) String() string {
+	switch i {
+	case LatestActivity:
+		return "LatestActivity"
+	case CreationDate:
+		return "CreationDate"
+	default:
+		return "SortOrderType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the StickerFormatType constant, or StickerFormatType(n) for values this package does not know
+func (i StickerFormatType) String() string {
+	switch i {
+	case StickerFormatTypePng:
+		return "StickerFormatTypePng"
+	case StickerFormatTypeAnimatedPng:
+		return "StickerFormatTypeAnimatedPng"
+	case StickerFormatTypeLottie:
+		return "StickerFormatTypeLottie"
+	case StickerFormatTypeGif:
+		return "StickerFormatTypeGif"
+	default:
+		return "StickerFormatType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the StickerType constant, or StickerType(n) for values this package does not know
+func (i StickerType) String() string {
+	switch i {
+	case StickerTypeStandard:
+		return "StickerTypeStandard"
+	case StickerTypeGuild:
+		return "StickerTypeGuild"
+	default:
+		return "StickerType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var systemChannelFlagsNames = []flagName{
+	{uint64(SuppressJoinNotifications), "SuppressJoinNotifications"},
+	{uint64(SuppressPremiumSubscriptions), "SuppressPremiumSubscriptions"},
+	{uint64(SuppressGuildReminderNotifications), "SuppressGuildReminderNotifications"},
+	{uint64(SuppressJoinNotificationReplies), "SuppressJoinNotificationReplies"},
+	{uint64(SuppressRoleSubscriptionPurchaseNotifications), "SuppressRoleSubscriptionPurchaseNotifications"},
+	{uint64(SuppressRoleSubscriptionPurchaseNotificationReplies), "SuppressRoleSubscriptionPurchaseNotificationReplies"},
+}
+
+// String - Returns the names of the SystemChannelFlags bits that are set joined with "|", with unknown bits as SystemChannelFlags(n)
+func (i SystemChannelFlags) String() string {
+	return flagString("SystemChannelFlags", "", uint64(i), systemChannelFlagsNames)
+}
+
+// String - Returns the name of the TextInputStyle constant, or TextInputStyle(n) for values this package does not know
+func (i TextInputStyle) String() string {
+	switch i {
+	case TextInputShort:
+		return "TextInputShort"
+	case TextInputParagraph:
+		return "TextInputParagraph"
+	default:
+		return "TextInputStyle(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the TriggerType constant, or TriggerType(n) for values this package does not know
+func (i TriggerType) String() string {
+	switch i {
+	case Keyword:
+		return "Keyword"
+	case Spam:
+		return "Spam"
+	case KeywordPreset:
+		return "KeywordPreset"
+	case MentionSpam:
+		return "MentionSpam"
+	default:
+		return "TriggerType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+var userFlagsNames = []flagName{
+	{uint64(Staff), "Staff"},
+	{uint64(Partner), "Partner"},
+	{uint64(HypeSquad), "HypeSquad"},
+	{uint64(BugHunterLevel1), "BugHunterLevel1"},
+	{uint64(HouseBravery), "HouseBravery"},
+	{uint64(HouseBrilliance), "HouseBrilliance"},
+	{uint64(HouseBalance), "HouseBalance"},
+	{uint64(PremiumEarlySupporter), "PremiumEarlySupporter"},
+	{uint64(TeamPsuedoUser), "TeamPsuedoUser"},
+	{uint64(BugHunterLevel2), "BugHunterLevel2"},
+	{uint64(VerifiedBot), "VerifiedBot"},
+	{uint64(VerifiedDeveloper), "VerifiedDeveloper"},
+	{uint64(CertifiedModerator), "CertifiedModerator"},
+	{uint64(BotHttpInteractions), "BotHttpInteractions"},
+	{uint64(ActiveDeveloper), "ActiveDeveloper"},
+}
+
+// String - Returns the names of the UserFlags bits that are set joined with "|", with unknown bits as UserFlags(n)
+func (i UserFlags) String() string {
+	return flagString("UserFlags", "FlagsNone", uint64(i), userFlagsNames)
+}
+
+// String - Returns the name of the VerificationLevel constant, or VerificationLevel(n) for values this package does not know
+func (i VerificationLevel) String() string {
+	switch i {
+	case VerificationLevelNone:
+		return "VerificationLevelNone"
+	case VerificationLevelLow:
+		return "VerificationLevelLow"
+	case VerificationLevelMedium:
+		return "VerificationLevelMedium"
+	case VerificationLevelHigh:
+		return "VerificationLevelHigh"
+	case VerificationLevelVeryHigh:
+		return "VerificationLevelVeryHigh"
+	default:
+		return "VerificationLevel(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the VideoQualityMode constant, or VideoQualityMode(n) for values this package does not know
+func (i VideoQualityMode) String() string {
+	switch i {
+	case Auto:
+		return "Auto"
+	case Full:
+		return "Full"
+	default:
+		return "VideoQualityMode(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// String - Returns the name of the WebhookType constant, or WebhookType(n) for values this package does not know
+func (i WebhookType) String() string {
+	switch i {
+	case WebhookTypeIncoming:
+		return "WebhookTypeIncoming"
+	case WebhookTypeChannelFollower:
+		return "WebhookTypeChannelFollower"
+	case WebhookTypeApplication:
+		return "WebhookTypeApplication"
+	default:
+		return "WebhookType(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// flagName - the name of a single bit of a bitfield enum
+type flagName struct {
+	bit  uint64
+	name string
+}
+
+func flagString(typeName, zero string, value uint64, names []flagName) string {
+	if value == 0 {
+		if zero != "" {
+			return zero
+		}
+		return typeName + "(0)"
+	}
+
+	var parts []string
+	for _, f := range names {
+		if value&f.bit == f.bit {
+			parts = append(parts, f.name)
+			value &^= f.bit
+		}
+	}
+	if value != 0 {
+		parts = append(parts, typeName+"("+strconv.FormatUint(value, 10)+")")
+	}
+
+	out := parts[0]
+	for _, part := range parts[1:] {
+		out += "|" + part
+	}
+
+	return out
+}
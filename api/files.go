@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// File - a file to upload alongside a JSON payload as multipart/form-data
+//
+// Reference an uploaded image from an embed with "attachment://" + Name.
+type File struct {
+	Name        string    // the file name, including its extension
+	ContentType string    // the file's media type; application/octet-stream when empty
+	Description string    // the attachment's alt text; only sent when the payload's attachments are built from the files
+	Reader      io.Reader // the file contents; read once when the request is encoded
+}
+
+// attachmentsFor - Builds the attachments array matching each file to its files[n] part by id
+func attachmentsFor(files []*File) []*Attachment {
+	attachments := make([]*Attachment, 0, len(files))
+	for i, file := range files {
+		attachments = append(attachments, &Attachment{
+			ID:          Snowflake(strconv.Itoa(i)),
+			Filename:    file.Name,
+			Description: file.Description,
+		})
+	}
+
+	return attachments
+}
+
+// ErrFileTooLarge - returned before uploading a File that exceeds the upload limit of the destination
+var ErrFileTooLarge = errors.New("file exceeds the upload size limit")
+
+// Size - Returns the size of the File when it can be determined without reading it
+//
+// Readers such as *bytes.Reader, *bytes.Buffer and *strings.Reader report their length, and *os.File its stat size.
+func (f *File) Size() (int64, bool) {
+	switch r := f.Reader.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len()), true
+	case interface{ Stat() (os.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, false
+		}
+		return info.Size(), true
+	}
+
+	return 0, false
+}
+
+// ValidateUploadSize - Checks that every File whose size is known fits within the limit, e.g. Guild.MaxUploadSize
+//
+// Files of unknown size are checked again while they are encoded, without reading past the limit.
+//
+//goland:noinspection GoUnusedExportedFunction
+func ValidateUploadSize(files []*File, limit int64) error {
+	for _, file := range files {
+		if size, ok := file.Size(); ok && size > limit {
+			return fileTooLarge(file.Name, size, limit)
+		}
+	}
+
+	return nil
+}
+
+// fitsUploadSize - reports whether the size of every File is known and within the limit
+func fitsUploadSize(files []*File, limit int64) bool {
+	for _, file := range files {
+		if size, ok := file.Size(); !ok || size > limit {
+			return false
+		}
+	}
+
+	return true
+}
+
+func fileTooLarge(name string, size, limit int64) error {
+	if size > limit {
+		return fmt.Errorf("%w: %q is %d bytes and the limit is %d bytes", ErrFileTooLarge, name, size, limit)
+	}
+
+	return fmt.Errorf("%w: %q is larger than the %d byte limit", ErrFileTooLarge, name, limit)
+}
+
+// ImageDataURI - Encodes an image as the data URI Discord expects for icon, avatar and banner fields, e.g. "image/png"
+//
+//goland:noinspection GoUnusedExportedFunction
+func ImageDataURI(contentType string, data []byte) string {
+	return "data:" + contentType + ";base64," + base64.StdEncoding.EncodeToString(data)
+}
+
+// rawBody - a pre-encoded request body sent as-is, so it can be replayed when a request is retried after a 429
+type rawBody struct {
+	contentType string
+	data        []byte
+}
+
+// encodeMultipart - writes the payload as payload_json followed by each file as files[n], failing as soon as a file passes the limit
+func encodeMultipart(payload any, files []*File, limit int64) (*rawBody, error) {
+	var buffer bytes.Buffer
+	writer := multipart.NewWriter(&buffer)
+
+	if payload != nil {
+		payloadJSON, err := JSONMarshal(payload)
+		if err != nil {
+			return nil, err
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="payload_json"`)
+		header.Set("Content-Type", "application/json")
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = part.Write(payloadJSON); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, file := range files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="files[%d]"; filename="%s"`, i, quoteEscaper.Replace(file.Name)))
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		written, err := io.Copy(part, io.LimitReader(file.Reader, limit+1))
+		if err != nil {
+			return nil, err
+		}
+		if written > limit {
+			return nil, fileTooLarge(file.Name, 0, limit)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return &rawBody{contentType: writer.FormDataContentType(), data: buffer.Bytes()}, nil
+}
+
+// quoteEscaper - escapes file names for the Content-Disposition header, as mime/multipart does
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// fireMultipart - sends the payload and files through the RateLimiter, with its default AllowedMentions, and reads the response
+func (r *RateLimiter) fireMultipart(method string, u *url.URL, payload any, files []*File, limit int64, reason *string) ([]byte, error) {
+	if err := ValidateUploadSize(files, limit); err != nil {
+		return nil, err
+	}
+
+	body, err := encodeMultipart(r.applyDefaultAllowedMentions(payload), files, limit)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return nil, err
+	}
+
+	resp, err := r.Request(method, u.String(), body, reason)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return []byte{}, err // we return an empty byte slice here to avoid nil pointer problems
+	}
+
+	return b, nil
+}
+
+// firePostMultipartRequest - POST a JSON payload with files attached
+func firePostMultipartRequest(u *url.URL, payload any, files []*File, limit int64, reason *string) ([]byte, error) {
+	return Rest.fireMultipart(http.MethodPost, u, payload, files, limit, reason)
+}
+
+// firePatchMultipartRequest - PATCH a JSON payload with files attached
+func firePatchMultipartRequest(u *url.URL, payload any, files []*File, limit int64, reason *string) ([]byte, error) {
+	return Rest.fireMultipart(http.MethodPatch, u, payload, files, limit, reason)
+}
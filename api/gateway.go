@@ -15,8 +15,6 @@
 package api
 
 import (
-	"encoding/json"
-
 	log "github.com/veteran-software/nowlive-logging"
 )
 
@@ -39,7 +37,7 @@ func GetGateway() (*GetGatewayResponse, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &gatewayResponseBytes)
+	err = decodeResponse(u, responseBytes, &gatewayResponseBytes)
 
 	return gatewayResponseBytes, nil
 }
@@ -66,7 +64,7 @@ func GetGatewayBot() (*GetGatewayBotResponse, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &gatewayResponseBytes)
+	err = decodeResponse(u, responseBytes, &gatewayResponseBytes)
 
 	return gatewayResponseBytes, nil
 }
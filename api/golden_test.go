@@ -0,0 +1,36 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/discordtest"
+)
+
+// TestGoldenRoundTrip - every payload in testdata/golden must survive decoding into its type and encoding again
+func TestGoldenRoundTrip(t *testing.T) {
+	discordtest.RunGolden(t, os.DirFS("testdata/golden"), map[string]discordtest.RoundTripFunc{
+		"channel":      discordtest.RoundTrip[api.Channel],
+		"emoji":        discordtest.RoundTrip[api.Emoji],
+		"guild":        discordtest.RoundTrip[api.Guild],
+		"guild_member": discordtest.RoundTrip[api.GuildMember],
+		"message":      discordtest.RoundTrip[api.Message],
+		"role":         discordtest.RoundTrip[api.Role],
+		"user":         discordtest.RoundTrip[api.User],
+	})
+}
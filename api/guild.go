@@ -49,7 +49,7 @@ type Guild struct {
 	Banner                      *string                         `json:"banner"`                                  // banner hash
 	PremiumTier                 PremiumTier                     `json:"premium_tier"`                            // premium tier (Server Boost level)
 	PremiumSubscriptionCount    uint64                          `json:"premium_subscription_count,omitempty"`    // the number of boosts this guild currently has
-	PreferredLocale             string                          `json:"preferred_locale"`                        // the preferred locale of a Community guild; used in server discovery and notices from Discord, and sent in interactions; defaults to "en-US"
+	PreferredLocale             Locale                          `json:"preferred_locale"`                        // the preferred locale of a Community guild; used in server discovery and notices from Discord, and sent in interactions; defaults to "en-US"
 	PublicUpdatesChannelID      *Snowflake                      `json:"public_updates_channel_id"`               // the id of the channel where admins and moderators of Community guilds receive notices from Discord
 	MaxVideoChannelUsers        uint64                          `json:"max_video_channel_users,omitempty"`       // the maximum amount of users in a video channel
 	MaxStageVideoChannelUsers   uint64                          `json:"max_stage_video_channel_users,omitempty"` // the maximum amount of users in a stage video channel
@@ -59,6 +59,8 @@ type Guild struct {
 	NsfwLevel                   GuildNsfwLevel                  `json:"nsfw_level"`                              // guild NSFW level
 	Stickers                    []*Sticker                      `json:"stickers,omitempty"`                      // custom guild stickers
 	PremiumProgressBarEnabled   bool                            `json:"premium_progress_bar_enabled"`            // whether the guild has the boost progress bar enabled
+	SafetyAlertsChannelID       *Snowflake                      `json:"safety_alerts_channel_id"`                // the id of the channel where admins and moderators of Community guilds receive safety alerts from Discord
+	HubType                     *HubType                        `json:"hub_type,omitempty"`                      // the type of Student Hub the guild is, when it has the Hub feature
 
 	// These fields are only sent when using the GET CurrentUserGuilds endpoint and are relative to the requested user
 
@@ -75,6 +77,11 @@ const (
 	OnlyMentions                                        // members will receive notifications only for messages that @mention them by default
 )
 
+// IsValid - Checks whether the DefaultMessageNotificationLevel is one Discord defines
+func (l DefaultMessageNotificationLevel) IsValid() bool {
+	return l >= AllMessages && l <= OnlyMentions
+}
+
 // ExplicitContentFilterLevel - explicit content filter level
 type ExplicitContentFilterLevel int
 
@@ -85,6 +92,11 @@ const (
 	AllMembers                                            // media content sent by all members will be scanned
 )
 
+// IsValid - Checks whether the ExplicitContentFilterLevel is one Discord defines
+func (l ExplicitContentFilterLevel) IsValid() bool {
+	return l >= Disabled && l <= AllMembers
+}
+
 // MfaLevel - required MFA level for the guild
 type MfaLevel int
 
@@ -94,6 +106,11 @@ const (
 	MfaElevated                 // guild has a 2FA requirement for moderation actions
 )
 
+// IsValid - Checks whether the MfaLevel is one Discord defines
+func (l MfaLevel) IsValid() bool {
+	return l == MfaNone || l == MfaElevated
+}
+
 // VerificationLevel - verification level required for the guild
 type VerificationLevel int
 
@@ -106,6 +123,11 @@ const (
 	VerificationLevelVeryHigh                          // must have a verified phone number
 )
 
+// IsValid - Checks whether the VerificationLevel is one Discord defines
+func (l VerificationLevel) IsValid() bool {
+	return l >= VerificationLevelNone && l <= VerificationLevelVeryHigh
+}
+
 // GuildNsfwLevel - guild NSFW level
 type GuildNsfwLevel int
 
@@ -117,6 +139,11 @@ const (
 	NsfwAgeRestricted
 )
 
+// IsValid - Checks whether the GuildNsfwLevel is one Discord defines
+func (l GuildNsfwLevel) IsValid() bool {
+	return l >= NsfwDefault && l <= NsfwAgeRestricted
+}
+
 // PremiumTier - premium tier (Server Boost level)
 type PremiumTier int
 
@@ -128,6 +155,63 @@ const (
 	PremiumTier3                    // guild has unlocked Server Boost level 3 perks
 )
 
+// DefaultMaxUploadSize - the largest file, in bytes, that can be uploaded to a DM or a guild below Server Boost level 2
+const DefaultMaxUploadSize int64 = 10 * 1024 * 1024
+
+// MaxUploadSize - Returns the largest file, in bytes, members can upload at this Server Boost level
+func (t PremiumTier) MaxUploadSize() int64 {
+	switch t {
+	case PremiumTier2:
+		return 50 * 1024 * 1024
+	case PremiumTier3:
+		return 100 * 1024 * 1024
+	default:
+		return DefaultMaxUploadSize
+	}
+}
+
+// EmojiLimit - Returns the number of static emoji a guild can have at this Server Boost level; animated emoji have a separate limit of the same size
+func (t PremiumTier) EmojiLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 100
+	case PremiumTier2:
+		return 150
+	case PremiumTier3:
+		return 250
+	default:
+		return 50
+	}
+}
+
+// StickerLimit - Returns the number of custom stickers a guild can have at this Server Boost level
+func (t PremiumTier) StickerLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 15
+	case PremiumTier2:
+		return 30
+	case PremiumTier3:
+		return 60
+	default:
+		return 5
+	}
+}
+
+// SoundboardLimit - Returns the number of soundboard sounds a guild can have at this Server Boost level
+func (t PremiumTier) SoundboardLimit() int {
+	switch t {
+	case PremiumTier1:
+		return 24
+	case PremiumTier2:
+		return 36
+	case PremiumTier3:
+		return 48
+	default:
+		return 8
+	}
+}
+
 // SystemChannelFlags - system channel flags
 type SystemChannelFlags int
 
@@ -157,13 +241,16 @@ const (
 	DeveloperSupportServer                GuildFeatures = "DEVELOPER_SUPPORT_SERVER"                  // guild has been set as a support server on the App Directory
 	Discoverable                          GuildFeatures = "DISCOVERABLE"                              // Mutable; guild is able to be discovered in the directory
 	Featurable                            GuildFeatures = "FEATURABLE"                                // guild is able to be featured in the directory
+	Hub                                   GuildFeatures = "HUB"                                       // guild is a Student Hub; its GuildDirectory channel lists the servers linked to it
 	InvitesDisabled                       GuildFeatures = "INVITES_DISABLED"                          // Mutable; Pauses all invites/access to the server
 	InviteSplash                          GuildFeatures = "INVITE_SPLASH"                             // guild has access to set an invite splash background
+	LinkedToHub                           GuildFeatures = "LINKED_TO_HUB"                             // guild is listed in a Student Hub
 	MemberVerificationGateEnabled         GuildFeatures = "MEMBER_VERIFICATION_GATE_ENABLED"          // guild has enabled Membership Screening
 	MoreStickers                          GuildFeatures = "MORE_STICKERS"                             // guild has increased custom sticker slots
 	News                                  GuildFeatures = "NEWS"                                      // guild has access to create news channels
 	Partnered                             GuildFeatures = "PARTNERED"                                 // guild is partnered
 	PreviewEnabled                        GuildFeatures = "PREVIEW_ENABLED"                           // guild can be previewed before joining via Membership Screening or the directory
+	RaidAlertsDisabled                    GuildFeatures = "RAID_ALERTS_DISABLED"                      // Mutable; guild has disabled alerts for join raids in the configured safety alerts channel
 	RoleIcons                             GuildFeatures = "ROLE_ICONS"                                // guild is able to set role icons
 	RoleSubscriptionsAvailableForPurchase GuildFeatures = "ROLE_SUBSCRIPTIONS_AVAILABLE_FOR_PURCHASE" // guild has role subscriptions that can be purchased
 	RoleSubscriptionsEnabled              GuildFeatures = "ROLE_SUBSCRIPTIONS_ENABLED"                // guild has enabled role subscriptions
@@ -174,6 +261,16 @@ const (
 	WelcomeScreenEnabled                  GuildFeatures = "WELCOME_SCREEN_ENABLED"                    // guild has enabled the welcome screen
 )
 
+// HubType - the type of school a Student Hub is for
+type HubType int
+
+//goland:noinspection GoUnusedConst
+const (
+	HubTypeDefault    HubType = iota // the hub is not for a particular type of school
+	HubTypeHighSchool                // the hub is for a high school
+	HubTypeCollege                   // the hub is for a college or university
+)
+
 // UnavailableGuild - A partial guild object.
 //
 // Represents an Offline Guild, or a Guild whose information has not been provided through Guild Create events during the Gateway connect.
@@ -203,18 +300,52 @@ type GuildWidgetSettings struct {
 	ChannelID *Snowflake `json:"channel_id"` // the widget channel id
 }
 
-// GetGuildWidget - the guild widget
+// GuildWidget - the public guild widget served from widget.json
 //
 // The fields `id`, `discriminator` and `avatar` are anonymized to prevent abuse.
-type GetGuildWidget struct {
-	ID            Snowflake      `json:"id"`             // guild id
-	Name          string         `json:"name"`           // guild name (2-100 characters)
-	InstantInvite *string        `json:"instant_invite"` // instant invite for the guilds specified widget invite channel
-	Channels      []*Channel     `json:"channels"`       // voice and stage channels which are accessible by @everyone
-	Members       []*GuildMember `json:"members"`        // special widget user objects that includes users presence (Limit 100)
-	PresenceCount int            `json:"presence_count"` // number of online members in this guild
+type GuildWidget struct {
+	ID            Snowflake             `json:"id"`             // guild id
+	Name          string                `json:"name"`           // guild name (2-100 characters)
+	InstantInvite *string               `json:"instant_invite"` // instant invite for the guilds specified widget invite channel
+	Channels      []*GuildWidgetChannel `json:"channels"`       // voice and stage channels which are accessible by @everyone
+	Members       []*GuildWidgetMember  `json:"members"`        // special widget user objects that includes users presence (Limit 100)
+	PresenceCount int                   `json:"presence_count"` // number of online members in this guild
+}
+
+// GetGuildWidget - the guild widget
+//
+// Deprecated: use GuildWidget
+type GetGuildWidget = GuildWidget
+
+// GuildWidgetChannel - a partial channel listed on the guild widget
+type GuildWidgetChannel struct {
+	ID       Snowflake `json:"id"`       // channel id
+	Name     string    `json:"name"`     // channel name
+	Position int       `json:"position"` // sorting position of the channel
+}
+
+// GuildWidgetMember - an anonymized user listed on the guild widget
+type GuildWidgetMember struct {
+	ID            string  `json:"id"`            // anonymized index of the member
+	Username      string  `json:"username"`      // username of the member
+	Discriminator string  `json:"discriminator"` // always "0000" on the widget
+	Avatar        *string `json:"avatar"`        // always null on the widget
+	Status        string  `json:"status"`        // online, idle or dnd
+	AvatarURL     string  `json:"avatar_url"`    // proxied avatar url for the member
 }
 
+// WidgetStyle - the style of the guild widget image
+type WidgetStyle string
+
+//goland:noinspection GoUnusedConst
+const (
+	WidgetStyleShield  WidgetStyle = "shield"  // shield style widget with Discord icon and guild members online count
+	WidgetStyleBanner1 WidgetStyle = "banner1" // large image with guild icon, name and online count. "POWERED BY DISCORD" as the footer of the widget
+	WidgetStyleBanner2 WidgetStyle = "banner2" // smaller widget style with guild icon, name and online count. Split on the right with Discord logo
+	WidgetStyleBanner3 WidgetStyle = "banner3" // large image with guild icon, name and online count. In the footer, Discord logo on the left and "Chat Now" on the right
+	WidgetStyleBanner4 WidgetStyle = "banner4" // large Discord logo at the top of the widget. Guild icon, name and online count in the middle portion of the widget and a "JOIN MY SERVER" button at the bottom
+)
+
 // GuildMember - Represents a member of a Guild
 //
 //	The field `user` won't be included in the member object attached to MessageCreate and MessageUpdate gateway events.
@@ -226,14 +357,15 @@ type GuildMember struct {
 	Nick                       *string         `json:"nick,omitempty"`                         // Nick - the users' guild nickname
 	Avatar                     *string         `json:"avatar,omitempty"`                       // Avatar - guild specific avatar
 	Roles                      []*Snowflake    `json:"roles"`                                  // Roles - array of GuildRole id's
-	JoinedAt                   time.Time       `json:"joined_at"`                              // JoinedAt - when the user joined the guild
-	PremiumSince               *time.Time      `json:"premium_since,omitempty"`                // PremiumSince - when the user started boosting the guild
+	JoinedAt                   Timestamp       `json:"joined_at"`                              // JoinedAt - when the user joined the guild
+	PremiumSince               *Timestamp      `json:"premium_since,omitempty"`                // PremiumSince - when the user started boosting the guild
 	Deaf                       bool            `json:"deaf"`                                   // Deaf - whether the user is deafened in voice channels
 	Mute                       bool            `json:"mute"`                                   // Mute - whether the user is muted in voice channels
 	Flags                      GuildMemberFlag `json:"flags,omitempty"`                        // Flags - guild member flags represented as a bit set, defaults to 0
 	Pending                    bool            `json:"pending,omitempty"`                      // Pending - whether the user has not yet passed the guild's Membership Screening requirements
 	Permissions                *string         `json:"permissions"`                            // Permissions - total permissions of the member in the channel, including overwrites, returned when in the interaction object
-	CommunicationDisabledUntil *time.Time      `json:"communication_disabled_until,omitempty"` // CommunicationDisabledUntil - when the user's timeout will expire and the user will be able to communicate in the guild again, null or a time in the past if the user is not timed out
+	CommunicationDisabledUntil *Timestamp      `json:"communication_disabled_until,omitempty"` // CommunicationDisabledUntil - when the user's timeout will expire and the user will be able to communicate in the guild again, null or a time in the past if the user is not timed out
+	GuildID                    Snowflake       `json:"guild_id,omitempty"`                     // GuildID - the guild the member belongs to; sent with gateway member events, and set by the Guild endpoints that return members
 
 	// Undocumented as of 12/3/2022
 	IsPending bool `json:"is_pending,omitempty"`
@@ -244,17 +376,46 @@ type GuildMemberFlag int
 
 //goland:noinspection GoUnusedConst
 const (
-	DidRejoin            GuildMemberFlag = 1 << 0 // DidRejoin - Member has left and rejoined the guild
-	CompletedOnboarding  GuildMemberFlag = 1 << 1 // CompletedOnboarding - Member has completed onboarding
-	BypassesVerification GuildMemberFlag = 1 << 2 // BypassesVerification - Member is exempt from guild verification requirements
-	StartedOnboarding    GuildMemberFlag = 1 << 3 // StartedOnboarding - Member has started onboarding
+	DidRejoin                    GuildMemberFlag = 1 << 0 // DidRejoin - Member has left and rejoined the guild
+	CompletedOnboarding          GuildMemberFlag = 1 << 1 // CompletedOnboarding - Member has completed onboarding
+	BypassesVerification         GuildMemberFlag = 1 << 2 // BypassesVerification - Member is exempt from guild verification requirements
+	StartedOnboarding            GuildMemberFlag = 1 << 3 // StartedOnboarding - Member has started onboarding
+	IsGuest                      GuildMemberFlag = 1 << 4 // IsGuest - Member is a guest and can only access the voice channel they were invited to
+	StartedHomeActions           GuildMemberFlag = 1 << 5 // StartedHomeActions - Member has started Server Guide new member actions
+	CompletedHomeActions         GuildMemberFlag = 1 << 6 // CompletedHomeActions - Member has completed Server Guide new member actions
+	AutomodQuarantinedUsername   GuildMemberFlag = 1 << 7 // AutomodQuarantinedUsername - Member's username, display name, or nickname is blocked by AutoMod
+	DmSettingsUpsellAcknowledged GuildMemberFlag = 1 << 9 // DmSettingsUpsellAcknowledged - Member has dismissed the DM settings upsell
 )
 
+// HasFlag - Checks whether the member has every bit of the given GuildMemberFlag set
+func (m *GuildMember) HasFlag(flag GuildMemberFlag) bool {
+	return m.Flags&flag == flag
+}
+
+// IsTimedOut - Checks whether the member is currently timed out
+//
+// Discord leaves communication_disabled_until in the past once a timeout expires, so the time is compared against now.
+func (m *GuildMember) IsTimedOut() bool {
+	return m.CommunicationDisabledUntil != nil && m.CommunicationDisabledUntil.After(time.Now())
+}
+
+// AwaitingScreening - Checks whether the member has yet to pass the guild's Membership Screening requirements
+//
+// Pending members cannot talk or react until they accept the rules, unless they have BypassesVerification.
+func (m *GuildMember) AwaitingScreening() bool {
+	return (m.Pending || m.IsPending) && !m.HasFlag(BypassesVerification)
+}
+
+// IsBoosting - Checks whether the member is currently boosting the guild
+func (m *GuildMember) IsBoosting() bool {
+	return m.PremiumSince != nil
+}
+
 // Integration - a guild integration
 type Integration struct {
 	ID                Snowflake                 `json:"id"`                            // integration id
 	Name              string                    `json:"name"`                          // integration name
-	Type              string                    `json:"type"`                          // integration type (twitch, YouTube, or discord)
+	Type              IntegrationType           `json:"type"`                          // integration type (twitch, youtube, discord, or guild_subscription)
 	Enabled           bool                      `json:"enabled"`                       // is this integration enabled
 	Syncing           bool                      `json:"syncing,omitempty"`             // is this integration syncing
 	RoleID            Snowflake                 `json:"role_id,omitempty"`             // id that this integration uses for "subscribers"
@@ -263,13 +424,24 @@ type Integration struct {
 	ExpireGracePeriod int                       `json:"expire_grace_period,omitempty"` // the grace period (in days) before expiring subscribers
 	User              User                      `json:"user,omitempty"`                // user for this integration
 	Account           IntegrationAccount        `json:"account"`                       // integration account information
-	SyncedAt          time.Time                 `json:"synced_at,omitempty"`           // when this integration was last synced
+	SyncedAt          *time.Time                `json:"synced_at,omitempty"`           // when this integration was last synced
 	SubscriberCount   int                       `json:"subscriber_count,omitempty"`    // how many subscribers this integration has
 	Revoked           bool                      `json:"revoked,omitempty"`             // has this integration been revoked
 	Application       IntegrationApplication    `json:"application,omitempty"`         // The bot/OAuth2 application for discord integrations
 	Scopes            []string                  `json:"scopes,omitempty"`              // the scopes the application has been authorized for
 }
 
+// IntegrationType - the platform an integration belongs to
+type IntegrationType string
+
+//goland:noinspection GoUnusedConst
+const (
+	TwitchIntegration            IntegrationType = "twitch"             // Twitch subscriber integration
+	YouTubeIntegration           IntegrationType = "youtube"            // YouTube membership integration
+	DiscordIntegration           IntegrationType = "discord"            // bot or OAuth2 application integration
+	GuildSubscriptionIntegration IntegrationType = "guild_subscription" // server subscription integration
+)
+
 // IntegrationExpireBehavior - the behavior of expiring subscribers
 type IntegrationExpireBehavior int
 
@@ -353,7 +525,7 @@ type PromptType int
 
 //goland:noinspection GoUnusedConst
 const (
-	MultipleChoice PremiumType = iota
+	MultipleChoice PromptType = iota
 	Dropdown
 )
 
@@ -361,3 +533,95 @@ const (
 func (g *Guild) String() string {
 	return g.Name + "(" + g.ID.String() + ")"
 }
+
+// MaxUploadSize - Returns the largest file, in bytes, that can be uploaded to the Guild
+func (g *Guild) MaxUploadSize() int64 {
+	return g.PremiumTier.MaxUploadSize()
+}
+
+// EmojiSlots - Returns how many more static and animated emoji can be created in the Guild
+//
+// Requires the Guild's Emojis, which are sent in GUILD_CREATE and by GetGuild.
+func (g *Guild) EmojiSlots() (static int, animated int) {
+	limit := g.PremiumTier.EmojiLimit()
+	static, animated = limit, limit
+
+	for _, emoji := range g.Emojis {
+		if emoji == nil {
+			continue
+		}
+		if emoji.Animated {
+			animated--
+		} else {
+			static--
+		}
+	}
+
+	return max(static, 0), max(animated, 0)
+}
+
+// StickerSlots - Returns how many more custom stickers can be created in the Guild
+//
+// Requires the Guild's Stickers, which are sent in GUILD_CREATE and by GetGuild.
+func (g *Guild) StickerSlots() int {
+	limit := g.PremiumTier.StickerLimit()
+	if g.HasFeature(MoreStickers) {
+		limit = PremiumTier3.StickerLimit()
+	}
+
+	return max(limit-len(g.Stickers), 0)
+}
+
+// premiumTierBoosts - the number of boosts that unlock each Server Boost level
+var premiumTierBoosts = map[PremiumTier]uint64{PremiumTier1: 2, PremiumTier2: 7, PremiumTier3: 14}
+
+// BoostsToNextTier - Returns how many more boosts the Guild needs to reach the next Server Boost level, or 0 at PremiumTier3
+func (g *Guild) BoostsToNextTier() uint64 {
+	if g.PremiumTier >= PremiumTier3 {
+		return 0
+	}
+
+	needed := premiumTierBoosts[g.PremiumTier+1]
+	if g.PremiumSubscriptionCount >= needed {
+		return 0
+	}
+
+	return needed - g.PremiumSubscriptionCount
+}
+
+// SoundboardSlots - Returns how many more soundboard sounds can be created in the Guild, given the number it already has
+//
+// The Guild object does not carry its sounds; count the SoundboardSounds sent in GUILD_CREATE.
+func (g *Guild) SoundboardSlots(sounds int) int {
+	return max(g.PremiumTier.SoundboardLimit()-sounds, 0)
+}
+
+// HasFeature - Helper function to check whether the Guild has the given feature enabled
+func (g *Guild) HasFeature(feature GuildFeatures) bool {
+	return hasFeature(g.Features, feature)
+}
+
+// IsCommunity - Helper function to check whether the Guild has the Community feature enabled
+func (g *Guild) IsCommunity() bool {
+	return g.HasFeature(Community)
+}
+
+// IsDiscoverable - Helper function to check whether the Guild is listed in Server Discovery
+func (g *Guild) IsDiscoverable() bool {
+	return g.HasFeature(Discoverable)
+}
+
+// HasFeature - Helper function to check whether the previewed Guild has the given feature enabled
+func (g *GuildPreview) HasFeature(feature GuildFeatures) bool {
+	return hasFeature(g.Features, feature)
+}
+
+func hasFeature(features []*GuildFeatures, feature GuildFeatures) bool {
+	for _, f := range features {
+		if f != nil && *f == feature {
+			return true
+		}
+	}
+
+	return false
+}
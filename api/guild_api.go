@@ -0,0 +1,20 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+// SetPremiumProgressBar - Shows or hides the Server Boost progress bar of the Guild; requires the ManageGuild permission
+func (g *Guild) SetPremiumProgressBar(enabled bool, reason *string) (*Guild, error) {
+	return g.ModifyGuild(ModifyGuildJSON{PremiumProgressBarEnabled: &enabled}, reason)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// DefaultCountsInterval - how often a CountsRefresher fetches the counts when Interval is not set
+const DefaultCountsInterval = 5 * time.Minute
+
+// GuildCounts - the approximate member and presence counts of a guild
+//
+// Discord computes these itself, so they are available without the GuildPresences intent or a member cache.
+type GuildCounts struct {
+	GuildID   Snowflake `json:"guild_id"`       // the guild the counts belong to
+	Members   uint64    `json:"member_count"`   // approximate number of members
+	Presences uint64    `json:"presence_count"` // approximate number of non-offline members
+	FetchedAt time.Time `json:"fetched_at"`     // when the counts were fetched
+}
+
+// GetGuildCounts - Returns the approximate member and presence counts of the Guild, using GetGuild with with_counts set
+func (g *Guild) GetGuildCounts() (*GuildCounts, error) {
+	withCounts := true
+	guild, err := g.GetGuild(&withCounts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuildCounts{
+		GuildID:   guild.ID,
+		Members:   guild.ApproximateMemberCount,
+		Presences: guild.ApproximatePresenceCount,
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+// GetInviteCounts - Returns the approximate member and presence counts of the guild the Invite is for, using GetInvite with with_counts set
+//
+// This works for guilds the bot is not a member of.
+func (i *Invite) GetInviteCounts() (*GuildCounts, error) {
+	withCounts := true
+	invite, err := i.GetInvite(&withCounts, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuildCounts{
+		GuildID:   invite.Guild.ID,
+		Members:   invite.ApproximateMemberCount,
+		Presences: invite.ApproximatePresenceCount,
+		FetchedAt: time.Now(),
+	}, nil
+}
+
+// CountsRefresher - fetches guild counts on an interval and keeps the latest ones, e.g. for a member count in a status or channel name
+type CountsRefresher struct {
+	Fetch    func() (*GuildCounts, error) // fetches the counts, e.g. Guild.GetGuildCounts or Invite.GetInviteCounts
+	Interval time.Duration                // the time between fetches; DefaultCountsInterval when zero
+	OnChange func(counts GuildCounts)     // receives the counts after the first fetch and whenever they change
+	OnError  func(err error)              // receives failed fetches; they are logged when nil
+
+	mu     sync.RWMutex
+	latest *GuildCounts
+}
+
+// NewGuildCountsRefresher - Creates a CountsRefresher for a guild the bot is a member of
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewGuildCountsRefresher(guildID Snowflake, interval time.Duration) *CountsRefresher {
+	guild := &Guild{ID: guildID}
+	return &CountsRefresher{Fetch: guild.GetGuildCounts, Interval: interval}
+}
+
+// NewInviteCountsRefresher - Creates a CountsRefresher that reads the counts from an invite, for guilds the bot is not a member of
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewInviteCountsRefresher(code string, interval time.Duration) *CountsRefresher {
+	invite := &Invite{Code: &code}
+	return &CountsRefresher{Fetch: invite.GetInviteCounts, Interval: interval}
+}
+
+// Run - Fetches the counts right away and then every Interval until the context ends, returning the context's error
+//
+// A failed fetch keeps the previous counts. A CountsRefresher must not be run more than once at the same time.
+func (r *CountsRefresher) Run(ctx context.Context) error {
+	if r.Fetch == nil {
+		return errors.New("counts refresher has no fetch function")
+	}
+
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultCountsInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.refresh()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Latest - Returns the most recently fetched counts, or false before the first successful fetch
+func (r *CountsRefresher) Latest() (GuildCounts, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.latest == nil {
+		return GuildCounts{}, false
+	}
+
+	return *r.latest, true
+}
+
+// refresh - fetches the counts once, reporting them when they differ from the previous ones
+func (r *CountsRefresher) refresh() {
+	counts, err := r.Fetch()
+	if err != nil {
+		if r.OnError != nil {
+			r.OnError(err)
+		} else {
+			log.Errorln(log.Discord, log.FuncName(), err)
+		}
+		return
+	}
+
+	r.mu.Lock()
+	changed := r.latest == nil || r.latest.Members != counts.Members || r.latest.Presences != counts.Presences
+	r.latest = counts
+	r.mu.Unlock()
+
+	if changed && r.OnChange != nil {
+		r.OnChange(*counts)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuildCounts(t *testing.T) {
+	var routes []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		routes = append(routes, strings.TrimPrefix(req.URL.String(), api))
+
+		body := `{"id":"1","name":"Chess club","approximate_member_count":120,"approximate_presence_count":45}`
+		if strings.HasPrefix(req.URL.Path, "/api/v10/invites/") {
+			body = `{"code":"chess","guild":{"id":"1"},"approximate_member_count":120,"approximate_presence_count":45}`
+		}
+		return http.StatusOK, body
+	})
+
+	fromGuild, err := (&Guild{ID: "1"}).GetGuildCounts()
+	if err != nil {
+		t.Fatalf("GetGuildCounts() error = %v", err)
+	}
+	code := "chess"
+	fromInvite, err := (&Invite{Code: &code}).GetInviteCounts()
+	if err != nil {
+		t.Fatalf("GetInviteCounts() error = %v", err)
+	}
+
+	want := []string{"/guilds/1?with_counts=true", "/invites/chess?with_counts=true"}
+	if len(routes) != 2 || routes[0] != want[0] || routes[1] != want[1] {
+		t.Errorf("routes = %q, want %q", routes, want)
+	}
+	for name, counts := range map[string]*GuildCounts{"GetGuildCounts": fromGuild, "GetInviteCounts": fromInvite} {
+		if counts.GuildID != "1" || counts.Members != 120 || counts.Presences != 45 {
+			t.Errorf("%s() = %+v, want guild 1 with 120 members and 45 presences", name, counts)
+		}
+	}
+}
+
+func TestCountsRefresher(t *testing.T) {
+	results := []*GuildCounts{
+		{Members: 10, Presences: 4},
+		{Members: 10, Presences: 4},
+		nil,
+		{Members: 11, Presences: 5},
+	}
+	fetches := 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var changes []GuildCounts
+	var failures int
+	r := &CountsRefresher{
+		Interval: time.Millisecond,
+		Fetch: func() (*GuildCounts, error) {
+			result := results[fetches]
+			fetches++
+			if fetches == len(results) {
+				cancel()
+			}
+			if result == nil {
+				return nil, errors.New("unavailable")
+			}
+			return result, nil
+		},
+		OnChange: func(counts GuildCounts) { changes = append(changes, counts) },
+		OnError:  func(error) { failures++ },
+	}
+
+	if _, ok := r.Latest(); ok {
+		t.Error("Latest() ok = true before the first fetch")
+	}
+	if err := r.Run(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() error = %v, want context.Canceled", err)
+	}
+
+	if len(changes) != 2 || changes[0].Members != 10 || changes[1].Members != 11 {
+		t.Errorf("OnChange received %+v, want the counts for 10 and 11 members", changes)
+	}
+	if failures != 1 {
+		t.Errorf("OnError called %d times, want 1", failures)
+	}
+	if latest, ok := r.Latest(); !ok || latest.Presences != 5 {
+		t.Errorf("Latest() = %+v, %v, want 5 presences", latest, ok)
+	}
+}
@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -49,7 +48,7 @@ func CreateGuild(payload *CreateGuildJSON) (*Guild, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guild)
+	err = decodeResponse(u, responseBytes, &guild)
 
 	return guild, err
 }
@@ -97,7 +96,7 @@ func (g *Guild) GetGuild(withCounts *bool) (*Guild, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guild)
+	err = decodeResponse(u, responseBytes, &guild)
 
 	return guild, err
 }
@@ -113,7 +112,7 @@ func (g *Guild) GetGuildPreview() (*GuildPreview, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildPreview)
+	err = decodeResponse(u, responseBytes, &guildPreview)
 
 	return guildPreview, err
 }
@@ -127,11 +126,16 @@ func (g *Guild) GetGuildPreview() (*GuildPreview, error) {
 // Fires a GuildUpdate Gateway event.
 //
 //	All parameters to this endpoint are optional
+//	The payload is validated with Validate before it is sent, so unknown levels are rejected locally.
 //
 //	This endpoint supports the X-Audit-Log-Reason header.
 //
 //	Attempting to add or remove the Community guild feature requires the Administrator permission.
 func (g *Guild) ModifyGuild(payload ModifyGuildJSON, reason *string) (*Guild, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
 	u := parseRoute(fmt.Sprintf(modifyGuild, api, g.ID.String()))
 
 	var guild *Guild
@@ -141,7 +145,7 @@ func (g *Guild) ModifyGuild(payload ModifyGuildJSON, reason *string) (*Guild, er
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guild)
+	err = decodeResponse(u, responseBytes, &guild)
 
 	return guild, err
 }
@@ -157,21 +161,36 @@ type ModifyGuildJSON struct {
 	VerificationLevel           *VerificationLevel               `json:"verification_level,omitempty"`            // verification level required for the guild
 	DefaultMessageNotifications *DefaultMessageNotificationLevel `json:"default_message_notifications,omitempty"` // default message notifications level
 	ExplicitContentFilter       *ExplicitContentFilterLevel      `json:"explicit_content_filter,omitempty"`       // explicit content filter level
-	AfkChannelID                *Snowflake                       `json:"afk_channel_id,omitempty,omitempty"`      // id of afk channel
+	AfkChannelID                *Nullable[Snowflake]             `json:"afk_channel_id,omitempty"`                // id of afk channel; Null removes it
 	AfkTimeout                  int64                            `json:"afk_timeout,omitempty"`                   // afk timeout in seconds
-	Icon                        *string                          `json:"icon,omitempty"`                          // icon hash
+	Icon                        *Nullable[string]                `json:"icon,omitempty"`                          // icon as a data URI; Null removes it
 	OwnerID                     Snowflake                        `json:"owner_id,omitempty"`                      // id of owner
-	Splash                      *string                          `json:"splash,omitempty,omitempty"`              // splash hash
-	DiscoverySplash             *string                          `json:"discovery_splash,omitempty"`              // discovery splash hash; only present for guilds with the "DISCOVERABLE" feature
-	Banner                      *string                          `json:"banner,omitempty"`                        // banner hash
-	SystemChannelID             *Snowflake                       `json:"system_channel_id,omitempty"`             // the id of the channel where guild notices such as welcome messages and boost events are posted
+	Splash                      *Nullable[string]                `json:"splash,omitempty"`                        // splash as a data URI; Null removes it
+	DiscoverySplash             *Nullable[string]                `json:"discovery_splash,omitempty"`              // discovery splash as a data URI; only for guilds with the "DISCOVERABLE" feature; Null removes it
+	Banner                      *Nullable[string]                `json:"banner,omitempty"`                        // banner as a data URI; Null removes it
+	SystemChannelID             *Nullable[Snowflake]             `json:"system_channel_id,omitempty"`             // the id of the channel where guild notices such as welcome messages and boost events are posted; Null turns them off
 	SystemChannelFlags          SystemChannelFlags               `json:"system_channel_flags,omitempty"`          // system channel flags
-	RulesChannelID              *Snowflake                       `json:"rules_channel_id,omitempty"`              // the id of the channel where Community guilds can display rules and/or guidelines
-	PublicUpdatesChannelID      *Snowflake                       `json:"public_updates_channel_id,omitempty"`     // the id of the channel where admins and moderators of Community guilds receive notices from Discord
-	PreferredLocale             string                           `json:"preferred_locale,omitempty"`              // the preferred locale of a Community guild; used in server discovery and notices from Discord, and sent in interactions; defaults to "en-US"
+	RulesChannelID              *Nullable[Snowflake]             `json:"rules_channel_id,omitempty"`              // the id of the channel where Community guilds can display rules and/or guidelines
+	PublicUpdatesChannelID      *Nullable[Snowflake]             `json:"public_updates_channel_id,omitempty"`     // the id of the channel where admins and moderators of Community guilds receive notices from Discord
+	PreferredLocale             Locale                           `json:"preferred_locale,omitempty"`              // the preferred locale of a Community guild; used in server discovery and notices from Discord, and sent in interactions; defaults to "en-US"
 	Features                    []*GuildFeatures                 `json:"features,omitempty"`                      // enabled guild features
-	Description                 *string                          `json:"description,omitempty"`                   // the description of a Community guild
-	PremiumProgressBarEnabled   bool                             `json:"premium_progress_bar_enabled,omitempty"`  // whether the guild has the boost progress bar enabled
+	Description                 *Nullable[string]                `json:"description,omitempty"`                   // the description of a Community guild; Null removes it
+	PremiumProgressBarEnabled   *bool                            `json:"premium_progress_bar_enabled,omitempty"`  // whether the guild has the boost progress bar enabled
+}
+
+// Validate - Checks that every level set on the payload is one Discord defines
+func (p *ModifyGuildJSON) Validate() error {
+	if p.VerificationLevel != nil && !p.VerificationLevel.IsValid() {
+		return fmt.Errorf("%v is not a valid verification level", *p.VerificationLevel)
+	}
+	if p.DefaultMessageNotifications != nil && !p.DefaultMessageNotifications.IsValid() {
+		return fmt.Errorf("%v is not a valid default message notification level", *p.DefaultMessageNotifications)
+	}
+	if p.ExplicitContentFilter != nil && !p.ExplicitContentFilter.IsValid() {
+		return fmt.Errorf("%v is not a valid explicit content filter level", *p.ExplicitContentFilter)
+	}
+
+	return nil
 }
 
 // DeleteGuild - Delete a guild permanently. User must be Owner. Returns `204 No Content` on success. Fires a GuildDelete Gateway event.
@@ -192,7 +211,7 @@ func (g *Guild) GetGuildChannels() ([]*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channels)
+	err = decodeResponse(u, responseBytes, &channels)
 
 	return channels, err
 }
@@ -208,9 +227,14 @@ func (g *Guild) GetGuildChannels() ([]*Channel, error) {
 // Returns the new channel object on success. Fires a ChannelCreate Gateway event.
 //
 //	All parameters to this endpoint are optional excluding name
+//	The payload is validated with Validate before it is sent, so fields that do not apply to the ChannelType are rejected locally.
 //
 //	This endpoint supports the X-Audit-Log-Reason header.
-func (g *Guild) CreateGuildChannel(payload CreateGuildChannelJSON, reason *string) (*Channel, error) {
+func (g *Guild) CreateGuildChannel(payload *CreateGuildChannelJSON, reason *string) (*Channel, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, err
+	}
+
 	u := parseRoute(fmt.Sprintf(createGuildChannel, api, g.ID.String()))
 
 	var channel *Channel
@@ -220,28 +244,124 @@ func (g *Guild) CreateGuildChannel(payload CreateGuildChannelJSON, reason *strin
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
 
+// CreateGuildChannelJSON - JSON payload; Type defaults to GuildText when omitted
 type CreateGuildChannelJSON struct {
-	Name                       *string          `json:"name"`                                    // the name of the channel (1-100 characters)
-	Type                       *ChannelType     `json:"type,omitempty"`                          // the ChannelType
-	Topic                      *string          `json:"topic,omitempty"`                         // the channel topic (0-1024 characters)
-	Bitrate                    *int64           `json:"bitrate,omitempty"`                       // the bitrate (in bits) of the voice channel
-	UserLimit                  *int64           `json:"user_limit,omitempty"`                    // the user limit of the voice channel
-	RateLimitPerUser           *int64           `json:"rate_limit_per_user,omitempty"`           // amount of seconds a user has to wait before sending another Message (0-21600); bots, as well as users with the permission ManageMessages or ManageChannels, are unaffected
-	Position                   *int             `json:"position,omitempty"`                      // sorting position of the channel
-	PermissionOverwrites       []*Overwrite     `json:"permission_overwrites,omitempty"`         // explicit permission overwrites for members and roles
-	ParentID                   *Snowflake       `json:"parent_id,omitempty"`                     // for guild channels: id of the parent category for a channel (each parent category can contain up to 50 channels), for threads: id of the text channel this thread was created
-	Nsfw                       *bool            `json:"nsfw,omitempty"`                          // whether the channel is nsfw
-	RtcRegion                  *string          `json:"rtc_region,omitempty"`                    // channel voice region id of the voice or stage channel, automatic when set to null
-	VideoQualityMode           VideoQualityMode `json:"video_quality_mode,omitempty"`            // the camera video quality mode of the voice channel
-	DefaultAutoArchiveDuration *int             `json:"default_auto_archive_duration,omitempty"` // default duration that the clients (not the API) will use for newly created threads, in minutes, to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
-	DefaultReactionEmoji       DefaultReaction  `json:"default_reaction_emoji,omitempty"`        // Emoji to show in the add Reaction button on a thread in a GuildForum channel
-	AvailableTags              []*ForumTag      `json:"available_tags,omitempty"`                // set of tags that can be used in a GuildForum channel
-	DefaultSortOrder           SortOrderType    `json:"default_sort_order,omitempty"`            // the default SortOrderType used to order posts in GuildForum channels
+	Name                          string            `json:"name"`                                         // the name of the channel (1-100 characters)
+	Type                          *ChannelType      `json:"type,omitempty"`                               // the ChannelType
+	Topic                         *string           `json:"topic,omitempty"`                              // the channel topic (0-1024 characters; 0-4096 for GuildForum and GuildMedia)
+	Bitrate                       *int64            `json:"bitrate,omitempty"`                            // the bitrate (in bits) of the voice or stage channel; min 8000
+	UserLimit                     *int64            `json:"user_limit,omitempty"`                         // the user limit of the voice channel (0-99) or stage channel (0-10000)
+	RateLimitPerUser              *int64            `json:"rate_limit_per_user,omitempty"`                // amount of seconds a user has to wait before sending another Message (0-21600); bots, as well as users with the permission ManageMessages or ManageChannels, are unaffected
+	Position                      *int              `json:"position,omitempty"`                           // sorting position of the channel
+	PermissionOverwrites          []*Overwrite      `json:"permission_overwrites,omitempty"`              // explicit permission overwrites for members and roles
+	ParentID                      *Snowflake        `json:"parent_id,omitempty"`                          // id of the parent category for a channel (each parent category can contain up to 50 channels)
+	Nsfw                          *bool             `json:"nsfw,omitempty"`                               // whether the channel is nsfw
+	RtcRegion                     *string           `json:"rtc_region,omitempty"`                         // channel voice region id of the voice or stage channel, automatic when set to null
+	VideoQualityMode              *VideoQualityMode `json:"video_quality_mode,omitempty"`                 // the camera video quality mode of the voice channel
+	DefaultAutoArchiveDuration    *int              `json:"default_auto_archive_duration,omitempty"`      // default duration that the clients (not the API) will use for newly created threads, in minutes, to automatically archive the thread after recent activity, can be set to: 60, 1440, 4320, 10080
+	DefaultReactionEmoji          *DefaultReaction  `json:"default_reaction_emoji,omitempty"`             // Emoji to show in the add Reaction button on a thread in a GuildForum or GuildMedia channel
+	AvailableTags                 []*ForumTag       `json:"available_tags,omitempty"`                     // set of tags that can be used in a GuildForum or GuildMedia channel; limited to 20
+	DefaultSortOrder              *SortOrderType    `json:"default_sort_order,omitempty"`                 // the default SortOrderType used to order posts in GuildForum and GuildMedia channels
+	DefaultForumLayout            *ForumLayoutType  `json:"default_forum_layout,omitempty"`               // the default ForumLayoutType used to display posts in GuildForum channels
+	DefaultThreadRateLimitPerUser *int64            `json:"default_thread_rate_limit_per_user,omitempty"` // the initial RateLimitPerUser to set on newly created threads in a channel
+}
+
+// Validate - Checks the payload against the limits Discord enforces, and that every field set applies to the ChannelType being created
+func (p *CreateGuildChannelJSON) Validate() error {
+	if p == nil {
+		return errors.New("payload is required")
+	}
+
+	if n := len([]rune(p.Name)); n < 1 || n > 100 {
+		return errors.New("name must be between 1 and 100 characters")
+	}
+
+	channelType := GuildText
+	if p.Type != nil {
+		channelType = *p.Type
+	}
+
+	var (
+		isVoice    = channelType == GuildVoice || channelType == GuildStageVoice
+		isText     = channelType == GuildText || channelType == GuildAnnouncement
+		isForum    = channelType == GuildForum || channelType == GuildMedia
+		isCategory = channelType == GuildCategory
+	)
+
+	if !isVoice && !isText && !isForum && !isCategory {
+		return fmt.Errorf("channel type %d cannot be created in a guild", channelType)
+	}
+
+	if p.Topic != nil {
+		if !isText && !isForum {
+			return errors.New("topic can only be set on text, announcement, forum and media channels")
+		}
+		topicLimit := 1024
+		if isForum {
+			topicLimit = 4096
+		}
+		if len([]rune(*p.Topic)) > topicLimit {
+			return fmt.Errorf("topic cannot be longer than %d characters", topicLimit)
+		}
+	}
+
+	if (p.Bitrate != nil || p.UserLimit != nil || p.RtcRegion != nil || p.VideoQualityMode != nil) && !isVoice {
+		return errors.New("bitrate, user_limit, rtc_region and video_quality_mode can only be set on voice and stage channels")
+	}
+	if p.Bitrate != nil && *p.Bitrate < 8000 {
+		return errors.New("bitrate must be at least 8000")
+	}
+	if p.UserLimit != nil {
+		userLimit := int64(99)
+		if channelType == GuildStageVoice {
+			userLimit = 10000
+		}
+		if *p.UserLimit < 0 || *p.UserLimit > userLimit {
+			return fmt.Errorf("user_limit must be between 0 and %d", userLimit)
+		}
+	}
+
+	if p.RateLimitPerUser != nil {
+		if isCategory || channelType == GuildAnnouncement {
+			return errors.New("rate_limit_per_user cannot be set on category and announcement channels")
+		}
+		if *p.RateLimitPerUser < 0 || *p.RateLimitPerUser > 21600 {
+			return errors.New("rate_limit_per_user must be between 0 and 21600")
+		}
+	}
+
+	if isCategory && (p.ParentID != nil || p.Nsfw != nil) {
+		return errors.New("categories cannot have a parent_id or be marked nsfw")
+	}
+
+	if p.DefaultAutoArchiveDuration != nil {
+		if !isText && !isForum {
+			return errors.New("default_auto_archive_duration can only be set on text, announcement, forum and media channels")
+		}
+		if !IsValidAutoArchiveDuration(*p.DefaultAutoArchiveDuration) {
+			return errors.New("default_auto_archive_duration must be one of 60, 1440, 4320, 10080")
+		}
+	}
+
+	if p.DefaultThreadRateLimitPerUser != nil && !isText && !isForum {
+		return errors.New("default_thread_rate_limit_per_user can only be set on text, announcement, forum and media channels")
+	}
+	if (p.DefaultReactionEmoji != nil || p.AvailableTags != nil || p.DefaultSortOrder != nil) && !isForum {
+		return errors.New("default_reaction_emoji, available_tags and default_sort_order can only be set on forum and media channels")
+	}
+	if len(p.AvailableTags) > 20 {
+		return errors.New("a channel can have at most 20 available tags")
+	}
+	if p.DefaultForumLayout != nil && channelType != GuildForum {
+		return errors.New("default_forum_layout can only be set on forum channels")
+	}
+
+	return nil
 }
 
 // ModifyGuildChannelPositions - Modify the positions of a set of channel objects for the guild.
@@ -251,7 +371,7 @@ type CreateGuildChannelJSON struct {
 //	Only channels to be modified are required.
 //
 //	This endpoint supports the X-Audit-Log-Reason header.
-func (g *Guild) ModifyGuildChannelPositions(payload *ModifyGuildChannelPositionsJSON, reason *string) error {
+func (g *Guild) ModifyGuildChannelPositions(payload []*ModifyGuildChannelPositionsJSON, reason *string) error {
 	u := parseRoute(fmt.Sprintf(modifyGuildChannelPositions, api, g.ID.String()))
 	_, err := firePatchRequest(u, payload, reason)
 	if err != nil {
@@ -264,10 +384,10 @@ func (g *Guild) ModifyGuildChannelPositions(payload *ModifyGuildChannelPositions
 
 // ModifyGuildChannelPositionsJSON - JSON payload
 type ModifyGuildChannelPositionsJSON struct {
-	ID              Snowflake  `json:"id"`               // channel id
-	Position        *uint64    `json:"position"`         // sorting position of the channel
-	LockPermissions *bool      `json:"lock_permissions"` // syncs the permission overwrites with the new parent, if moving to a new category
-	ParentID        *Snowflake `json:"parent_id"`        // the new parent ID for the channel that is moved
+	ID              Snowflake            `json:"id"`                         // channel id
+	Position        *uint64              `json:"position,omitempty"`         // sorting position of the channel
+	LockPermissions *bool                `json:"lock_permissions,omitempty"` // syncs the permission overwrites with the new parent, if moving to a new category
+	ParentID        *Nullable[Snowflake] `json:"parent_id,omitempty"`        // the new parent ID for the channel that is moved; Null moves it out of its category
 }
 
 // ListActiveThreads - Returns all active threads in the guild, including public and private threads. Threads are ordered by their id, in descending order.
@@ -281,7 +401,7 @@ func (g *Guild) ListActiveThreads() (*ThreadListResponse, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &threadListResponse)
+	err = decodeResponse(u, responseBytes, &threadListResponse)
 
 	return threadListResponse, err
 }
@@ -297,7 +417,8 @@ func (g *Guild) GetGuildMember(userID *Snowflake) (*GuildMember, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMember)
+	err = decodeResponse(u, responseBytes, &guildMember)
+	g.setMemberGuild(guildMember)
 
 	return guildMember, err
 }
@@ -326,7 +447,8 @@ func (g *Guild) ListGuildMembers(limit *uint64, after *Snowflake) ([]*GuildMembe
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMembers)
+	err = decodeResponse(u, responseBytes, &guildMembers)
+	g.setMemberGuild(guildMembers...)
 
 	return guildMembers, err
 }
@@ -351,7 +473,8 @@ func (g *Guild) SearchGuildMembers(query string, limit *uint64) ([]*GuildMember,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMembers)
+	err = decodeResponse(u, responseBytes, &guildMembers)
+	g.setMemberGuild(guildMembers...)
 
 	return guildMembers, err
 }
@@ -385,7 +508,8 @@ func (g *Guild) AddGuildMember(userID *Snowflake, payload *AddGuildMemberJSON) (
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMember)
+	err = decodeResponse(u, responseBytes, &guildMember)
+	g.setMemberGuild(guildMember)
 
 	return guildMember, err
 }
@@ -412,6 +536,12 @@ func (g *Guild) ModifyGuildMember(userID *Snowflake, payload *ModifyGuildMemberJ
 	*GuildMember,
 	error,
 ) {
+	if payload != nil {
+		if err := payload.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	u := parseRoute(fmt.Sprintf(modifyGuildMember, api, g.ID.String(), userID.String()))
 
 	var guildMember *GuildMember
@@ -421,20 +551,69 @@ func (g *Guild) ModifyGuildMember(userID *Snowflake, payload *ModifyGuildMemberJ
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMember)
+	err = decodeResponse(u, responseBytes, &guildMember)
+	g.setMemberGuild(guildMember)
 
 	return guildMember, err
 }
 
 // ModifyGuildMemberJSON - JSON payload
 type ModifyGuildMemberJSON struct {
-	Nick                       *string         `json:"nick,omitempty"`                         // value to set user's nickname to
-	Roles                      []*Snowflake    `json:"roles,omitempty"`                        // array of role ids the member is assigned
-	Mute                       *bool           `json:"mute,omitempty"`                         // whether the user is muted in voice channels. Will throw a 400 error if the user is not in a voice channel
-	Deaf                       *bool           `json:"deaf,omitempty"`                         // whether the user is deafened in voice channels. Will throw a 400 error if the user is not in a voice channel
-	ChannelID                  *Snowflake      `json:"channel_id,omitempty"`                   // id of channel to move user to (if they are connected to voice)
-	CommunicationDisabledUntil *time.Time      `json:"communication_disabled_until,omitempty"` // when the user's timeout will expire and the User will be able to communicate in the guild again (up to 28 days in the future), set to null to remove timeout. Will throw a 403 error if the user has the Administrator permission or is the owner of the guild
-	Flags                      GuildMemberFlag `json:"flags"`                                  // guild member flags
+	Nick                       *Nullable[string]    `json:"nick,omitempty"`                         // value to set user's nickname to; Null removes the nickname
+	Roles                      []*Snowflake         `json:"roles,omitempty"`                        // array of role ids the member is assigned
+	Mute                       *bool                `json:"mute,omitempty"`                         // whether the user is muted in voice channels. Will throw a 400 error if the user is not in a voice channel
+	Deaf                       *bool                `json:"deaf,omitempty"`                         // whether the user is deafened in voice channels. Will throw a 400 error if the user is not in a voice channel
+	ChannelID                  *Nullable[Snowflake] `json:"channel_id,omitempty"`                   // id of channel to move user to (if they are connected to voice); Null disconnects them
+	CommunicationDisabledUntil *Nullable[time.Time] `json:"communication_disabled_until,omitempty"` // when the user's timeout will expire and the User will be able to communicate in the guild again (up to 28 days in the future); Null removes a timeout. Will throw a 403 error if the user has the Administrator permission or is the owner of the guild
+	Flags                      *GuildMemberFlag     `json:"flags,omitempty"`                        // guild member flags; only BypassesVerification can be changed
+}
+
+// maxTimeout - Discord rejects timeouts more than 28 days in the future
+const maxTimeout = 28 * 24 * time.Hour
+
+// TimeoutUntil - Times the member out until t, or removes their timeout when t is the zero time.
+//
+// Requires the ModerateMembers permission.
+func (p *ModifyGuildMemberJSON) TimeoutUntil(t time.Time) *ModifyGuildMemberJSON {
+	if t.IsZero() {
+		p.CommunicationDisabledUntil = Null[time.Time]()
+
+		return p
+	}
+
+	p.CommunicationDisabledUntil = NewNullable(t)
+
+	return p
+}
+
+// SetFlags - Sets the member's flags; build them from the member's current Flags, as every bit is sent.
+func (p *ModifyGuildMemberJSON) SetFlags(flags GuildMemberFlag) *ModifyGuildMemberJSON {
+	p.Flags = &flags
+
+	return p
+}
+
+// Validate - Checks the timeout is no more than 28 days in the future
+func (p *ModifyGuildMemberJSON) Validate() error {
+	if until, ok := p.CommunicationDisabledUntil.Get(); ok && time.Until(until) > maxTimeout {
+		return errors.New("timeouts cannot be more than 28 days in the future")
+	}
+
+	return nil
+}
+
+// SetMemberBypassesVerification - Lets a member skip, or stop skipping, the guild's verification requirements.
+//
+// Requires the ModerateMembers permission; the member's other flags are preserved.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (g *Guild) SetMemberBypassesVerification(member *GuildMember, bypass bool, reason *string) (*GuildMember, error) {
+	flags := member.Flags &^ BypassesVerification
+	if bypass {
+		flags |= BypassesVerification
+	}
+
+	return g.ModifyGuildMember(&member.User.ID, (&ModifyGuildMemberJSON{}).SetFlags(flags), reason)
 }
 
 // ModifyCurrentMember - Modifies the current member in a guild. Returns a 200 with the updated member object on success. Fires a Guild Member Update Gateway event.
@@ -444,7 +623,7 @@ func (g *Guild) ModifyCurrentMember(nick *string, reason *string) (*GuildMember,
 	u := parseRoute(fmt.Sprintf(modifyCurrentMember, api, g.ID.String()))
 
 	payload := struct {
-		Nick *string
+		Nick *string `json:"nick"`
 	}{
 		nick,
 	}
@@ -456,7 +635,8 @@ func (g *Guild) ModifyCurrentMember(nick *string, reason *string) (*GuildMember,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMember)
+	err = decodeResponse(u, responseBytes, &guildMember)
+	g.setMemberGuild(guildMember)
 
 	return guildMember, err
 }
@@ -537,7 +717,7 @@ func (g *Guild) GetGuildBans(limit *uint64, before *Snowflake, after *Snowflake)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &bans)
+	err = decodeResponse(u, responseBytes, &bans)
 
 	return bans, err
 }
@@ -553,7 +733,7 @@ func (g *Guild) GetGuildBan(userID Snowflake) (*Ban, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &ban)
+	err = decodeResponse(u, responseBytes, &ban)
 
 	return ban, err
 }
@@ -599,35 +779,48 @@ func (g *Guild) GetGuildRoles() ([]*Role, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &roles)
+	err = decodeResponse(u, responseBytes, &roles)
 
 	return roles, err
 }
 
-func (g *Guild) CreateGuildRole(payload *CreateGuildRoleJSON, reason *string) ([]*Role, error) {
+// CreateGuildRole - Create a new Role for the guild.
+//
+// Requires the ManageRoles permission.
+//
+// Returns the new role object on success.
+//
+// Fires a GuildRoleCreate Gateway event.
+//
+//	All JSON params are optional.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (g *Guild) CreateGuildRole(payload *CreateGuildRoleJSON, reason *string) (*Role, error) {
 	u := parseRoute(fmt.Sprintf(createGuildRole, api, g.ID.String()))
 
-	var roles []*Role
+	var role *Role
 	responseBytes, err := firePostRequest(u, payload, reason)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &roles)
+	err = decodeResponse(u, responseBytes, &role)
 
-	return roles, err
+	return role, err
 }
 
 // CreateGuildRoleJSON - JSON payload
+//
+// Icon and UnicodeEmoji require the guild to have the RoleIcons feature.
 type CreateGuildRoleJSON struct {
-	Name         string  `json:"name"`
-	Permissions  string  `json:"permissions"`
-	Color        uint64  `json:"color"`
-	Hoist        bool    `json:"hoist"`
-	Icon         *string `json:"icon"`
-	UnicodeEmoji *string `json:"unicode_emoji"`
-	Mentionable  bool    `json:"mentionable"`
+	Name         string  `json:"name"`                    // name of the role, max 100 characters
+	Permissions  string  `json:"permissions"`             // bitwise value of the enabled/disabled permissions
+	Color        uint64  `json:"color"`                   // RGB color value
+	Hoist        bool    `json:"hoist"`                   // whether the role should be displayed separately in the sidebar
+	Icon         *string `json:"icon,omitempty"`          // the role's icon image as a data URI; see ImageDataURI
+	UnicodeEmoji *string `json:"unicode_emoji,omitempty"` // the role's unicode emoji as a standard emoji
+	Mentionable  bool    `json:"mentionable"`             // whether the role should be mentionable
 }
 
 // ModifyGuildRolePositions - Modify the positions of a set of role objects for the guild.
@@ -649,7 +842,7 @@ func (g *Guild) ModifyGuildRolePositions(payload []*ModifyGuildRolePositionsJSON
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &roles)
+	err = decodeResponse(u, responseBytes, &roles)
 
 	return roles, err
 }
@@ -682,20 +875,22 @@ func (g *Guild) ModifyGuildRole(roleID *Snowflake, payload *ModifyGuildRoleJSON,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &roles)
+	err = decodeResponse(u, responseBytes, &roles)
 
 	return roles, err
 }
 
 // ModifyGuildRoleJSON - JSON payload
+//
+// Icon and UnicodeEmoji require the guild to have the RoleIcons feature.
 type ModifyGuildRoleJSON struct {
-	Name         *string `json:"name,omitempty"`
-	Permissions  *string `json:"permissions,omitempty"`
-	Color        *uint64 `json:"color,omitempty"`
-	Hoist        *bool   `json:"hoist,omitempty"`
-	Icon         *string `json:"icon,omitempty"`
-	UnicodeEmoji *string `json:"unicode_emoji,omitempty"`
-	Mentionable  *bool   `json:"mentionable,omitempty"`
+	Name         *string           `json:"name,omitempty"`          // name of the role, max 100 characters
+	Permissions  *string           `json:"permissions,omitempty"`   // bitwise value of the enabled/disabled permissions
+	Color        *uint64           `json:"color,omitempty"`         // RGB color value
+	Hoist        *bool             `json:"hoist,omitempty"`         // whether the role should be displayed separately in the sidebar
+	Icon         *Nullable[string] `json:"icon,omitempty"`          // the role's icon image as a data URI; see ImageDataURI. Null removes it
+	UnicodeEmoji *Nullable[string] `json:"unicode_emoji,omitempty"` // the role's unicode emoji as a standard emoji; Null removes it
+	Mentionable  *bool             `json:"mentionable,omitempty"`   // whether the role should be mentionable
 }
 
 // ModifyGuildMfaLevel - Modify a guild's MFA level.
@@ -706,6 +901,10 @@ type ModifyGuildRoleJSON struct {
 //
 // Fires a GuildUpdate Gateway event.
 func (g *Guild) ModifyGuildMfaLevel(level MfaLevel, reason *string) (*MfaLevel, error) {
+	if !level.IsValid() {
+		return nil, fmt.Errorf("%v is not a valid MFA level", level)
+	}
+
 	u := parseRoute(fmt.Sprintf(modifyGuildMfaLevel, api, g.ID.String()))
 
 	payload := struct {
@@ -721,7 +920,7 @@ func (g *Guild) ModifyGuildMfaLevel(level MfaLevel, reason *string) (*MfaLevel,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &mfaLevel)
+	err = decodeResponse(u, responseBytes, &mfaLevel)
 
 	return mfaLevel, err
 }
@@ -773,7 +972,7 @@ func (g *Guild) GetGuildPruneCount(days uint, includeRoles *string) (*GetGuildPr
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &pruneCountResponse)
+	err = decodeResponse(u, responseBytes, &pruneCountResponse)
 
 	return pruneCountResponse, err
 }
@@ -813,7 +1012,7 @@ func (g *Guild) BeginGuildPrune(payload *BeginGuildPruneJSON, reason *string) (*
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &response)
+	err = decodeResponse(u, responseBytes, &response)
 
 	return response, err
 }
@@ -838,7 +1037,7 @@ func (g *Guild) GetGuildVoiceRegions() ([]*VoiceRegion, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &voiceRegions)
+	err = decodeResponse(u, responseBytes, &voiceRegions)
 
 	return voiceRegions, err
 }
@@ -856,7 +1055,7 @@ func (g *Guild) GetGuildInvites() ([]*Invite, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &invites)
+	err = decodeResponse(u, responseBytes, &invites)
 
 	return invites, err
 }
@@ -874,7 +1073,7 @@ func (g *Guild) GetGuildIntegrations() ([]*Integration, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &integrations)
+	err = decodeResponse(u, responseBytes, &integrations)
 
 	return integrations, err
 }
@@ -909,7 +1108,7 @@ func (g *Guild) GetGuildWidgetSettings() (*GuildWidgetSettings, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildWidgetSettings)
+	err = decodeResponse(u, responseBytes, &guildWidgetSettings)
 
 	return guildWidgetSettings, err
 }
@@ -933,23 +1132,32 @@ func (g *Guild) ModifyGuildWidget(payload *GuildWidgetSettings, reason *string)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildWidgetSettings)
+	err = decodeResponse(u, responseBytes, &guildWidgetSettings)
 
 	return guildWidgetSettings, err
 }
 
 // GetGuildWidget - Returns the widget for the guild.
-func (g *Guild) GetGuildWidget() (*GetGuildWidget, error) {
-	u := parseRoute(fmt.Sprintf(getGuildWidget, api, g.ID.String()))
+func (g *Guild) GetGuildWidget() (*GuildWidget, error) {
+	return GetGuildWidgetJSON(g.ID)
+}
 
-	var guildWidget *GetGuildWidget
+// GetGuildWidgetJSON - Returns the public widget.json data for a guild with its widget enabled.
+//
+// Only the guild ID is required, which makes this suitable for status pages that never load the full Guild.
+//
+//goland:noinspection GoUnusedExportedFunction
+func GetGuildWidgetJSON(guildID Snowflake) (*GuildWidget, error) {
+	u := parseRoute(fmt.Sprintf(getGuildWidget, api, guildID.String()))
+
+	var guildWidget *GuildWidget
 	responseBytes, err := fireGetRequest(u, nil, nil)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildWidget)
+	err = decodeResponse(u, responseBytes, &guildWidget)
 
 	return guildWidget, err
 }
@@ -969,7 +1177,7 @@ func (g *Guild) GetGuildVanityURL() (*Invite, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &invite)
+	err = decodeResponse(u, responseBytes, &invite)
 
 	return invite, err
 }
@@ -987,7 +1195,7 @@ func (g *Guild) GetGuildWelcomeScreen() (*WelcomeScreen, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &welcomeScreen)
+	err = decodeResponse(u, responseBytes, &welcomeScreen)
 
 	return welcomeScreen, err
 }
@@ -1010,7 +1218,7 @@ func (g *Guild) ModifyGuildWelcomeScreen(payload *ModifyGuildWelcomeScreenJSON,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &welcomeScreen)
+	err = decodeResponse(u, responseBytes, &welcomeScreen)
 
 	return welcomeScreen, err
 }
@@ -1032,7 +1240,7 @@ func (g *Guild) GetGuildOnboarding() (*GuildOnboarding, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &onboarding)
+	err = decodeResponse(u, responseBytes, &onboarding)
 
 	return onboarding, err
 }
@@ -1096,8 +1304,21 @@ func (g *Guild) getSelfMember() (*GuildMember, error) {
 	return g.GetGuildMember(&ApplicationID)
 }
 
-func (g *Guild) GetGuildWidgetImage() ([]byte, error) {
-	u := parseRoute(fmt.Sprintf("%s/guilds/%s/widget.png", api, g.ID.String()))
+// GetGuildWidgetImage - Returns a PNG image widget for the guild.
+//
+// Requires no permissions or authentication.
+//
+// SUPPORTS: "style : WidgetStyle" ; nil defaults to WidgetStyleShield
+func (g *Guild) GetGuildWidgetImage(style *WidgetStyle) ([]byte, error) {
+	u := parseRoute(fmt.Sprintf(getGuildWidgetImage, api, g.ID.String()))
+
+	q := u.Query()
+	if style != nil {
+		q.Set("style", string(*style))
+	}
+	if len(q) > 0 {
+		u.RawQuery = q.Encode()
+	}
 
 	b, err := fireGetRequest(u, nil, nil)
 	if err != nil {
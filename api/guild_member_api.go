@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrUnknownMemberGuild - returned by GuildMember methods when the member's GuildID or User is not known
+//
+// Members in interactions and message events carry no guild_id; use Interaction.GuildMember or set GuildID yourself.
+var ErrUnknownMemberGuild = errors.New("guild member has no guild or user id")
+
+// setMemberGuild - records the guild on members fetched from it, as Discord leaves guild_id out of REST member objects
+func (g *Guild) setMemberGuild(members ...*GuildMember) {
+	for _, member := range members {
+		if member != nil {
+			member.GuildID = g.ID
+		}
+	}
+}
+
+// guild - Returns the guild the member belongs to, for calling Guild endpoints
+func (m *GuildMember) guild() (*Guild, error) {
+	if m.GuildID == "" || m.User.ID == "" {
+		return nil, ErrUnknownMemberGuild
+	}
+
+	return &Guild{ID: m.GuildID}, nil
+}
+
+// Ban - Bans the member, deleting the messages they sent in the last deleteMessageSeconds (up to 604800, 7 days). Requires the BanMembers permission.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) Ban(deleteMessageSeconds uint64, reason *string) error {
+	guild, err := m.guild()
+	if err != nil {
+		return err
+	}
+
+	return guild.CreateGuildBan(&m.User.ID, &deleteMessageSeconds, reason)
+}
+
+// Kick - Removes the member from the guild; they can rejoin with an invite. Requires the KickMembers permission.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) Kick(reason *string) error {
+	guild, err := m.guild()
+	if err != nil {
+		return err
+	}
+
+	return guild.RemoveGuildMember(&m.User, reason)
+}
+
+// Timeout - Times the member out until the given time, at most 28 days away. Requires the ModerateMembers permission.
+//
+// Returns the updated member.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) Timeout(until time.Time, reason *string) (*GuildMember, error) {
+	if until.IsZero() {
+		return nil, errors.New("a timeout needs an end time; use RemoveTimeout to remove one")
+	}
+
+	return m.modify((&ModifyGuildMemberJSON{}).TimeoutUntil(until), reason)
+}
+
+// RemoveTimeout - Ends the member's timeout early. Requires the ModerateMembers permission.
+//
+// Returns the updated member.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) RemoveTimeout(reason *string) (*GuildMember, error) {
+	return m.modify((&ModifyGuildMemberJSON{}).TimeoutUntil(time.Time{}), reason)
+}
+
+func (m *GuildMember) modify(payload *ModifyGuildMemberJSON, reason *string) (*GuildMember, error) {
+	guild, err := m.guild()
+	if err != nil {
+		return nil, err
+	}
+
+	return guild.ModifyGuildMember(&m.User.ID, payload, reason)
+}
+
+// AddRole - Gives the member a role, and adds it to Roles. Requires the ManageRoles permission.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) AddRole(roleID Snowflake, reason *string) error {
+	guild, err := m.guild()
+	if err != nil {
+		return err
+	}
+
+	if err = guild.AddGuildMemberRole(&m.User, &roleID, reason); err != nil {
+		return err
+	}
+
+	if !m.HasRole(roleID) {
+		m.Roles = append(m.Roles, &roleID)
+	}
+
+	return nil
+}
+
+// RemoveRole - Takes a role from the member, and removes it from Roles. Requires the ManageRoles permission.
+//
+//	This endpoint supports the X-Audit-Log-Reason header.
+func (m *GuildMember) RemoveRole(roleID Snowflake, reason *string) error {
+	guild, err := m.guild()
+	if err != nil {
+		return err
+	}
+
+	if err = guild.RemoveGuildMemberRole(&m.User, &roleID, reason); err != nil {
+		return err
+	}
+
+	roles := m.Roles[:0]
+	for _, role := range m.Roles {
+		if role != nil && *role != roleID {
+			roles = append(roles, role)
+		}
+	}
+	m.Roles = roles
+
+	return nil
+}
+
+// HasRole - Checks whether the member has the role
+func (m *GuildMember) HasRole(roleID Snowflake) bool {
+	for _, role := range m.Roles {
+		if role != nil && *role == roleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RoleChangeResult - the outcome of a bulk role change for one member
+type RoleChangeResult struct {
+	MemberID Snowflake // the member whose roles were changed
+	Err      error     // nil when the change succeeded; the context's error when it was never attempted
+}
+
+// roleChunkSize - the most role changes sent at once; a chunk is also kept within the requests Discord reported left in the shared bucket
+const roleChunkSize = 5
+
+// AddRoleToMembers - Adds the role to the members in chunks and reports the outcome for every member, in order
+//
+// Discord shares one rate limit bucket between all members of the guild, so each chunk is sized by the requests that bucket has left,
+// and the next chunk waits for it to reset once it runs out. Members left when the context is cancelled are reported with its error.
+// Duplicate ids are only changed once.
+func (g *Guild) AddRoleToMembers(ctx context.Context, roleID Snowflake, memberIDs []Snowflake, reason *string) []RoleChangeResult {
+	return g.changeMemberRoles(ctx, addGuildMemberRole, roleID, memberIDs, func(memberID Snowflake) error {
+		return g.AddGuildMemberRole(&User{ID: memberID}, &roleID, reason)
+	})
+}
+
+// RemoveRoleFromMembers - Removes the role from the members in chunks and reports the outcome for every member, in order
+//
+// Chunking, pacing and cancellation work as in AddRoleToMembers.
+func (g *Guild) RemoveRoleFromMembers(ctx context.Context, roleID Snowflake, memberIDs []Snowflake, reason *string) []RoleChangeResult {
+	return g.changeMemberRoles(ctx, removeGuildMemberRole, roleID, memberIDs, func(memberID Snowflake) error {
+		return g.RemoveGuildMemberRole(&User{ID: memberID}, &roleID, reason)
+	})
+}
+
+func (g *Guild) changeMemberRoles(ctx context.Context, route string, roleID Snowflake, memberIDs []Snowflake, change func(memberID Snowflake) error) []RoleChangeResult {
+	seen := make(map[Snowflake]struct{}, len(memberIDs))
+	results := make([]RoleChangeResult, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		if _, ok := seen[memberID]; !ok {
+			seen[memberID] = struct{}{}
+			results = append(results, RoleChangeResult{MemberID: memberID})
+		}
+	}
+
+	var last string
+	for start := 0; start < len(results); {
+		size, err := Rest.nextChunk(ctx, last, roleChunkSize)
+		if err != nil {
+			for i := start; i < len(results); i++ {
+				results[i].Err = err
+			}
+			break
+		}
+
+		chunk := results[start:min(start+size, len(results))]
+
+		var wg sync.WaitGroup
+		for i := range chunk {
+			wg.Add(1)
+			go func(result *RoleChangeResult) {
+				defer wg.Done()
+				result.Err = change(result.MemberID)
+			}(&chunk[i])
+		}
+		wg.Wait()
+
+		start += len(chunk)
+		last = fmt.Sprintf(route, api, g.ID.String(), chunk[len(chunk)-1].MemberID.String(), roleID.String())
+	}
+
+	return results
+}
+
+// nextChunk - Returns how many requests to send at once on the bucket last used by the route, waiting for it to reset when none are left
+func (r *RateLimiter) nextChunk(ctx context.Context, route string, limit int) (int, error) {
+	if err := r.waitForBucket(ctx, route); err != nil {
+		return 0, err
+	}
+
+	if state, ok := r.Bucket(route); ok && state.Remaining > 0 && state.Reset.After(time.Now()) {
+		return min(limit, state.Remaining), nil
+	}
+
+	return limit, nil
+}
+
+// waitForBucket - Waits until the bucket last used by the route has a request left, as the next member's route shares it on Discord's side
+func (r *RateLimiter) waitForBucket(ctx context.Context, route string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if route == "" {
+		return nil
+	}
+
+	state, ok := r.Bucket(route)
+	if !ok || state.Remaining > 0 || !state.Reset.After(time.Now()) {
+		return nil
+	}
+
+	timer := time.NewTimer(time.Until(state.Reset))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGuildMemberRequiresGuild(t *testing.T) {
+	member := &GuildMember{User: User{ID: "1"}}
+
+	if err := member.Kick(nil); !errors.Is(err, ErrUnknownMemberGuild) {
+		t.Errorf("Kick() error = %v, want %v", err, ErrUnknownMemberGuild)
+	}
+	if _, err := member.RemoveTimeout(nil); !errors.Is(err, ErrUnknownMemberGuild) {
+		t.Errorf("RemoveTimeout() error = %v, want %v", err, ErrUnknownMemberGuild)
+	}
+
+	interaction := &Interaction{GuildID: "2", Member: GuildMember{User: User{ID: "1"}}}
+	if m := interaction.GuildMember(); m == nil || m.GuildID != "2" {
+		t.Errorf("GuildMember() = %+v, want GuildID 2", m)
+	}
+}
+
+func TestAddRoleToMembersCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	results := (&Guild{ID: "1"}).AddRoleToMembers(ctx, "9", []Snowflake{"2", "3", "2"}, nil)
+
+	if len(results) != 2 || results[0].MemberID != "2" || results[1].MemberID != "3" {
+		t.Fatalf("AddRoleToMembers() = %+v, want one result each for 2 and 3", results)
+	}
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("AddRoleToMembers() %s error = %v, want %v", result.MemberID, result.Err, context.Canceled)
+		}
+	}
+}
+
+func TestAddRoleToMembersChunks(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, most int
+	stubRest(t, func(*http.Request) (int, string) {
+		mu.Lock()
+		inFlight++
+		most = max(most, inFlight)
+		mu.Unlock()
+
+		time.Sleep(10 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		return http.StatusNoContent, ""
+	})
+
+	members := []Snowflake{"2", "3", "4", "5", "6", "7", "8"}
+	results := (&Guild{ID: "1"}).AddRoleToMembers(context.Background(), "9", members, nil)
+
+	if len(results) != len(members) {
+		t.Fatalf("AddRoleToMembers() = %d results, want %d", len(results), len(members))
+	}
+	for i, result := range results {
+		if result.MemberID != members[i] || result.Err != nil {
+			t.Errorf("AddRoleToMembers() result %d = %+v, want %s without an error", i, result, members[i])
+		}
+	}
+	if most < 2 || most > roleChunkSize {
+		t.Errorf("AddRoleToMembers() sent %d requests at once, want chunks of up to %d", most, roleChunkSize)
+	}
+}
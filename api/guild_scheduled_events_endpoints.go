@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -43,7 +42,7 @@ func (g *Guild) ListGuildScheduledEvents(withUserCount *bool) ([]*GuildScheduled
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildScheduledEvents)
+	err = decodeResponse(u, responseBytes, &guildScheduledEvents)
 
 	return guildScheduledEvents, err
 }
@@ -66,7 +65,7 @@ func (g *Guild) CreateGuildScheduledEvent(payload *CreateGuildScheduledEventJSON
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildScheduledEvent)
+	err = decodeResponse(u, responseBytes, &guildScheduledEvent)
 
 	return guildScheduledEvent, err
 }
@@ -106,7 +105,7 @@ func (g *Guild) GetGuildScheduledEvent(guildScheduledEventID *Snowflake, withUse
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildScheduledEvent)
+	err = decodeResponse(u, responseBytes, &guildScheduledEvent)
 
 	return guildScheduledEvent, err
 }
@@ -138,7 +137,7 @@ func (g *Guild) ModifyGuildScheduledEvent(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildScheduledEvent)
+	err = decodeResponse(u, responseBytes, &guildScheduledEvent)
 
 	return guildScheduledEvent, err
 }
@@ -205,7 +204,7 @@ func (g *Guild) GetGuildScheduledEventUsers(
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildScheduledEvent)
+	err = decodeResponse(u, responseBytes, &guildScheduledEvent)
 
 	return guildScheduledEvent, err
 }
@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -34,7 +33,7 @@ func GetGuildTemplate(templateCode string) (*GuildTemplate, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildTemplate)
+	err = decodeResponse(u, responseBytes, &guildTemplate)
 
 	return guildTemplate, err
 }
@@ -54,7 +53,7 @@ func CreateGuildFromGuildTemplate(templateCode string, payload *CreateGuildFromG
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guild)
+	err = decodeResponse(u, responseBytes, &guild)
 
 	return guild, err
 }
@@ -76,11 +75,14 @@ func (g *Guild) GetGuildTemplates() ([]*GuildTemplate, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildTemplates)
+	err = decodeResponse(u, responseBytes, &guildTemplates)
 
 	return guildTemplates, err
 }
 
+// CreateGuildTemplate - Creates a template for the guild.
+//
+// Requires the ManageGuild permission. Returns the created GuildTemplate object on success.
 func (g *Guild) CreateGuildTemplate(payload *CreateGuildTemplateJSON) (*GuildTemplate, error) {
 	u := parseRoute(fmt.Sprintf(createGuildTemplate, api, g.ID.String()))
 
@@ -91,7 +93,7 @@ func (g *Guild) CreateGuildTemplate(payload *CreateGuildTemplateJSON) (*GuildTem
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildTemplate)
+	err = decodeResponse(u, responseBytes, &guildTemplate)
 
 	return guildTemplate, err
 }
@@ -115,7 +117,7 @@ func (g *Guild) SyncGuildTemplate(templateCode string) (*GuildTemplate, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildTemplate)
+	err = decodeResponse(u, responseBytes, &guildTemplate)
 
 	return guildTemplate, err
 }
@@ -135,11 +137,12 @@ func (g *Guild) ModifyGuildTemplate(templateCode string, payload *ModifyGuildTem
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildTemplate)
+	err = decodeResponse(u, responseBytes, &guildTemplate)
 
 	return guildTemplate, err
 }
 
+// ModifyGuildTemplateJSON - JSON payload; all fields are optional
 type ModifyGuildTemplateJSON struct {
 	CreateGuildTemplateJSON
 }
@@ -147,9 +150,17 @@ type ModifyGuildTemplateJSON struct {
 // DeleteGuildTemplate - Deletes the template.
 //
 // Requires the ManageGuild permission. Returns the deleted GuildTemplate object on success.
-// TODO: This DELETE endpoint returns a payload; update the delete request and all other methods that use it accordingly
-func (g *Guild) DeleteGuildTemplate(templateCode string) error {
+func (g *Guild) DeleteGuildTemplate(templateCode string) (*GuildTemplate, error) {
 	u := parseRoute(fmt.Sprintf(deleteGuildTemplate, api, g.ID.String(), templateCode))
 
-	return fireDeleteRequest(u, nil)
+	var guildTemplate *GuildTemplate
+	responseBytes, err := fireDeleteRequestWithResponse(u, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &guildTemplate)
+
+	return guildTemplate, err
 }
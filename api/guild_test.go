@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestGuildSlots(t *testing.T) {
+	moreStickers := MoreStickers
+	g := &Guild{
+		PremiumTier: PremiumTier1,
+		Emojis:      []*Emoji{{Animated: true}, {}, {}},
+		Stickers:    make([]*Sticker, 20),
+	}
+
+	if static, animated := g.EmojiSlots(); static != 98 || animated != 99 {
+		t.Errorf("EmojiSlots() = %d, %d, want 98, 99", static, animated)
+	}
+	if got := g.StickerSlots(); got != 0 {
+		t.Errorf("StickerSlots() = %d, want 0", got)
+	}
+	if got := g.SoundboardSlots(4); got != 20 {
+		t.Errorf("SoundboardSlots() = %d, want 20", got)
+	}
+
+	g.Features = []*GuildFeatures{&moreStickers}
+	if got := g.StickerSlots(); got != 40 {
+		t.Errorf("StickerSlots() with MORE_STICKERS = %d, want 40", got)
+	}
+}
+
+func TestGuildBoostsToNextTier(t *testing.T) {
+	tests := []struct {
+		tier  PremiumTier
+		count uint64
+		want  uint64
+	}{
+		{PremiumNone, 0, 2},
+		{PremiumNone, 1, 1},
+		{PremiumTier1, 3, 4},
+		{PremiumTier2, 20, 0},
+		{PremiumTier3, 30, 0},
+	}
+
+	for _, tt := range tests {
+		g := &Guild{PremiumTier: tt.tier, PremiumSubscriptionCount: tt.count}
+		if got := g.BoostsToNextTier(); got != tt.want {
+			t.Errorf("BoostsToNextTier() at %v with %d boosts = %d, want %d", tt.tier, tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestModifyGuildJSONValidate(t *testing.T) {
+	valid, unknown := VerificationLevelHigh, VerificationLevel(9)
+	filter := ExplicitContentFilterLevel(-1)
+
+	tests := []struct {
+		name    string
+		payload ModifyGuildJSON
+		wantErr bool
+	}{
+		{"empty", ModifyGuildJSON{}, false},
+		{"valid", ModifyGuildJSON{Name: " ok ", VerificationLevel: &valid}, false},
+		{"unknown verification level", ModifyGuildJSON{VerificationLevel: &unknown}, true},
+		{"unknown content filter", ModifyGuildJSON{ExplicitContentFilter: &filter}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.payload.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%s) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	if !NsfwAgeRestricted.IsValid() || GuildNsfwLevel(4).IsValid() || MfaLevel(2).IsValid() {
+		t.Error("IsValid() accepted an unknown level or rejected a known one")
+	}
+}
+
+func TestCreateGuildChannelJSONValidate(t *testing.T) {
+	announcement, voice, forum := GuildAnnouncement, GuildVoice, GuildForum
+	slowmode := int64(60)
+
+	tests := []struct {
+		name    string
+		payload CreateGuildChannelJSON
+		wantErr bool
+	}{
+		{"text thread slowmode", CreateGuildChannelJSON{Name: "general", DefaultThreadRateLimitPerUser: &slowmode}, false},
+		{"announcement thread slowmode", CreateGuildChannelJSON{Name: "news", Type: &announcement, DefaultThreadRateLimitPerUser: &slowmode}, false},
+		{"forum thread slowmode", CreateGuildChannelJSON{Name: "help", Type: &forum, DefaultThreadRateLimitPerUser: &slowmode}, false},
+		{"voice thread slowmode", CreateGuildChannelJSON{Name: "lounge", Type: &voice, DefaultThreadRateLimitPerUser: &slowmode}, true},
+		{"text available tags", CreateGuildChannelJSON{Name: "general", AvailableTags: []*ForumTag{{Name: "bug"}}}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.payload.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("Validate(%s) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestModifyCurrentMemberNick(t *testing.T) {
+	var sent string
+	stubRest(t, func(req *http.Request) (int, string) {
+		body, _ := io.ReadAll(req.Body)
+		sent = string(body)
+		return http.StatusOK, `{"nick":"Nelly"}`
+	})
+
+	nick := "Nelly"
+	if _, err := (&Guild{ID: "1"}).ModifyCurrentMember(&nick, nil); err != nil {
+		t.Fatalf("ModifyCurrentMember() error = %v", err)
+	}
+	if sent != `{"nick":"Nelly"}`+"\n" {
+		t.Errorf("ModifyCurrentMember() sent %q, want the nick under the lowercase key Discord reads", sent)
+	}
+}
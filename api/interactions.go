@@ -15,6 +15,7 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -44,10 +45,25 @@ type Interaction struct {
 	Version        int                    `json:"version"`                   // Read-only property, always `1`
 	Message        *Message               `json:"message,omitempty"`         // For components, the message they were attached to
 	AppPermissions string                 `json:"app_permissions,omitempty"` // Bitwise set of permissions the app or bot has within the channel the interaction was sent from
-	Locale         string                 `json:"locale,omitempty"`          // Selected language of the invoking user
-	GuildLocale    string                 `json:"guild_locale,omitempty"`    // Guild's preferred locale, if invoked in a Guild
+	Locale         Locale                 `json:"locale,omitempty"`          // Selected language of the invoking user
+	GuildLocale    Locale                 `json:"guild_locale,omitempty"`    // Guild's preferred locale, if invoked in a Guild
+
+	AttachmentSizeLimit int64 `json:"attachment_size_limit,omitempty"` // Maximum size of attachments in bytes
+
+	AuthorizingIntegrationOwners map[ApplicationIntegrationType]Snowflake `json:"authorizing_integration_owners,omitempty"` // Mapping of installation contexts that the interaction was authorized for to related user or guild IDs
+	Context                      *InteractionContextType                  `json:"context,omitempty"`                        // Context where the interaction was triggered from
 }
 
+// InteractionContextType - the surface in Discord where an interaction was triggered
+type InteractionContextType int
+
+//goland:noinspection GoUnusedConst
+const (
+	ContextGuild          InteractionContextType = iota // Interaction can be used within servers
+	ContextBotDm                                        // Interaction can be used within DMs with the app's bot user
+	ContextPrivateChannel                               // Interaction can be used within Group DMs and DMs other than the app's bot user
+)
+
 // InteractionType - The type of Interaction
 type InteractionType int
 
@@ -71,6 +87,12 @@ type ApplicationCommandData struct {
 	Options  []*ApplicationCommandInteractionDataOption `json:"options,omitempty"`   // the params + values from the user
 	GuildID  Snowflake                                  `json:"guild_id,omitempty"`  // the id of the guild the command is registered to
 	TargetID Snowflake                                  `json:"target_id,omitempty"` // id the of user or message targeted by a user or message command
+
+	// Message components and modal submits share the data field
+	CustomID      string        `json:"custom_id,omitempty"`      // the custom_id of the component or modal
+	ComponentType ComponentType `json:"component_type,omitempty"` // the type of the component
+	Values        []string      `json:"values,omitempty"`         // values the user selected in a select menu component
+	Components    []*Component  `json:"components,omitempty"`     // the values submitted by the user in a modal
 }
 
 type MessageComponentData struct {
@@ -87,13 +109,14 @@ type ModalSubmitData struct {
 // ResolvedData - Descriptive data about the Interaction
 //
 // If data for a GuildMember is included, data for its corresponding User will also be included.
+// Each field is nil when Discord sent nothing for it; a nil Collection reads as empty.
 type ResolvedData struct {
-	Users       map[Snowflake]User        `json:"users,omitempty"`       // the IDs and DiscordUser objects
-	Members     map[Snowflake]GuildMember `json:"members,omitempty"`     // the IDs and partial GuildMember objects
-	Roles       map[Snowflake]Role        `json:"roles,omitempty"`       // the IDs and GuildRole objects
-	Channels    map[Snowflake]Channel     `json:"channels,omitempty"`    // the IDs and partial GuildChannel objects
-	Messages    map[Snowflake]Message     `json:"messages,omitempty"`    // the ids and partial Message objects
-	Attachments map[Snowflake]Attachment  `json:"attachments,omitempty"` // the ids and attachment objects
+	Users       *Collection[User]        `json:"users,omitempty"`       // the IDs and DiscordUser objects
+	Members     *Collection[GuildMember] `json:"members,omitempty"`     // the IDs and partial GuildMember objects
+	Roles       *Collection[Role]        `json:"roles,omitempty"`       // the IDs and GuildRole objects
+	Channels    *Collection[Channel]     `json:"channels,omitempty"`    // the IDs and partial GuildChannel objects
+	Messages    *Collection[Message]     `json:"messages,omitempty"`    // the ids and partial Message objects
+	Attachments *Collection[Attachment]  `json:"attachments,omitempty"` // the ids and attachment objects
 }
 
 // ApplicationCommandInteractionDataOption - All options have names, and an option can either be a parameter and input value--in which case value will be set--or it can denote a subcommand or group--in which case it will contain a top-level key and another array of options.
@@ -118,6 +141,21 @@ type MessageInteraction struct {
 	Member GuildMember     `json:"member,omitempty"` // the Member who invoked the interaction in the Guild
 }
 
+// MessageInteractionMetadata - Metadata about the interaction, including the source of the interaction and relevant server and user IDs.
+//
+// This replaces MessageInteraction and is also sent for responses to Message Components and Modal submissions.
+type MessageInteractionMetadata struct {
+	ID                            Snowflake                                `json:"id"`                                        // ID of the interaction
+	Type                          InteractionType                          `json:"type"`                                      // Type of interaction
+	User                          User                                     `json:"user"`                                      // User who triggered the interaction
+	AuthorizingIntegrationOwners  map[ApplicationIntegrationType]Snowflake `json:"authorizing_integration_owners"`            // IDs for installation context(s) related to an interaction
+	OriginalResponseMessageID     *Snowflake                               `json:"original_response_message_id,omitempty"`    // ID of the original response message, present only on follow-up messages
+	TargetUser                    *User                                    `json:"target_user,omitempty"`                     // The user the command was run on, present only on user command interactions
+	TargetMessageID               *Snowflake                               `json:"target_message_id,omitempty"`               // The ID of the message the command was run on, present only on message command interactions
+	InteractedMessageID           *Snowflake                               `json:"interacted_message_id,omitempty"`           // ID of the message that contained the interactive component, present only on messages created from component interactions
+	TriggeringInteractionMetadata *MessageInteractionMetadata              `json:"triggering_interaction_metadata,omitempty"` // Metadata for the interaction that was used to open the modal, present only on modal submit interactions
+}
+
 // InteractionResponseMessages - After receiving an interaction, you must respond to acknowledge it.
 //
 // You can choose to respond with a message immediately using type 4, or you can choose to send a deferred response with type 5.
@@ -172,6 +210,8 @@ const (
 	UpdateMessage                                                       // for components, edit the message the component was attached to
 	AutocompleteResult                                                  // respond to an autocomplete interaction with suggested choices
 	Modal                                                               // respond to an interaction with a popup modal ** Not available for MODAL_SUBMIT and PING interactions.
+	PremiumRequired                                                     // Deprecated: use a ButtonPremium button instead; respond to an interaction with an upgrade button, only available for apps with monetization enabled
+	LaunchActivity                   InteractionCallbackType = iota + 4 // launch the Activity associated with the app; only available for apps with Activities enabled
 )
 
 // InteractionCallbackDataMessages - Not all message fields are currently supported by Discord
@@ -199,6 +239,26 @@ type InteractionCallbackDataModal struct {
 	Components []*Component `json:"components"` // between 1 and 5 (inclusive) components that make up the modal
 }
 
+// InvokingUser - Returns the user who triggered the Interaction, from Member in a guild and User in a DM
+func (i *Interaction) InvokingUser() *User {
+	if i.User != nil {
+		return i.User
+	}
+
+	return &i.Member.User
+}
+
+// GuildMember - Returns the invoking member with its GuildID set, so GuildMember methods such as Timeout can be called; nil in a DM
+func (i *Interaction) GuildMember() *GuildMember {
+	if i.GuildID == "" {
+		return nil
+	}
+
+	i.Member.GuildID = i.GuildID
+
+	return &i.Member
+}
+
 // BuildResponse
 // Deprecated: helper method for building a basic message response
 func (i *Interaction) BuildResponse(embeds []*Embed) *InteractionResponseMessages {
@@ -232,11 +292,11 @@ func (i *Interaction) CreateInteractionResponse(payload any) error {
 	// verify that we only accept the payload that we want
 	// maybe future language version will make this easier/cleaner
 	switch payload.(type) {
-	case **InteractionResponseMessages:
-	case **InteractionResponseAutocomplete:
-	case **InteractionResponseModal:
+	case *InteractionResponseMessages, **InteractionResponseMessages:
+	case *InteractionResponseAutocomplete, **InteractionResponseAutocomplete:
+	case *InteractionResponseModal, **InteractionResponseModal:
 	default:
-		return nil
+		return errors.New("payload must be an InteractionResponseMessages, InteractionResponseAutocomplete or InteractionResponseModal")
 	}
 
 	u := parseRoute(fmt.Sprintf(createInteractionResponse, api, i.ID.String(), i.Token))
@@ -276,27 +336,50 @@ func (i *Interaction) CreateFollowupMessage() (method string, route string) {
 
 // GetFollowupMessage - Returns a followup message for an Interaction.
 //
-// Functions the same as Get Webhook Message.
-//
-//	Does not support ephemeral followups.
-func (i *Interaction) GetFollowupMessage() (method string, route string) {
-	return http.MethodGet, fmt.Sprintf(getFollowupMessage, api, i.ApplicationID.String(), i.Token, i.Message.ID)
+// Functions the same as Get Webhook Message; ephemeral followups can be fetched too, as the interaction token is used.
+func (i *Interaction) GetFollowupMessage(messageID Snowflake) (*Message, error) {
+	u := parseRoute(fmt.Sprintf(getFollowupMessage, api, i.ApplicationID.String(), i.Token, messageID.String()))
+
+	var message *Message
+	responseBytes, err := fireGetRequest(u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &message)
+
+	return message, err
 }
 
 // EditFollowupMessage - Edits a followup message for an Interaction.
 //
-// Functions the same as Edit Webhook Message.
-//
-//	Does not support ephemeral followups.
-func (i *Interaction) EditFollowupMessage() (method string, route string) {
-	return http.MethodPatch, fmt.Sprintf(editFollowupMessage, api, i.ApplicationID, i.Token, i.Message.ID)
+// Functions the same as Edit Webhook Message; ephemeral followups can be edited too, as the interaction token is used.
+func (i *Interaction) EditFollowupMessage(messageID Snowflake, payload *EditWebhookMessageJSON) (*Message, error) {
+	u := parseRoute(fmt.Sprintf(editFollowupMessage, api, i.ApplicationID.String(), i.Token, messageID.String()))
+
+	var message *Message
+	responseBytes, err := firePatchRequest(u, payload, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &message)
+
+	return message, err
 }
 
 // DeleteFollowupMessage - Deletes a followup message for an Interaction.
 //
-// Returns 204 No Content on success.
-//
-//	Does not support ephemeral followups.
-func (i *Interaction) DeleteFollowupMessage() (method string, route string) {
-	return http.MethodDelete, fmt.Sprintf(deleteFollowupMessage, api, i.ApplicationID, i.Token, i.Message.ID)
+// Returns 204 No Content on success. Ephemeral followups can be deleted too, as the interaction token is used.
+func (i *Interaction) DeleteFollowupMessage(messageID Snowflake) error {
+	u := parseRoute(fmt.Sprintf(deleteFollowupMessage, api, i.ApplicationID.String(), i.Token, messageID.String()))
+
+	if err := fireDeleteRequest(u, nil); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,335 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// ErrBindTarget - returned by BindOptions when it is not given a non-nil pointer to a struct
+var ErrBindTarget = errors.New("options can only be bound to a non-nil pointer to a struct")
+
+// OptionBindError - describes an option that could not be stored in the field it is bound to
+type OptionBindError struct {
+	Option string                       // the name of the option
+	Type   ApplicationCommandOptionType // the type of the option as sent by Discord
+	Field  string                       // the name of the struct field
+	Err    error                        // why the value could not be stored
+}
+
+func (e *OptionBindError) Error() string {
+	return fmt.Sprintf("option %q (%s): cannot bind to field %s: %v", e.Option, e.Type, e.Field, e.Err)
+}
+
+func (e *OptionBindError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	errOptionRequired = errors.New("required option is missing")
+	errUnresolved     = errors.New("value is not in the resolved data")
+)
+
+// BindOptions - Populates the fields of a struct from the options of the invoked (sub)command
+//
+// Fields are matched by their `discord:"name"` tag; add ",required" to fail when the user left the option out.
+// Untagged fields and fields tagged "-" are left alone, as are fields of options the user did not pass.
+//
+//	var args struct {
+//		Query string    `discord:"query,required"`
+//		Count *int      `discord:"count"`
+//		User  *api.User `discord:"user"`
+//	}
+//	err := interaction.BindOptions(&args)
+//
+// STRING, INTEGER, NUMBER and BOOLEAN options bind to strings, integers, floats and bools, converting between them where lossless.
+// USER, CHANNEL, ROLE, MENTIONABLE and ATTACHMENT options bind to a Snowflake or string holding the ID,
+// or to the resolved User, GuildMember, Channel, Role or Attachment (or a pointer to one).
+// Pointer fields are left nil when the option is missing.
+func (i *Interaction) BindOptions(dst any) error {
+	return i.Data.BindOptions(dst)
+}
+
+// BindOptions - Populates the fields of a struct from the options of the invoked (sub)command; see Interaction.BindOptions
+func (d *ApplicationCommandData) BindOptions(dst any) error {
+	target := reflect.ValueOf(dst)
+	if target.Kind() != reflect.Pointer || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return ErrBindTarget
+	}
+	target = target.Elem()
+
+	// Subcommands and groups nest the options the user typed
+	options := d.Options
+	for len(options) == 1 && (options[0].Type == OptionTypeSubCommand || options[0].Type == OptionTypeSubCommandGroup) {
+		options = options[0].Options
+	}
+
+	byName := make(map[string]*ApplicationCommandInteractionDataOption, len(options))
+	for _, option := range options {
+		byName[option.Name] = option
+	}
+
+	fields := target.Type()
+	for n := 0; n < fields.NumField(); n++ {
+		field := fields.Field(n)
+		tag, ok := field.Tag.Lookup("discord")
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		name, flags, _ := strings.Cut(tag, ",")
+		option, ok := byName[name]
+		if !ok || option.Value == nil {
+			if flags == "required" {
+				return &OptionBindError{Option: name, Field: field.Name, Err: errOptionRequired}
+			}
+			continue
+		}
+
+		if err := d.bindOption(option, target.Field(n)); err != nil {
+			return &OptionBindError{Option: name, Type: option.Type, Field: field.Name, Err: err}
+		}
+	}
+
+	return nil
+}
+
+var (
+	snowflakeType   = reflect.TypeOf(Snowflake(""))
+	userType        = reflect.TypeOf(User{})
+	guildMemberType = reflect.TypeOf(GuildMember{})
+	channelType     = reflect.TypeOf(Channel{})
+	roleType        = reflect.TypeOf(Role{})
+	attachmentType  = reflect.TypeOf(Attachment{})
+)
+
+func (d *ApplicationCommandData) bindOption(option *ApplicationCommandInteractionDataOption, field reflect.Value) error {
+	if field.Kind() == reflect.Pointer {
+		value := reflect.New(field.Type().Elem())
+		if err := d.bindOption(option, value.Elem()); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	id := Snowflake(fmt.Sprint(option.Value))
+	var resolved any
+	var found bool
+
+	switch field.Type() {
+	case snowflakeType:
+		field.SetString(id.String())
+		return nil
+	case userType:
+		resolved, found = d.Resolved.Users.Get(id)
+	case guildMemberType:
+		resolved, found = d.Resolved.Members.Get(id)
+	case channelType:
+		resolved, found = d.Resolved.Channels.Get(id)
+	case roleType:
+		resolved, found = d.Resolved.Roles.Get(id)
+	case attachmentType:
+		resolved, found = d.Resolved.Attachments.Get(id)
+	default:
+		return bindScalar(option.Value, field)
+	}
+
+	if !found {
+		return errUnresolved
+	}
+	field.Set(reflect.ValueOf(resolved))
+
+	return nil
+}
+
+// bindScalar - stores a decoded JSON value in a string, bool, integer or float field
+//
+// Autocomplete interactions send the partial value of a focused INTEGER or NUMBER option as a string, so strings are parsed.
+func bindScalar(value any, field reflect.Value) error {
+	switch field.Kind() {
+	case reflect.String:
+		if s, ok := value.(string); ok {
+			field.SetString(s)
+		} else {
+			field.SetString(fmt.Sprint(value))
+		}
+		return nil
+	case reflect.Bool:
+		b, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("cannot use %T as bool", value)
+		}
+		field.SetBool(b)
+		return nil
+	}
+
+	f, err := toFloat(value)
+	if err != nil {
+		return err
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := int64(f)
+		if float64(i) != f {
+			return fmt.Errorf("%v is not a whole number", f)
+		}
+		if field.OverflowInt(i) {
+			return fmt.Errorf("%d overflows %s", i, field.Type())
+		}
+		field.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := uint64(f)
+		if f < 0 || float64(u) != f {
+			return fmt.Errorf("%v is not a non-negative whole number", f)
+		}
+		if field.OverflowUint(u) {
+			return fmt.Errorf("%d overflows %s", u, field.Type())
+		}
+		field.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		if field.OverflowFloat(f) {
+			return fmt.Errorf("%v overflows %s", f, field.Type())
+		}
+		field.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
+
+func toFloat(value any) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a number", v)
+		}
+		return f, nil
+	}
+
+	return 0, fmt.Errorf("cannot use %T as a number", value)
+}
+
+// ResponseOption - adjusts the message sent by RespondWithFiles and EditOriginalWithFiles
+type ResponseOption func(data *InteractionCallbackDataMessages)
+
+// WithEmbeds - Adds embeds to the response; reference an uploaded image with "attachment://" + File.Name
+//
+//goland:noinspection GoUnusedExportedFunction
+func WithEmbeds(embeds ...*Embed) ResponseOption {
+	return func(data *InteractionCallbackDataMessages) {
+		data.Embeds = append(data.Embeds, embeds...)
+	}
+}
+
+// WithComponents - Adds components to the response
+//
+//goland:noinspection GoUnusedExportedFunction
+func WithComponents(components ...*Component) ResponseOption {
+	return func(data *InteractionCallbackDataMessages) {
+		data.Components = append(data.Components, components...)
+	}
+}
+
+// WithAllowedMentions - Sets the mentions the response is allowed to ping
+//
+//goland:noinspection GoUnusedExportedFunction
+func WithAllowedMentions(allowedMentions *AllowedMentions) ResponseOption {
+	return func(data *InteractionCallbackDataMessages) {
+		data.AllowedMentions = allowedMentions
+	}
+}
+
+// WithEphemeral - Makes the response visible only to the invoking user; ignored when editing
+//
+//goland:noinspection GoUnusedExportedFunction
+func WithEphemeral() ResponseOption {
+	return func(data *InteractionCallbackDataMessages) {
+		data.Flags |= Ephemeral
+	}
+}
+
+// RespondWithFiles - Responds to the interaction with a message that has the files attached
+//
+// The attachments array is built from the files, so each File.Name and File.Description lines up with its files[n] part.
+func (i *Interaction) RespondWithFiles(content string, files []*File, opts ...ResponseOption) error {
+	data := i.messageWithFiles(content, files, opts)
+	u := parseRoute(fmt.Sprintf(createInteractionResponse, api, i.ID.String(), i.Token))
+
+	_, err := firePostMultipartRequest(u, &InteractionResponseMessages{Type: ChannelMessageWithSource, Data: data}, files, i.maxUploadSize(), nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return err
+	}
+
+	return nil
+}
+
+// EditOriginalWithFiles - Replaces the content of the original response and attaches the files, e.g. after a deferred response
+//
+// Attachments already on the message are removed; the message keeps only the files given here.
+func (i *Interaction) EditOriginalWithFiles(content string, files []*File, opts ...ResponseOption) (*Message, error) {
+	data := i.messageWithFiles(content, files, opts)
+	u := parseRoute(fmt.Sprintf(editOriginalInteractionResponse, api, i.ApplicationID.String(), i.Token))
+
+	payload := EditWebhookMessageJSON{
+		Content:         &data.Content,
+		Embeds:          data.Embeds,
+		AllowedMentions: data.AllowedMentions,
+		Components:      data.Components,
+		Attachments:     data.Attachments,
+	}
+
+	var message *Message
+	responseBytes, err := firePatchMultipartRequest(u, payload, files, i.maxUploadSize(), nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &message)
+
+	return message, err
+}
+
+func (i *Interaction) messageWithFiles(content string, files []*File, opts []ResponseOption) *InteractionCallbackDataMessages {
+	data := &InteractionCallbackDataMessages{Content: content}
+	for _, opt := range opts {
+		opt(data)
+	}
+	data.Attachments = attachmentsFor(files)
+
+	return data
+}
+
+// maxUploadSize - Returns the upload limit Discord sent with the interaction, which accounts for the guild's boosts
+func (i *Interaction) maxUploadSize() int64 {
+	if i.AttachmentSizeLimit > 0 {
+		return i.AttachmentSizeLimit
+	}
+
+	return DefaultMaxUploadSize
+}
@@ -0,0 +1,233 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBindOptions(t *testing.T) {
+	data := &ApplicationCommandData{
+		Name: "tag",
+		Options: []*ApplicationCommandInteractionDataOption{{
+			Name: "search",
+			Type: OptionTypeSubCommand,
+			Options: []*ApplicationCommandInteractionDataOption{
+				{Name: "query", Type: OptionTypeString, Value: "hello"},
+				{Name: "count", Type: OptionTypeInteger, Value: float64(3)},
+				{Name: "user", Type: OptionTypeUser, Value: "80351110224678912"},
+			},
+		}},
+		Resolved: ResolvedData{
+			Users: CollectionOf(map[Snowflake]User{"80351110224678912": {ID: "80351110224678912", Username: "Nelly"}}),
+		},
+	}
+
+	var args struct {
+		Query  string    `discord:"query,required"`
+		Count  *int      `discord:"count"`
+		Limit  *int      `discord:"limit"`
+		User   *User     `discord:"user"`
+		UserID Snowflake `discord:"user"`
+	}
+	if err := data.BindOptions(&args); err != nil {
+		t.Fatalf("BindOptions() error = %v", err)
+	}
+
+	if args.Query != "hello" || args.Count == nil || *args.Count != 3 || args.Limit != nil {
+		t.Errorf("BindOptions() scalars = %q, %v, %v", args.Query, args.Count, args.Limit)
+	}
+	if args.User == nil || args.User.Username != "Nelly" || args.UserID != "80351110224678912" {
+		t.Errorf("BindOptions() user = %+v, %q", args.User, args.UserID)
+	}
+}
+
+func TestBindOptionsErrors(t *testing.T) {
+	data := &ApplicationCommandData{
+		Options: []*ApplicationCommandInteractionDataOption{
+			{Name: "count", Type: OptionTypeNumber, Value: 2.5},
+		},
+	}
+
+	tests := []struct {
+		name string
+		dst  any
+		want error
+	}{
+		{
+			name: "Not a pointer",
+			dst: struct {
+				Count int `discord:"count"`
+			}{},
+			want: ErrBindTarget,
+		},
+		{
+			name: "Missing required",
+			dst: &struct {
+				Query string `discord:"query,required"`
+			}{},
+			want: errOptionRequired,
+		},
+		{
+			name: "Fraction into int",
+			dst: &struct {
+				Count int `discord:"count"`
+			}{},
+		},
+		{
+			name: "Unresolved user",
+			dst: &struct {
+				User User `discord:"count"`
+			}{},
+			want: errUnresolved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := data.BindOptions(tt.dst)
+			if err == nil {
+				t.Fatal("BindOptions() error = nil")
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("BindOptions() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageWithFiles(t *testing.T) {
+	i := &Interaction{}
+	files := []*File{{Name: "chart.png", Description: "weekly chart"}, {Name: "data.csv"}}
+
+	data := i.messageWithFiles("report", files, []ResponseOption{WithEphemeral(), WithEmbeds(NewEmbed().SetImage("attachment://chart.png"))})
+
+	if data.Content != "report" || data.Flags&Ephemeral == 0 || len(data.Embeds) != 1 {
+		t.Errorf("messageWithFiles() = %+v, want ephemeral content with one embed", data)
+	}
+	if len(data.Attachments) != 2 {
+		t.Fatalf("messageWithFiles() attachments = %d, want 2", len(data.Attachments))
+	}
+	for n, attachment := range data.Attachments {
+		if attachment.ID.String() != strconv.Itoa(n) || attachment.Filename != files[n].Name || attachment.Description != files[n].Description {
+			t.Errorf("Attachments[%d] = %+v, want id %d matching %+v", n, attachment, n, files[n])
+		}
+	}
+
+	if got := i.maxUploadSize(); got != DefaultMaxUploadSize {
+		t.Errorf("maxUploadSize() = %d, want %d", got, DefaultMaxUploadSize)
+	}
+	i.AttachmentSizeLimit = 50 << 20
+	if got := i.maxUploadSize(); got != 50<<20 {
+		t.Errorf("maxUploadSize() = %d, want %d", got, 50<<20)
+	}
+}
+
+func TestAutoDefer(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		calls = append(calls, req.Method+" "+strings.TrimPrefix(req.URL.Path, "/api/v10")+" "+strings.TrimSpace(string(body)))
+		mu.Unlock()
+		return http.StatusOK, `{"id":"5"}`
+	})
+
+	i := &Interaction{ID: "1", ApplicationID: "2", Token: "tok", Type: InteractionTypeApplicationCommand}
+	reply := &InteractionResponseMessages{Type: ChannelMessageWithSource, Data: &InteractionCallbackDataMessages{Content: "done"}}
+
+	tests := []struct {
+		name  string
+		delay time.Duration
+		want  []string
+	}{
+		{"in time", 0, []string{`POST /interactions/1/tok/callback {"type":4,"data":{"content":"done","allowed_mentions":null}}`}},
+		{"late", 60 * time.Millisecond, []string{
+			`POST /interactions/1/tok/callback {"type":5,"data":{"allowed_mentions":null,"flags":64}}`,
+			`PATCH /webhooks/2/tok/messages/@original {"content":"done"}`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			err := i.AutoDefer(20*time.Millisecond, true, func(respond Responder) error {
+				time.Sleep(tt.delay)
+				if err := respond(reply); err != nil {
+					return err
+				}
+				return respond(reply)
+			})
+			if !errors.Is(err, ErrAlreadyResponded) {
+				t.Errorf("AutoDefer() error = %v, want ErrAlreadyResponded from the second respond", err)
+			}
+			if strings.Join(calls, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("AutoDefer() sent\n%s\nwant\n%s", strings.Join(calls, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestAutoDeferComponent(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		body, _ := io.ReadAll(req.Body)
+		mu.Lock()
+		calls = append(calls, req.Method+" "+strings.TrimPrefix(req.URL.Path, "/api/v10")+" "+strings.TrimSpace(string(body)))
+		mu.Unlock()
+		return http.StatusOK, `{"id":"5"}`
+	})
+
+	i := &Interaction{ID: "1", ApplicationID: "2", Token: "tok", Type: InteractionTypeMessageComponent}
+	data := &InteractionCallbackDataMessages{Content: "done"}
+
+	tests := []struct {
+		name     string
+		response *InteractionResponseMessages
+		wantErr  error
+		want     string
+	}{
+		{"update", &InteractionResponseMessages{Type: UpdateMessage, Data: data}, nil, `PATCH /webhooks/2/tok/messages/@original {"content":"done"}`},
+		{"reply", &InteractionResponseMessages{Type: ChannelMessageWithSource, Data: data}, nil, `POST /webhooks/2/tok {"content":"done","embeds":null,"payload_json":""}`},
+		{"modal", &InteractionResponseMessages{Type: Modal}, ErrCannotFollowDefer, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			err := i.AutoDefer(time.Millisecond, false, func(respond Responder) error {
+				time.Sleep(40 * time.Millisecond)
+				return respond(tt.response)
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AutoDefer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			want := []string{`POST /interactions/1/tok/callback {"type":6}`}
+			if tt.want != "" {
+				want = append(want, tt.want)
+			}
+			if strings.Join(calls, "\n") != strings.Join(want, "\n") {
+				t.Errorf("AutoDefer() sent\n%s\nwant\n%s", strings.Join(calls, "\n"), strings.Join(want, "\n"))
+			}
+		})
+	}
+}
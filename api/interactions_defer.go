@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// DefaultDeferAfter - how long AutoDefer waits for the handler to respond before deferring, leaving a second of the three Discord allows
+const DefaultDeferAfter = 2 * time.Second
+
+// ErrAlreadyResponded - returned by a Responder called more than once; send further messages as followups
+var ErrAlreadyResponded = errors.New("the interaction has already been responded to")
+
+// ErrCannotFollowDefer - returned by a Responder given a response type that cannot be sent once the interaction is deferred, such as Modal
+var ErrCannotFollowDefer = errors.New("the response type cannot be sent after the interaction was deferred")
+
+// Responder - sends the handler's response to an interaction, as a response or as an edit of a deferred one
+type Responder func(response *InteractionResponseMessages) error
+
+// AutoDefer - Runs the handler, deferring the response for it if it has not responded within after, DefaultDeferAfter when zero
+//
+// Commands are deferred with DeferredChannelMessageWithSource, ephemerally if ephemeral is set; components and modals with DeferredUpdateMessage,
+// which shows no loading state. Once deferred, the handler's response is sent as an edit of the original response instead,
+// except that a ChannelMessageWithSource reply to a deferred component or modal is sent as a followup so the message it was on is kept.
+// Only message responses can follow a defer; any other type is returned as ErrCannotFollowDefer.
+// Autocomplete interactions cannot be deferred and should respond directly.
+func (i *Interaction) AutoDefer(after time.Duration, ephemeral bool, handler func(respond Responder) error) error {
+	if after <= 0 {
+		after = DefaultDeferAfter
+	}
+
+	d := &autoDefer{interaction: i}
+	timer := time.AfterFunc(after, func() { d.deferResponse(ephemeral) })
+	defer timer.Stop()
+
+	err := handler(d.respond)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return errors.Join(err, d.deferErr)
+}
+
+type autoDefer struct {
+	interaction *Interaction
+
+	mu        sync.Mutex
+	responded bool
+	deferred  InteractionCallbackType // the type the interaction was deferred with, or zero
+	deferErr  error
+}
+
+func (d *autoDefer) deferResponse(ephemeral bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.responded {
+		return
+	}
+
+	response := &InteractionResponseMessages{Type: DeferredChannelMessageWithSource}
+	switch d.interaction.Type {
+	case InteractionTypeMessageComponent, InteractionTypeModalSubmit:
+		response.Type = DeferredUpdateMessage
+	default:
+		if ephemeral {
+			response.Data = &InteractionCallbackDataMessages{Flags: Ephemeral}
+		}
+	}
+
+	if err := d.interaction.CreateInteractionResponse(response); err != nil {
+		d.deferErr = fmt.Errorf("deferring the response: %w", err)
+		return
+	}
+	d.deferred = response.Type
+}
+
+func (d *autoDefer) respond(response *InteractionResponseMessages) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.responded {
+		return ErrAlreadyResponded
+	}
+	d.responded = true
+
+	switch {
+	case d.deferred == 0:
+		return d.interaction.CreateInteractionResponse(response)
+	case response.Type != ChannelMessageWithSource && response.Type != UpdateMessage:
+		return fmt.Errorf("%w: %d", ErrCannotFollowDefer, response.Type)
+	case d.deferred == DeferredUpdateMessage && response.Type == ChannelMessageWithSource:
+		return d.followup(response.Data)
+	}
+
+	payload := EditWebhookMessageJSON{}
+	if data := response.Data; data != nil {
+		payload.Content = &data.Content
+		payload.Embeds = data.Embeds
+		payload.AllowedMentions = data.AllowedMentions
+		payload.Components = data.Components
+		payload.Attachments = data.Attachments
+	}
+
+	u := parseRoute(fmt.Sprintf(editOriginalInteractionResponse, api, d.interaction.ApplicationID.String(), d.interaction.Token))
+	if _, err := firePatchRequest(u, payload, nil); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return err
+	}
+
+	return nil
+}
+
+// followup - sends the reply as a new message, leaving the message a deferred component or modal was on as it is
+func (d *autoDefer) followup(data *InteractionCallbackDataMessages) error {
+	payload := ExecuteWebhookJSON{}
+	if data != nil {
+		payload.Content = data.Content
+		payload.Tts = data.TTS
+		payload.Embeds = data.Embeds
+		payload.AllowedMentions = data.AllowedMentions
+		payload.Components = data.Components
+		payload.Attachments = data.Attachments
+		payload.Flags = data.Flags
+	}
+
+	u := parseRoute(fmt.Sprintf(createFollowupMessage, api, d.interaction.ApplicationID.String(), d.interaction.Token))
+	if _, err := firePostRequest(u, payload, nil); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return err
+	}
+
+	return nil
+}
@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -48,7 +47,7 @@ func (i *Invite) GetInvite(withCounts *bool, withExpiration *bool, guildSchedule
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &invite)
+	err = decodeResponse(u, responseBytes, &invite)
 
 	return invite, err
 }
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"sync/atomic"
+)
+
+// jsonCodec - the functions payloads are encoded and decoded with
+type jsonCodec struct {
+	marshal   func(v any) ([]byte, error)
+	unmarshal func(data []byte, v any) error
+}
+
+var codec atomic.Pointer[jsonCodec]
+
+// SetJSONCodec - Replaces encoding/json for REST bodies, responses and gateway events, e.g. with jsoniter or sonic, which decode large payloads with less CPU
+//
+// The functions must behave like json.Marshal and json.Unmarshal, including calling MarshalJSON and UnmarshalJSON methods.
+// Passing nil for both restores encoding/json, the default. DecodeLogUnknown and DecodeStrict always decode with encoding/json,
+// as they rely on its unknown field errors. Set the codec before making requests or handling events.
+//
+//goland:noinspection GoUnusedExportedFunction
+func SetJSONCodec(marshal func(v any) ([]byte, error), unmarshal func(data []byte, v any) error) {
+	if marshal == nil && unmarshal == nil {
+		codec.Store(nil)
+		return
+	}
+
+	if marshal == nil {
+		marshal = json.Marshal
+	}
+	if unmarshal == nil {
+		unmarshal = json.Unmarshal
+	}
+	codec.Store(&jsonCodec{marshal: marshal, unmarshal: unmarshal})
+}
+
+// JSONMarshal - Encodes v with the codec set by SetJSONCodec, or encoding/json
+func JSONMarshal(v any) ([]byte, error) {
+	if c := codec.Load(); c != nil {
+		return c.marshal(v)
+	}
+
+	return json.Marshal(v)
+}
+
+// JSONUnmarshal - Decodes data with the codec set by SetJSONCodec, or encoding/json, regardless of the DecodeMode
+//
+// Use Unmarshal for payloads whose unknown fields should be reported.
+func JSONUnmarshal(data []byte, v any) error {
+	if c := codec.Load(); c != nil {
+		return c.unmarshal(data, v)
+	}
+
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+// MembershipScreening - the rules and questions a new member of a guild with MemberVerificationGateEnabled must answer before they stop being pending
+//
+// Undocumented as of 10/15/2026; the structure follows what the Discord client sends and receives.
+type MembershipScreening struct {
+	Version     *Timestamp                  `json:"version,omitempty"`     // when the form was last changed; sent back with the member's answers
+	FormFields  []*MembershipScreeningField `json:"form_fields"`           // the questions on the form, in order
+	Description *string                     `json:"description,omitempty"` // the guild description shown above the form
+}
+
+// MembershipScreeningField - a question on the MembershipScreening form, and the member's answer when it is part of a GuildJoinRequest
+type MembershipScreeningField struct {
+	FieldType   MembershipScreeningFieldType `json:"field_type"`            // the type of question
+	Label       string                       `json:"label"`                 // the question, or the title of the rules for Terms
+	Description *string                      `json:"description,omitempty"` // extra text shown under the label
+	Required    bool                         `json:"required"`              // whether the member must answer before submitting
+	Values      []string                     `json:"values,omitempty"`      // the rules a member must agree to for Terms
+	Choices     []string                     `json:"choices,omitempty"`     // the answers to pick from for MultipleChoice
+	Placeholder *string                      `json:"placeholder,omitempty"` // placeholder text for TextInput and Paragraph
+	Response    any                          `json:"response,omitempty"`    // the member's answer; true for Terms, a string for text, the index of the choice for MultipleChoice
+}
+
+// MembershipScreeningFieldType - the type of question on a MembershipScreening form
+type MembershipScreeningFieldType string
+
+//goland:noinspection GoUnusedConst
+const (
+	ScreeningTerms          MembershipScreeningFieldType = "TERMS"           // the member agrees to the server rules
+	ScreeningTextInput      MembershipScreeningFieldType = "TEXT_INPUT"      // a short answer
+	ScreeningParagraph      MembershipScreeningFieldType = "PARAGRAPH"       // a long answer
+	ScreeningMultipleChoice MembershipScreeningFieldType = "MULTIPLE_CHOICE" // the member picks one of the choices
+)
+
+// GuildJoinRequest - a member's submitted MembershipScreening form, for guilds that review applications before letting members in
+//
+// Undocumented as of 10/15/2026.
+type GuildJoinRequest struct {
+	ID                Snowflake                   `json:"id"`                         // the id of the join request
+	GuildID           Snowflake                   `json:"guild_id"`                   // the guild the request is for
+	UserID            Snowflake                   `json:"user_id"`                    // the user who sent the request
+	User              *User                       `json:"user,omitempty"`             // the user who sent the request
+	CreatedAt         Timestamp                   `json:"created_at"`                 // when the request was started
+	ApplicationStatus GuildJoinRequestStatus      `json:"application_status"`         // where the request is in review
+	FormResponses     []*MembershipScreeningField `json:"form_responses,omitempty"`   // the form, with the member's answers
+	RejectionReason   *string                     `json:"rejection_reason,omitempty"` // why the request was rejected, shown to the user
+	ActionedAt        *Snowflake                  `json:"actioned_at,omitempty"`      // a snowflake for when the request was approved or rejected
+	ActionedByUser    *User                       `json:"actioned_by_user,omitempty"` // the moderator who approved or rejected the request
+	LastSeen          *Timestamp                  `json:"last_seen,omitempty"`        // when the user was last seen by the guild
+}
+
+// GuildJoinRequestStatus - where a GuildJoinRequest is in review
+type GuildJoinRequestStatus string
+
+//goland:noinspection GoUnusedConst
+const (
+	JoinRequestStarted   GuildJoinRequestStatus = "STARTED"   // the user opened the form but has not submitted it
+	JoinRequestSubmitted GuildJoinRequestStatus = "SUBMITTED" // the form is waiting for review
+	JoinRequestRejected  GuildJoinRequestStatus = "REJECTED"  // a moderator rejected the request
+	JoinRequestApproved  GuildJoinRequestStatus = "APPROVED"  // a moderator approved the request and the user is now a member
+)
+
+// IsPending - Checks whether the join request is waiting for a moderator
+func (r *GuildJoinRequest) IsPending() bool {
+	return r.ApplicationStatus == JoinRequestSubmitted
+}
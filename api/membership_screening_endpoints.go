@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// The endpoints in this file are undocumented as of 10/15/2026; Discord may change or remove them without notice.
+
+// GetGuildMembershipScreening - Returns the MembershipScreening form of a guild with MemberVerificationGateEnabled.
+func (g *Guild) GetGuildMembershipScreening() (*MembershipScreening, error) {
+	u := parseRoute(fmt.Sprintf(getGuildMemberVerification, api, g.ID.String()))
+
+	var screening *MembershipScreening
+	responseBytes, err := fireGetRequest(u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &screening)
+
+	return screening, err
+}
+
+// ModifyGuildMembershipScreening - Modify the guild's MembershipScreening form. Requires the ManageGuild permission. Returns the updated form.
+//
+//	This endpoint supports the `X-Audit-Log-Reason` header.
+func (g *Guild) ModifyGuildMembershipScreening(payload *ModifyGuildMembershipScreeningJSON, reason *string) (
+	*MembershipScreening,
+	error,
+) {
+	u := parseRoute(fmt.Sprintf(modifyGuildMemberVerification, api, g.ID.String()))
+
+	var screening *MembershipScreening
+	responseBytes, err := firePatchRequest(u, payload, reason)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &screening)
+
+	return screening, err
+}
+
+// ModifyGuildMembershipScreeningJSON - All parameters to this endpoint are optional
+type ModifyGuildMembershipScreeningJSON struct {
+	Enabled     *bool                       `json:"enabled,omitempty"`     // whether new members must complete the form
+	FormFields  []*MembershipScreeningField `json:"form_fields,omitempty"` // the questions on the form, in order
+	Description *Nullable[string]           `json:"description,omitempty"` // the guild description shown above the form; Null removes it
+}
+
+// GuildJoinRequests - a page of join requests returned by ListGuildJoinRequests
+type GuildJoinRequests struct {
+	GuildJoinRequests []*GuildJoinRequest `json:"guild_join_requests"` // the join requests, newest first
+	Total             int                 `json:"total"`               // how many join requests have the requested status
+	Limit             int                 `json:"limit"`               // the page size that was used
+}
+
+// ListGuildJoinRequests - Returns a page of the guild's join requests with the given status, newest first.
+//
+// Pass the ID of the last request as before to get the next page; limit is 1-100.
+func (g *Guild) ListGuildJoinRequests(status GuildJoinRequestStatus, before *Snowflake, limit *uint64) (
+	*GuildJoinRequests,
+	error,
+) {
+	u := parseRoute(fmt.Sprintf(listGuildJoinRequests, api, g.ID.String()))
+
+	q := u.Query()
+	q.Set("status", string(status))
+	if before != nil {
+		q.Set("before", before.String())
+	}
+	if limit != nil {
+		q.Set("limit", strconv.FormatUint(*limit, 10))
+	}
+	u.RawQuery = q.Encode()
+
+	var requests *GuildJoinRequests
+	responseBytes, err := fireGetRequest(u, nil, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &requests)
+
+	return requests, err
+}
+
+// ApproveGuildJoinRequest - Approves a submitted join request, making the user a full member. Returns the updated request.
+func (g *Guild) ApproveGuildJoinRequest(requestID Snowflake) (*GuildJoinRequest, error) {
+	return g.actionGuildJoinRequest(requestID, actionGuildJoinRequestJSON{Action: JoinRequestApproved})
+}
+
+// RejectGuildJoinRequest - Rejects a submitted join request; the reason, when given, is shown to the user. Returns the updated request.
+func (g *Guild) RejectGuildJoinRequest(requestID Snowflake, rejectionReason *string) (*GuildJoinRequest, error) {
+	return g.actionGuildJoinRequest(requestID, actionGuildJoinRequestJSON{Action: JoinRequestRejected, RejectionReason: rejectionReason})
+}
+
+type actionGuildJoinRequestJSON struct {
+	Action          GuildJoinRequestStatus `json:"action"`
+	RejectionReason *string                `json:"rejection_reason,omitempty"`
+}
+
+func (g *Guild) actionGuildJoinRequest(requestID Snowflake, payload actionGuildJoinRequestJSON) (*GuildJoinRequest, error) {
+	u := parseRoute(fmt.Sprintf(actionGuildJoinRequest, api, g.ID.String(), requestID.String()))
+
+	var request *GuildJoinRequest
+	responseBytes, err := firePatchRequest(u, payload, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+
+	err = decodeResponse(u, responseBytes, &request)
+
+	return request, err
+}
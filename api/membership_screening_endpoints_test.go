@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestGuildJoinRequests(t *testing.T) {
+	var requests []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		body, _ := io.ReadAll(req.Body)
+		requests = append(requests, req.Method+" "+strings.TrimPrefix(req.URL.String(), api)+" "+strings.TrimSpace(string(body)))
+
+		response := `{"id":"2","guild_id":"1","user_id":"3","application_status":"APPROVED"}`
+		if req.Method == http.MethodGet {
+			response = `{"guild_join_requests":[{"id":"2","guild_id":"1","user_id":"3","application_status":"SUBMITTED",` +
+				`"form_responses":[{"field_type":"TERMS","label":"Rules","values":["Be nice"],"required":true,"response":true}]}],"total":1,"limit":100}`
+		}
+		return http.StatusOK, response
+	})
+
+	g := &Guild{ID: "1"}
+	page, err := g.ListGuildJoinRequests(JoinRequestSubmitted, nil, nil)
+	if err != nil {
+		t.Fatalf("ListGuildJoinRequests() error = %v", err)
+	}
+	if page.Total != 1 || !page.GuildJoinRequests[0].IsPending() || page.GuildJoinRequests[0].FormResponses[0].FieldType != ScreeningTerms {
+		t.Errorf("ListGuildJoinRequests() = %+v, want one pending request with a Terms answer", page)
+	}
+
+	request, err := g.ApproveGuildJoinRequest(page.GuildJoinRequests[0].ID)
+	if err != nil {
+		t.Fatalf("ApproveGuildJoinRequest() error = %v", err)
+	}
+	if request.ApplicationStatus != JoinRequestApproved {
+		t.Errorf("ApproveGuildJoinRequest() status = %v, want %v", request.ApplicationStatus, JoinRequestApproved)
+	}
+
+	want := []string{
+		"GET /guilds/1/requests?status=SUBMITTED ",
+		`PATCH /guilds/1/requests/id/2 {"action":"APPROVED"}`,
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// MentionType - what a Mention in message content refers to
+type MentionType int
+
+//goland:noinspection GoUnusedConst
+const (
+	MentionTypeUser    MentionType = iota // <@USER_ID> or <@!USER_ID>
+	MentionTypeRole                       // <@&ROLE_ID>
+	MentionTypeChannel                    // <#CHANNEL_ID>
+	MentionTypeCommand                    // </NAME:COMMAND_ID>
+)
+
+// Mention - a mention found in message content
+type Mention struct {
+	Type  MentionType // what the mention refers to
+	ID    Snowflake   // the id of the user, role, channel or command
+	Name  string      // the command path for MentionTypeCommand, e.g. "tag show"; empty otherwise
+	Start int         // byte offset of the mention in the content
+	End   int         // byte offset just past the mention, so content[Start:End] is the mention
+}
+
+// mentionPattern - user, role and channel mentions, then command mentions with up to a subcommand group and subcommand
+var mentionPattern = regexp.MustCompile(`<(@!?|@&|#)(\d{17,20})>|</([-_\p{L}\p{N}]{1,32}(?: [-_\p{L}\p{N}]{1,32}){0,2}):(\d{17,20})>`)
+
+// ParseMentions - Returns the user, role, channel and command mentions in the content, in the order they appear
+//
+// Mentions inside code blocks are included, as Discord does not render them but the text is the same.
+func ParseMentions(content string) []Mention {
+	var mentions []Mention
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(content, -1) {
+		mention := Mention{Start: match[0], End: match[1]}
+
+		if match[2] >= 0 {
+			mention.ID = Snowflake(content[match[4]:match[5]])
+			switch content[match[2]:match[3]] {
+			case "@&":
+				mention.Type = MentionTypeRole
+			case "#":
+				mention.Type = MentionTypeChannel
+			default:
+				mention.Type = MentionTypeUser
+			}
+		} else {
+			mention.Type = MentionTypeCommand
+			mention.Name = content[match[6]:match[7]]
+			mention.ID = Snowflake(content[match[8]:match[9]])
+		}
+
+		mentions = append(mentions, mention)
+	}
+
+	return mentions
+}
+
+// ParseMentions - Returns the mentions in the message's content, in the order they appear
+//
+// Unlike Mentions and MentionRoles, this includes channel and command mentions and where each one is.
+func (m *Message) ParseMentions() []Mention {
+	return ParseMentions(m.Content)
+}
+
+// MentionedIDs - Returns the distinct ids of the given type mentioned in the content, in the order they first appear
+//
+//goland:noinspection GoUnusedExportedFunction
+func MentionedIDs(content string, mentionType MentionType) []Snowflake {
+	var ids []Snowflake
+	seen := make(map[Snowflake]struct{})
+	for _, mention := range ParseMentions(content) {
+		if _, ok := seen[mention.ID]; ok || mention.Type != mentionType {
+			continue
+		}
+		seen[mention.ID] = struct{}{}
+		ids = append(ids, mention.ID)
+	}
+
+	return ids
+}
+
+// jumpURLFormat - https://discord.com/channels/GUILD_ID/CHANNEL_ID/MESSAGE_ID, with @me as the guild for DMs
+const jumpURLFormat = "https://discord.com/channels/%s/%s/%s"
+
+// JumpURL - Returns the link that opens a message in the client; an empty guildID links to a DM
+func JumpURL(guildID, channelID, messageID Snowflake) string {
+	guild := guildID.String()
+	if guild == "" {
+		guild = "@me"
+	}
+
+	return fmt.Sprintf(jumpURLFormat, guild, channelID, messageID)
+}
+
+// JumpURL - Returns the link that opens the message in the client
+//
+// Messages from REST do not carry their guild, so pass the guild of the channel, or an empty ID for a DM.
+func (m *Message) JumpURL(guildID Snowflake) string {
+	return JumpURL(guildID, m.ChannelID, m.ID)
+}
+
+// ReferencedJumpLink - Returns the link to the message this one replies to, forwards or crossposts; empty when there is none
+func (m *Message) ReferencedJumpLink() string {
+	reference := m.MessageReference
+	if reference.MessageID == "" {
+		return ""
+	}
+
+	channelID := reference.ChannelID
+	if channelID == "" {
+		channelID = m.ChannelID
+	}
+
+	return JumpURL(reference.GuildID, channelID, reference.MessageID)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseMentions(t *testing.T) {
+	content := "hi <@123456789012345678> and <@!223456789012345678>, see <#323456789012345678> " +
+		"for <@&423456789012345678>, or run </tag show:523456789012345678>. <@12> <#abc>"
+
+	want := []Mention{
+		{Type: MentionTypeUser, ID: "123456789012345678", Start: 3, End: 24},
+		{Type: MentionTypeUser, ID: "223456789012345678", Start: 29, End: 51},
+		{Type: MentionTypeChannel, ID: "323456789012345678", Start: 57, End: 78},
+		{Type: MentionTypeRole, ID: "423456789012345678", Start: 83, End: 105},
+		{Type: MentionTypeCommand, ID: "523456789012345678", Name: "tag show", Start: 114, End: 144},
+	}
+
+	got := ParseMentions(content)
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseMentions() = %+v, want %+v", got, want)
+	}
+	for _, m := range got {
+		if content[m.Start] != '<' || content[m.End-1] != '>' {
+			t.Errorf("ParseMentions() position %d:%d = %q, want a whole mention", m.Start, m.End, content[m.Start:m.End])
+		}
+	}
+
+	ids := MentionedIDs("<@123456789012345678> <@!123456789012345678> <#323456789012345678>", MentionTypeUser)
+	if !reflect.DeepEqual(ids, []Snowflake{"123456789012345678"}) {
+		t.Errorf("MentionedIDs() = %v, want [123456789012345678]", ids)
+	}
+}
+
+func TestMessageJumpURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *Message
+		guildID Snowflake
+		jump    string
+		ref     string
+	}{
+		{
+			name:    "guild reply",
+			message: &Message{ID: "3", ChannelID: "2", MessageReference: MessageReference{MessageID: "4", ChannelID: "2", GuildID: "1"}},
+			guildID: "1",
+			jump:    "https://discord.com/channels/1/2/3",
+			ref:     "https://discord.com/channels/1/2/4",
+		},
+		{
+			name:    "dm without reference",
+			message: &Message{ID: "3", ChannelID: "2"},
+			jump:    "https://discord.com/channels/@me/2/3",
+		},
+		{
+			name:    "reference without channel",
+			message: &Message{ID: "3", ChannelID: "2", MessageReference: MessageReference{MessageID: "4"}},
+			jump:    "https://discord.com/channels/@me/2/3",
+			ref:     "https://discord.com/channels/@me/2/4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.message.JumpURL(tt.guildID); got != tt.jump {
+				t.Errorf("JumpURL() = %q, want %q", got, tt.jump)
+			}
+			if got := tt.message.ReferencedJumpLink(); got != tt.ref {
+				t.Errorf("ReferencedJumpLink() = %q, want %q", got, tt.ref)
+			}
+		})
+	}
+}
+
+func TestMessageRoleSubscriptionData(t *testing.T) {
+	data := `{"id":"1","type":25,"role_subscription_data":{"role_subscription_listing_id":"2","tier_name":"Gold","total_months_subscribed":3,"is_renewal":true}}`
+
+	var m Message
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if m.Type != RoleSubscriptionPurchase || m.RoleSubscriptionData == nil || m.RoleSubscriptionData.TierName != "Gold" || !m.RoleSubscriptionData.IsRenewal {
+		t.Errorf("Message = %+v, want a Gold renewal", m)
+	}
+
+	data = `{"id":"1","type":44,"purchase_notification":{"type":0,"guild_product_purchase":{"listing_id":"3","product_name":"Emote pack"}}}`
+	if err := json.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if m.PurchaseNotification.GuildProductPurchase.ProductName != "Emote pack" {
+		t.Errorf("Message = %+v, want an Emote pack purchase", m)
+	}
+}
+
+func TestMessageTypePredicates(t *testing.T) {
+	tests := []struct {
+		messageType MessageType
+		system      bool
+		deletable   bool
+	}{
+		{Default, false, true},
+		{Reply, false, true},
+		{ChatInputCommand, false, true},
+		{ContextMenuCommand, false, true},
+		{UserJoin, true, true},
+		{GuildBoostTier3, true, true},
+		{AutoModerationAction, true, true},
+		{StageStart, true, true},
+		{RecipientAdd, true, false},
+		{ChannelNameChange, true, false},
+		{ThreadStarterMessage, true, false},
+	}
+	for _, tt := range tests {
+		if got := tt.messageType.IsSystem(); got != tt.system {
+			t.Errorf("%v.IsSystem() = %v, want %v", tt.messageType, got, tt.system)
+		}
+		if got := tt.messageType.IsDeletable(); got != tt.deletable {
+			t.Errorf("%v.IsDeletable() = %v, want %v", tt.messageType, got, tt.deletable)
+		}
+	}
+}
+
+func TestMessageTypeValues(t *testing.T) {
+	tests := []struct {
+		messageType MessageType
+		want        int
+	}{
+		{ChannelFollowAdd, 12},
+		{GuildDiscoveryDisqualified, 14},
+		{StageSpeaker, 29},
+		{StageTopic, 31},
+		{GuildApplicationPremiumSubscription, 32},
+		{GuildIncidentAlertModeEnabled, 36},
+		{GuildIncidentReportFalseAlarm, 39},
+		{PurchaseNotification, 44},
+		{PollResult, 46},
+	}
+	for _, tt := range tests {
+		if int(tt.messageType) != tt.want {
+			t.Errorf("%v = %d, want %d", tt.messageType, int(tt.messageType), tt.want)
+		}
+	}
+}
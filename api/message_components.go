@@ -26,6 +26,7 @@ type Component struct {
 	Label       string          `json:"label,omitempty"`       // text that appears on the button, max 80 characters
 	Emoji       *Emoji          `json:"emoji,omitempty"`       // name, id, and animated
 	URL         string          `json:"url,omitempty"`         // a URL for link-style buttons
+	SkuID       Snowflake       `json:"sku_id,omitempty"`      // the SKU a ButtonPremium button offers for purchase
 	Options     []*SelectOption `json:"options,omitempty"`     // the choices in the select, max 25
 	MinValues   int             `json:"min_values,omitempty"`  // the minimum number of items that must be chosen; default 1, min 0, max 25
 	MaxValues   int             `json:"max_values,omitempty"`  // the maximum number of items that can be chosen; default 1, max 25
@@ -50,6 +51,13 @@ const (
 	ComponentTypeRoleSelect                                 // Select menu for roles
 	ComponentTypeMentionableSelect                          // Select menu for mentionables (users and roles)
 	ComponentTypeChannelSelect                              // Select menu for channels
+	ComponentTypeSection                                    // Container to display text alongside an accessory component
+	ComponentTypeTextDisplay                                // Markdown text
+	ComponentTypeThumbnail                                  // Small image that can be used as an accessory
+	ComponentTypeMediaGallery                               // Display images and other media
+	ComponentTypeFile                                       // Displays an attached file
+	ComponentTypeSeparator                                  // Component to add vertical padding between other components
+	ComponentTypeContainer         ComponentType = iota + 3 // Container that visually groups a set of components
 )
 
 // Button - Buttons are interactive components that render on messages.
@@ -84,6 +92,7 @@ const (
 	ButtonSuccess                          // color: green; requires field: custom_id
 	ButtonDanger                           // color: red; requires field: custom_id
 	ButtonLink                             // color: grey; requires field: url
+	ButtonPremium                          // color: blurple; requires field: sku_id; does not send an interaction to the app when clicked
 )
 
 // SelectMenu - Select menus support single-select and multi-select behavior, meaning you can prompt a user to choose just one item from a list, or multiple.
@@ -14,6 +14,11 @@
 
 package api
 
+import (
+	"errors"
+	"fmt"
+)
+
 // NewComponent - Build a new Component
 func NewComponent() *Component {
 	return &Component{}
@@ -203,3 +208,176 @@ func (i *InteractionResponseAutocomplete) AddChoices(c []*ApplicationCommandOpti
 
 	return i
 }
+
+// ButtonsPerRow - An action row can hold up to 5 buttons
+const ButtonsPerRow = 5
+
+// ComponentError - A component that breaks Discord's layout rules
+type ComponentError struct {
+	Row    int    // index of the top-level component
+	Index  int    // index of the component within the row, or -1 when the row itself is at fault
+	Reason string // what is wrong with the component
+}
+
+// Error - implements the error interface
+func (e *ComponentError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("components[%d]: %s", e.Row, e.Reason)
+	}
+
+	return fmt.Sprintf("components[%d].components[%d]: %s", e.Row, e.Index, e.Reason)
+}
+
+// IsSelectMenu - Whether the component type is one of the select menus
+func (t ComponentType) IsSelectMenu() bool {
+	switch t {
+	case ComponentTypeSelectMenu, ComponentTypeUserSelect, ComponentTypeRoleSelect, ComponentTypeMentionableSelect, ComponentTypeChannelSelect:
+		return true
+	default:
+		return false
+	}
+}
+
+// buttonStyle - reads the Style of a button, which holds a float64 when the component was decoded from JSON
+func (c *Component) buttonStyle() ButtonStyle {
+	switch style := c.Style.(type) {
+	case ButtonStyle:
+		return style
+	case int:
+		return ButtonStyle(style)
+	case float64:
+		return ButtonStyle(style)
+	default:
+		return 0
+	}
+}
+
+// ValidateMessageComponents - Checks that the components can be sent on a message, returning a ComponentError for every rule they break
+//
+// Only the classic action row layout is checked; action rows nested in sections and containers follow the same rules.
+func ValidateMessageComponents(components []*Component) error {
+	return errors.Join(messageComponentErrors(components)...)
+}
+
+func messageComponentErrors(components []*Component) []error {
+	var errs []error
+
+	rows := 0
+	for i, component := range components {
+		if component == nil {
+			continue
+		}
+
+		switch {
+		case component.Type == ComponentTypeActionRow:
+			rows++
+			if rows > ActionRowCount {
+				errs = append(errs, &ComponentError{Row: i, Index: -1, Reason: fmt.Sprintf("a message can have at most %d action rows", ActionRowCount)})
+			}
+			errs = append(errs, validateMessageRow(i, component.Components)...)
+		case component.Type == ComponentTypeButton || component.Type == ComponentTypeTextInput || component.Type.IsSelectMenu():
+			errs = append(errs, &ComponentError{Row: i, Index: -1, Reason: "interactive components must be inside an action row"})
+		}
+	}
+
+	return errs
+}
+
+func validateMessageRow(row int, components []*Component) []error {
+	if len(components) == 0 {
+		return []error{&ComponentError{Row: row, Index: -1, Reason: "an action row must contain at least one component"}}
+	}
+
+	var errs []error
+	buttons := 0
+	for i, component := range components {
+		if component == nil {
+			continue
+		}
+		fail := func(reason string) {
+			errs = append(errs, &ComponentError{Row: row, Index: i, Reason: reason})
+		}
+
+		switch {
+		case component.Type == ComponentTypeButton:
+			buttons++
+			if buttons > ButtonsPerRow {
+				fail(fmt.Sprintf("an action row can hold at most %d buttons", ButtonsPerRow))
+			}
+
+			switch component.buttonStyle() {
+			case ButtonLink:
+				if component.URL == "" {
+					fail("link buttons require a url")
+				}
+				if component.CustomID != "" {
+					fail("link buttons cannot have a custom_id")
+				}
+			case ButtonPremium:
+				if component.SkuID == "" {
+					fail("premium buttons require a sku_id")
+				}
+				if component.CustomID != "" || component.URL != "" || component.Label != "" {
+					fail("premium buttons cannot have a custom_id, url or label")
+				}
+			default:
+				if component.CustomID == "" {
+					fail("non-link buttons require a custom_id")
+				}
+				if component.URL != "" {
+					fail("non-link buttons cannot have a url")
+				}
+			}
+		case component.Type.IsSelectMenu():
+			if len(components) > 1 {
+				fail("a select menu must be alone in its action row")
+			}
+			if component.CustomID == "" {
+				fail("select menus require a custom_id")
+			}
+		case component.Type == ComponentTypeTextInput:
+			fail("text inputs can only be used in modals")
+		default:
+			fail("an action row can only contain buttons or a select menu")
+		}
+	}
+
+	return errs
+}
+
+// ValidateModalComponents - Checks that the components can be shown in a modal, returning a ComponentError for every rule they break
+func ValidateModalComponents(components []*Component) error {
+	var errs []error
+
+	if len(components) == 0 || len(components) > ActionRowCount {
+		errs = append(errs, &ComponentError{Row: 0, Index: -1, Reason: fmt.Sprintf("a modal must have between 1 and %d action rows", ActionRowCount)})
+	}
+
+	for i, component := range components {
+		if component == nil {
+			continue
+		}
+		if component.Type != ComponentTypeActionRow {
+			errs = append(errs, &ComponentError{Row: i, Index: -1, Reason: "a modal can only contain action rows"})
+			continue
+		}
+		if len(component.Components) != 1 || component.Components[0] == nil || component.Components[0].Type != ComponentTypeTextInput {
+			errs = append(errs, &ComponentError{Row: i, Index: -1, Reason: "each action row of a modal must hold exactly one text input"})
+			continue
+		}
+		if component.Components[0].CustomID == "" {
+			errs = append(errs, &ComponentError{Row: i, Index: 0, Reason: "text inputs require a custom_id"})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate - Checks the modal's components against Discord's layout rules
+func (i *InteractionResponseModal) Validate() error {
+	if i.Data == nil {
+		return ValidateModalComponents(nil)
+	}
+
+	return ValidateModalComponents(i.Data.Components)
+}
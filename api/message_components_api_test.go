@@ -15,6 +15,7 @@
 package api
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
 )
@@ -668,3 +669,106 @@ func TestComponentSetURL(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMessageComponents(t *testing.T) {
+	button := func(customID string) *Component {
+		return &Component{Type: ComponentTypeButton, Style: ButtonPrimary, CustomID: customID}
+	}
+	row := func(components ...*Component) *Component {
+		return &Component{Type: ComponentTypeActionRow, Components: components}
+	}
+
+	tests := []struct {
+		name       string
+		components []*Component
+		want       string
+	}{
+		{"valid", []*Component{row(button("a"), button("b")), row(&Component{Type: ComponentTypeUserSelect, CustomID: "c"})}, ""},
+		{"link button", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonLink, URL: googleDotCom})}, ""},
+		{"decoded style", []*Component{row(&Component{Type: ComponentTypeButton, Style: float64(ButtonLink), URL: googleDotCom})}, ""},
+		{"too many rows", []*Component{row(button("a")), row(button("b")), row(button("c")), row(button("d")), row(button("e")), row(button("f"))}, "components[5]: a message can have at most 5 action rows"},
+		{"too many buttons", []*Component{row(button("a"), button("b"), button("c"), button("d"), button("e"), button("f"))}, "components[0].components[5]: an action row can hold at most 5 buttons"},
+		{"select not alone", []*Component{row(button("a"), &Component{Type: ComponentTypeSelectMenu, CustomID: "b"})}, "components[0].components[1]: a select menu must be alone in its action row"},
+		{"text input", []*Component{row(&Component{Type: ComponentTypeTextInput, CustomID: "a"})}, "components[0].components[0]: text inputs can only be used in modals"},
+		{"link with custom_id", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonLink, URL: googleDotCom, CustomID: "a"})}, "components[0].components[0]: link buttons cannot have a custom_id"},
+		{"link without url", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonLink})}, "components[0].components[0]: link buttons require a url"},
+		{"premium button", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonPremium, SkuID: "1"})}, ""},
+		{"premium without sku_id", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonPremium})}, "components[0].components[0]: premium buttons require a sku_id"},
+		{"premium with label", []*Component{row(&Component{Type: ComponentTypeButton, Style: ButtonPremium, SkuID: "1", Label: "Buy"})}, "components[0].components[0]: premium buttons cannot have a custom_id, url or label"},
+		{"button outside row", []*Component{button("a")}, "components[0]: interactive components must be inside an action row"},
+		{"empty row", []*Component{row()}, "components[0]: an action row must contain at least one component"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if err := ValidateMessageComponents(tt.components); err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("ValidateMessageComponents() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateModalComponents(t *testing.T) {
+	input := &Component{Type: ComponentTypeActionRow, Components: []*Component{{Type: ComponentTypeTextInput, CustomID: "a"}}}
+	button := &Component{Type: ComponentTypeActionRow, Components: []*Component{{Type: ComponentTypeButton, Style: ButtonPrimary, CustomID: "b"}}}
+
+	if err := ValidateModalComponents([]*Component{input}); err != nil {
+		t.Errorf("ValidateModalComponents() error = %v", err)
+	}
+	if err := ValidateModalComponents([]*Component{input, button}); err == nil || err.Error() != "components[1]: each action row of a modal must hold exactly one text input" {
+		t.Errorf("ValidateModalComponents() error = %v", err)
+	}
+	if err := (&InteractionResponseModal{}).Validate(); err == nil {
+		t.Errorf("Validate() of an empty modal returned no error")
+	}
+}
+
+func TestSelectMenuSplitting(t *testing.T) {
+	var options []*SelectOption
+	for i := 0; i < 60; i++ {
+		options = append(options, NewSelectOption(fmt.Sprint("Option ", i), fmt.Sprint(i)).SetDescription("an option"))
+	}
+	template := NewSelectMenu("pick").SetPlaceholder("Choose").SetMaxValues(25)
+
+	rows, err := ChunkSelectMenus(template, options)
+	if err != nil || len(rows) != 3 || ValidateMessageComponents(rows) != nil {
+		t.Fatalf("ChunkSelectMenus() = %d rows, %v, %v", len(rows), err, ValidateMessageComponents(rows))
+	}
+	if last := rows[2].Components[0]; last.CustomID != "pick:2" || len(last.Options) != 10 || last.MaxValues != 10 || last.Placeholder != "Choose" {
+		t.Errorf("ChunkSelectMenus() last menu = %+v", last)
+	}
+	if _, err := ChunkSelectMenus(template, append(append(options, options...), options...)); err == nil {
+		t.Error("ChunkSelectMenus() split 180 options across more than 5 rows")
+	}
+
+	tests := []struct {
+		page, want       int
+		first            string
+		prevOff, nextOff bool
+	}{
+		{0, 0, "0", true, false},
+		{1, 1, "25", false, false},
+		{7, 2, "50", false, true},
+	}
+	for _, tt := range tests {
+		rows := PaginateSelectMenu(template, options, tt.page)
+		if err := ValidateMessageComponents(rows); err != nil {
+			t.Fatalf("PaginateSelectMenu(%d) invalid: %v", tt.page, err)
+		}
+
+		menu, nav := rows[0].Components[0], rows[1].Components
+		if menu.CustomID != "pick" || menu.Options[0].Value != tt.first || nav[0].Disabled != tt.prevOff || nav[2].Disabled != tt.nextOff {
+			t.Errorf("PaginateSelectMenu(%d) = first %s, prev disabled %v, next disabled %v", tt.page, menu.Options[0].Value, nav[0].Disabled, nav[2].Disabled)
+		}
+		if nav[1].Label != fmt.Sprintf("%d/3", tt.want+1) {
+			t.Errorf("PaginateSelectMenu(%d) indicator = %q", tt.page, nav[1].Label)
+		}
+		if page, ok := SelectMenuPage("pick", nav[2].CustomID); !tt.nextOff && (!ok || page != tt.want+1) {
+			t.Errorf("SelectMenuPage(%q) = %d, %v", nav[2].CustomID, page, ok)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"net/http"
+)
+
+// RoundTripFunc - sends a REST request and returns Discord's response
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware - wraps the sending of every REST request, e.g. to add headers, log requests or answer from a cache
+//
+// A middleware may return a response without calling next; the response's rate limit headers are still applied to the bucket.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use - Adds middleware around every request the RateLimiter sends; the first added is the outermost
+//
+// Middleware runs once the request's bucket is free, and again for each retry after a 429.
+func (r *RateLimiter) Use(middleware ...Middleware) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.middleware = append(r.middleware, middleware...)
+
+	var transport RoundTripFunc = sendRequest
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		transport = r.middleware[i](transport)
+	}
+	r.transport.Store(&transport)
+}
+
+// roundTrip sends the request through the middleware chain
+func (r *RateLimiter) roundTrip(req *http.Request) (*http.Response, error) {
+	if transport := r.transport.Load(); transport != nil {
+		return (*transport)(req)
+	}
+
+	return sendRequest(req)
+}
+
+// sendRequest is the innermost RoundTripFunc, which sends the request to Discord
+func sendRequest(req *http.Request) (*http.Response, error) {
+	client := http.Client{}
+
+	return client.Do(req)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRateLimiterUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, r.Header.Get("X-Trace"))
+	}))
+	defer server.Close()
+
+	var order []string
+	limiter := NewRatelimiter()
+	limiter.Use(
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, "outer")
+				req.Header.Set("X-Trace", "traced")
+				return next(req)
+			}
+		},
+		func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, "inner")
+				if strings.HasSuffix(req.URL.Path, "/cached") {
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("cached")), Header: http.Header{}}, nil
+				}
+				return next(req)
+			}
+		},
+	)
+
+	for _, tt := range []struct{ path, want string }{{"/live", "traced"}, {"/cached", "cached"}} {
+		resp, err := limiter.Request(http.MethodGet, server.URL+tt.path, nil, nil)
+		if err != nil {
+			t.Fatalf("Request(%s) error = %v", tt.path, err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if string(body) != tt.want {
+			t.Errorf("Request(%s) body = %q, want %q", tt.path, body, tt.want)
+		}
+	}
+
+	if strings.Join(order, ",") != "outer,inner,outer,inner" {
+		t.Errorf("middleware order = %v, want outer before inner", order)
+	}
+}
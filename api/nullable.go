@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Nullable - a PATCH field that can be left unchanged, set to a value, or cleared with null
+//
+// Use it through a pointer tagged omitempty: a nil *Nullable leaves the field out of the request,
+// NewNullable sets it, and Null sends null to clear it, e.g. removing a nickname or a channel's topic.
+type Nullable[T any] struct {
+	Value T    // the value to set; ignored when Valid is false
+	Valid bool // false sends null
+}
+
+// NewNullable - Returns a field that sets the value
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewNullable[T any](value T) *Nullable[T] {
+	return &Nullable[T]{Value: value, Valid: true}
+}
+
+// Null - Returns a field that clears the value by sending null
+//
+//goland:noinspection GoUnusedExportedFunction
+func Null[T any]() *Nullable[T] {
+	return &Nullable[T]{}
+}
+
+// Get - Returns the value and whether it is set rather than null
+func (n *Nullable[T]) Get() (T, bool) {
+	if n == nil || !n.Valid {
+		var zero T
+		return zero, false
+	}
+
+	return n.Value, true
+}
+
+// MarshalJSON - Encodes the value, or null when it is not Valid
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON - Decodes null as not Valid
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		var zero T
+		n.Value, n.Valid = zero, false
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.Value); err != nil {
+		return err
+	}
+	n.Valid = true
+
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/utilities"
+)
+
+func TestNullablePatchFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload any
+		want    string
+	}{
+		{
+			name:    "Omitted",
+			payload: ModifyGuildMemberJSON{},
+			want:    `{}`,
+		},
+		{
+			name:    "Cleared",
+			payload: ModifyGuildMemberJSON{Nick: Null[string]()},
+			want:    `{"nick":null}`,
+		},
+		{
+			name:    "Set",
+			payload: ModifyGuildMemberJSON{Nick: NewNullable("Nelly")},
+			want:    `{"nick":"Nelly"}`,
+		},
+		{
+			name:    "Timeout removed",
+			payload: (&ModifyGuildMemberJSON{}).TimeoutUntil(time.Time{}),
+			want:    `{"communication_disabled_until":null}`,
+		},
+		{
+			name: "Channel topic cleared",
+			payload: ModifyTextChannelJSON{ModifyAnnouncementChannelJSON: ModifyAnnouncementChannelJSON{
+				ModifyAllChannelJSON: ModifyAllChannelJSON{Name: "general"},
+				Topic:                Null[string](),
+			}},
+			want: `{"name":"general","topic":null}`,
+		},
+		{
+			name: "Converted to text",
+			payload: ModifyAnnouncementChannelJSON{
+				ModifyAllChannelJSON: ModifyAllChannelJSON{Name: "news"},
+				Type:                 utilities.ToPtr(GuildText),
+			},
+			want: `{"name":"news","type":0}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := json.Marshal(tt.payload)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("json.Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var payload struct {
+		Set     *Nullable[string] `json:"set"`
+		Cleared *Nullable[string] `json:"cleared"`
+		Missing *Nullable[string] `json:"missing"`
+	}
+	if err := json.Unmarshal([]byte(`{"set":"x","cleared":null}`), &payload); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if v, ok := payload.Set.Get(); !ok || v != "x" {
+		t.Errorf("Set.Get() = %q, %v", v, ok)
+	}
+	if _, ok := payload.Cleared.Get(); ok {
+		t.Error("Cleared.Get() reported a value")
+	}
+	if payload.Missing != nil {
+		t.Error("Missing was decoded")
+	}
+}
@@ -15,6 +15,7 @@
 package api
 
 import (
+	"encoding/json"
 	"strconv"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -81,6 +82,9 @@ const (
 	CreateEvents                     Permission = 1 << 44 // Allows for creating scheduled events, and editing and deleting those created by the current user
 	UseExternalSounds                Permission = 1 << 45 // Allows the usage of custom soundboard sounds from other servers
 	SendVoiceMessages                Permission = 1 << 46 // Allows sending voice messages
+	SetVoiceChannelStatus            Permission = 1 << 48 // Allows setting the status of a voice channel
+	SendPolls                        Permission = 1 << 49 // Allows sending polls
+	UseExternalApps                  Permission = 1 << 50 // Allows user-installed apps to send public responses. When disabled, users will still be allowed to use their apps but the responses will be ephemeral. This only applies to apps not also installed to the server
 )
 
 /*
@@ -710,22 +714,103 @@ func CanModerateMembers(member *GuildMember, channel *Channel) bool {
 //
 // The @everyone role has the same ID as the guild it belongs to.
 type Role struct {
-	ID           Snowflake  `json:"id"`                      // role id
-	Name         string     `json:"name"`                    // role name
-	Color        int        `json:"color"`                   // integer representation of hexadecimal color code
-	Hoist        bool       `json:"hoist"`                   // if this role is pinned in the user listing
-	Icon         *string    `json:"icon,omitempty"`          // role icon hash
-	UnicodeEmoji *string    `json:"unicode_emoji,omitempty"` // role unicode emoji
-	Position     int        `json:"position"`                // position of this role
-	Permissions  Permission `json:"permissions,string"`      // permission bit set
-	Managed      bool       `json:"managed"`                 // whether this role is managed by an integration
-	Mentionable  bool       `json:"mentionable"`             // whether this role is mentionable
-	Tags         RoleTags   `json:"tags,omitempty"`          // the tags this role has
-}
+	ID           Snowflake   `json:"id"`                      // role id
+	Name         string      `json:"name"`                    // role name
+	Color        int         `json:"color"`                   // integer representation of hexadecimal color code; superseded by Colors
+	Colors       *RoleColors `json:"colors,omitempty"`        // the role's colors; a secondary color makes a gradient
+	Hoist        bool        `json:"hoist"`                   // if this role is pinned in the user listing
+	Icon         *string     `json:"icon,omitempty"`          // role icon hash
+	UnicodeEmoji *string     `json:"unicode_emoji,omitempty"` // role unicode emoji
+	Position     int         `json:"position"`                // position of this role
+	Permissions  Permission  `json:"permissions,string"`      // permission bit set
+	Managed      bool        `json:"managed"`                 // whether this role is managed by an integration
+	Mentionable  bool        `json:"mentionable"`             // whether this role is mentionable
+	Tags         *RoleTags   `json:"tags,omitempty"`          // the tags this role has
+	Flags        RoleFlags   `json:"flags"`                   // role flags combined as a bitfield
+}
+
+// RoleColors - the colors of a Role, as integer representations of hexadecimal color codes
+type RoleColors struct {
+	PrimaryColor   int  `json:"primary_color"`   // the primary color of the role
+	SecondaryColor *int `json:"secondary_color"` // the secondary color of the role; makes a gradient
+	TertiaryColor  *int `json:"tertiary_color"`  // the tertiary color of the role; only set for the holographic style
+}
+
+// RoleFlags - role flags combined as a bitfield
+type RoleFlags int
+
+//goland:noinspection GoUnusedConst
+const (
+	RoleInPrompt RoleFlags = 1 << 0 // role can be selected by members in an onboarding prompt
+)
 
 // RoleTags - the tags this Role has
+//
+// Discord sends premium_subscriber, available_for_purchase and guild_connections as null when they are true and leaves them out when false;
+// they are decoded into plain booleans here.
 type RoleTags struct {
-	BotID             Snowflake `json:"bot_id,omitempty"`             // the id of the bot this role belongs to
-	IntegrationID     Snowflake `json:"integration_id,omitempty"`     // the id of the integration this role belongs to
-	PremiumSubscriber *string   `json:"premium_subscriber,omitempty"` // whether this is the guild's premium subscriber role
+	BotID                 *Snowflake `json:"bot_id,omitempty"`                  // the id of the bot this role belongs to
+	IntegrationID         *Snowflake `json:"integration_id,omitempty"`          // the id of the integration this role belongs to
+	PremiumSubscriber     bool       `json:"premium_subscriber,omitempty"`      // whether this is the guild's Booster role
+	SubscriptionListingID *Snowflake `json:"subscription_listing_id,omitempty"` // the id of this role's subscription sku and listing
+	AvailableForPurchase  bool       `json:"available_for_purchase,omitempty"`  // whether this role is available for purchase
+	GuildConnections      bool       `json:"guild_connections,omitempty"`       // whether this role is a guild's linked role
+}
+
+// roleTagsJSON - the wire format of RoleTags, where a present but null flag means true
+type roleTagsJSON struct {
+	BotID                 *Snowflake       `json:"bot_id,omitempty"`
+	IntegrationID         *Snowflake       `json:"integration_id,omitempty"`
+	PremiumSubscriber     *json.RawMessage `json:"premium_subscriber,omitempty"`
+	SubscriptionListingID *Snowflake       `json:"subscription_listing_id,omitempty"`
+	AvailableForPurchase  *json.RawMessage `json:"available_for_purchase,omitempty"`
+	GuildConnections      *json.RawMessage `json:"guild_connections,omitempty"`
+}
+
+// UnmarshalJSON - Decodes the "null means true" flags of the tags object
+func (t *RoleTags) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var tags roleTagsJSON
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return err
+	}
+
+	_, premiumSubscriber := raw["premium_subscriber"]
+	_, availableForPurchase := raw["available_for_purchase"]
+	_, guildConnections := raw["guild_connections"]
+
+	*t = RoleTags{
+		BotID:                 tags.BotID,
+		IntegrationID:         tags.IntegrationID,
+		PremiumSubscriber:     premiumSubscriber,
+		SubscriptionListingID: tags.SubscriptionListingID,
+		AvailableForPurchase:  availableForPurchase,
+		GuildConnections:      guildConnections,
+	}
+
+	return nil
+}
+
+// MarshalJSON - Encodes the tags the way Discord sends them, so a decoded Role round-trips
+func (t RoleTags) MarshalJSON() ([]byte, error) {
+	null := json.RawMessage("null")
+	flag := func(set bool) *json.RawMessage {
+		if set {
+			return &null
+		}
+		return nil
+	}
+
+	return json.Marshal(roleTagsJSON{
+		BotID:                 t.BotID,
+		IntegrationID:         t.IntegrationID,
+		PremiumSubscriber:     flag(t.PremiumSubscriber),
+		SubscriptionListingID: t.SubscriptionListingID,
+		AvailableForPurchase:  flag(t.AvailableForPurchase),
+		GuildConnections:      flag(t.GuildConnections),
+	})
 }
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRoleTagsUnmarshalJSON(t *testing.T) {
+	botID := Snowflake("1234")
+	tests := []struct {
+		name string
+		data string
+		want RoleTags
+	}{
+		{
+			name: "Bot Role",
+			data: `{"bot_id":"1234"}`,
+			want: RoleTags{BotID: &botID},
+		},
+		{
+			name: "Booster Role",
+			data: `{"premium_subscriber":null}`,
+			want: RoleTags{PremiumSubscriber: true},
+		},
+		{
+			name: "Linked Role",
+			data: `{"guild_connections":null,"available_for_purchase":null}`,
+			want: RoleTags{AvailableForPurchase: true, GuildConnections: true},
+		},
+		{
+			name: "No Tags",
+			data: `{}`,
+			want: RoleTags{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got RoleTags
+			if err := json.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnmarshalJSON() = %+v, want %+v", got, tt.want)
+			}
+
+			data, err := json.Marshal(got)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			var roundTrip RoleTags
+			if err = json.Unmarshal(data, &roundTrip); err != nil || !reflect.DeepEqual(roundTrip, tt.want) {
+				t.Errorf("MarshalJSON() = %s, does not round-trip", data)
+			}
+		})
+	}
+}
@@ -15,6 +15,8 @@
 package api
 
 import (
+	"context"
+	"errors"
 	"math"
 	"net/http"
 	"strconv"
@@ -22,6 +24,8 @@ import (
 	"sync"
 	"sync/atomic"
 	"time"
+
+	log "github.com/veteran-software/nowlive-logging"
 )
 
 type rateLimitResponse struct {
@@ -41,21 +45,92 @@ type customRateLimit struct {
 type RateLimiter struct {
 	sync.Mutex
 
-	global           *int64
-	buckets          map[string]*bucket
-	customRateLimits []*customRateLimit
+	// OnRateLimit is called, if set, whenever a request has to wait before it can be sent.
+	//
+	// It runs on the requesting goroutine while the bucket is held, so it should return quickly. Set it before issuing requests.
+	OnRateLimit func(delay RateLimitDelay)
+
+	// InvalidRequestLimit is the number of 401, 403 and 429 responses allowed within InvalidRequestWindow before further requests are refused.
+	//
+	// Discord bans the IP for an hour at 10,000; zero disables the circuit breaker.
+	InvalidRequestLimit int
+
+	// Store, if set, persists bucket state so windows exhausted before a restart are still honoured after it. Set it before issuing requests.
+	//
+	// A SharedBucketStore also coordinates requests between processes that use the same token.
+	Store BucketStore
+
+	// EmojiConcurrency is the number of emoji create, modify and delete requests allowed in flight at once for each guild; 1 when zero.
+	//
+	// Emoji routes are limited per guild in ways the rate limit headers do not report, so they are paced separately. Set it before issuing requests.
+	EmojiConcurrency int
+
+	// EmojiInterval is the least time between the starts of emoji create, modify and delete requests for one guild.
+	//
+	// DefaultEmojiInterval when zero; negative for no pacing beyond the rate limit headers.
+	EmojiInterval time.Duration
+
+	global                 *int64
+	middleware             []Middleware
+	transport              atomic.Pointer[RoundTripFunc] // the middleware chain, read without taking the lock
+	defaultAllowedMentions atomic.Pointer[AllowedMentions]
+	bearerToken            atomic.Pointer[string]
+	buckets                map[string]*bucket
+	customRateLimits       []*customRateLimit
+	invalidRequests        *invalidRequestCounter
+	highPending            int64
+	pending                int64 // requests waiting on a bucket or in flight
+	emojiGates             map[Snowflake]*emojiGate
+	cache                  atomic.Pointer[ResponseCache] // answers fresh GET requests before they lock a bucket
+}
+
+// InvalidRequestWindow - the period Discord counts invalid requests over before issuing a Cloudflare ban
+const InvalidRequestWindow = 10 * time.Minute
+
+// ErrInvalidRequestLimit - returned instead of sending a request while the invalid request circuit breaker is open
+var ErrInvalidRequestLimit = errors.New("invalid request limit reached; refusing to send requests to avoid a Cloudflare ban")
+
+// invalidRequestCounter - a sliding count of invalid requests, kept in one-minute slots
+type invalidRequestCounter struct {
+	sync.Mutex
+
+	slots [10]int
+	times [10]int64
 }
 
 // bucket represents a ratelimit bucket, each bucket gets ratelimited individually (-global ratelimits)
 type bucket struct {
-	sync.Mutex
+	priorityLock
 
 	Key             string
+	Hash            string
+	Limit           int
 	Remaining       int
 	reset           time.Time
 	global          *int64
 	lastReset       time.Time
 	customRateLimit *customRateLimit
+	store           BucketStore
+
+	// state is a copy of the fields above that can be read without waiting on an in-flight request
+	state atomic.Pointer[BucketState]
+}
+
+// BucketState - a point-in-time view of a single rate limit bucket
+type BucketState struct {
+	Route     string    // the route (without query string) the bucket was created for
+	Hash      string    // the X-RateLimit-Bucket hash Discord reported for the route, if any
+	Limit     int       // the number of requests that can be made per window, if reported
+	Remaining int       // the number of requests left in the current window
+	Reset     time.Time // when the current window resets; the zero value means it has not been reported
+}
+
+// RateLimitDelay - describes a request that is being held back by the RateLimiter
+type RateLimitDelay struct {
+	Bucket   BucketState   // state of the bucket the request belongs to
+	Wait     time.Duration // how long the request will sleep before being sent
+	Global   bool          // whether the global rate limit caused the delay
+	Exceeded bool          // whether Discord answered with 429 Too Many Requests
 }
 
 // NewRatelimiter returns a new RateLimiter
@@ -63,8 +138,10 @@ type bucket struct {
 //goland:noinspection SpellCheckingInspection
 func NewRatelimiter() *RateLimiter {
 	return &RateLimiter{
-		buckets: make(map[string]*bucket),
-		global:  new(int64),
+		buckets:             make(map[string]*bucket),
+		global:              new(int64),
+		InvalidRequestLimit: 9000,
+		invalidRequests:     &invalidRequestCounter{},
 		customRateLimits: []*customRateLimit{
 			{
 				suffix:   "//reactions//",
@@ -97,47 +174,210 @@ func (r *RateLimiter) getBucket(key string) *bucket {
 		}
 	}
 
+	if r.Store != nil && b.customRateLimit == nil {
+		b.store = r.Store
+		if state, ok, err := r.Store.Load(key); err != nil {
+			log.Errorln(log.Discord, log.FuncName(), err)
+		} else if ok {
+			b.restore(state)
+		}
+	}
+
+	b.publish()
 	r.buckets[key] = b
 
 	return b
 }
 
-// getWaitTime returns the duration you should wait for a bucket
-func (r *RateLimiter) getWaitTime(b *bucket, minRemaining int) time.Duration {
+// Buckets - Returns the current state of every bucket the RateLimiter has seen, in no particular order
+func (r *RateLimiter) Buckets() []BucketState {
+	r.Lock()
+	defer r.Unlock()
+
+	states := make([]BucketState, 0, len(r.buckets))
+	for _, b := range r.buckets {
+		states = append(states, b.snapshot())
+	}
+
+	return states
+}
+
+// Bucket - Returns the current state of the bucket used for the given route, if a request has been made to it
+func (r *RateLimiter) Bucket(route string) (BucketState, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	b, ok := r.buckets[strings.SplitN(route, "?", 2)[0]]
+	if !ok {
+		return BucketState{}, false
+	}
+
+	return b.snapshot(), true
+}
+
+// GlobalReset - Returns when the global rate limit lifts; the zero value means no global limit has been hit
+func (r *RateLimiter) GlobalReset() time.Time {
+	if reset := atomic.LoadInt64(r.global); reset > 0 {
+		return time.Unix(0, reset)
+	}
+
+	return time.Time{}
+}
+
+// InvalidRequests - Returns the number of invalid requests counted within the last InvalidRequestWindow
+func (r *RateLimiter) InvalidRequests() int {
+	return r.invalidRequests.count(time.Now())
+}
+
+// Pending - Returns the number of requests waiting on a bucket or in flight
+func (r *RateLimiter) Pending() int {
+	return int(atomic.LoadInt64(&r.pending))
+}
+
+// Flush - Blocks until every pending request has been sent and answered, or the context ends
+//
+// Flush does not stop new requests from being made; stop the code that makes them first, e.g. when shutting down.
+func (r *RateLimiter) Flush(ctx context.Context) error {
+	ticker := time.NewTicker(flushPoll)
+	defer ticker.Stop()
+
+	for r.Pending() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	return nil
+}
+
+// flushPoll - how often Flush re-checks for pending requests
+const flushPoll = 20 * time.Millisecond
+
+// setGlobalReset blocks every bucket until the given time, in other processes too when the Store is shared
+func (r *RateLimiter) setGlobalReset(resetAt time.Time) {
+	atomic.StoreInt64(r.global, resetAt.UnixNano())
+	r.shareGlobalReset(resetAt)
+}
+
+// isInvalidRequest reports whether Discord counts the response towards the Cloudflare ban threshold
+//
+// 429s with a shared scope are not counted, as they are caused by other applications on the same resource.
+func isInvalidRequest(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return true
+	case http.StatusTooManyRequests:
+		return resp.Header.Get("X-RateLimit-Scope") != "shared"
+	}
+
+	return false
+}
+
+// circuitOpen reports whether too many invalid requests have been made to safely send another
+func (r *RateLimiter) circuitOpen() bool {
+	return r.InvalidRequestLimit > 0 && r.invalidRequests.count(time.Now()) >= r.InvalidRequestLimit
+}
+
+// add records one invalid request at the given time
+func (c *invalidRequestCounter) add(now time.Time) {
+	c.Lock()
+	defer c.Unlock()
+
+	minute := now.Unix() / 60
+	slot := minute % int64(len(c.slots))
+	if c.times[slot] != minute {
+		c.times[slot] = minute
+		c.slots[slot] = 0
+	}
+	c.slots[slot]++
+}
+
+// count returns the number of invalid requests recorded within the window ending at the given time
+func (c *invalidRequestCounter) count(now time.Time) int {
+	c.Lock()
+	defer c.Unlock()
+
+	minute := now.Unix() / 60
+	total := 0
+	for i, t := range c.times {
+		if minute-t < int64(len(c.slots)) {
+			total += c.slots[i]
+		}
+	}
+
+	return total
+}
+
+// getWaitTime returns the duration you should wait for a bucket and whether the wait is caused by the global rate limit
+func (r *RateLimiter) getWaitTime(b *bucket, minRemaining int) (time.Duration, bool) {
 	if b.Remaining < minRemaining && b.reset.After(time.Now()) {
-		return time.Until(b.reset)
+		return time.Until(b.reset), false
 	}
 
 	// Check global rate limits
 	sleepTo := time.Unix(0, atomic.LoadInt64(r.global))
 	if now := time.Now(); now.Before(sleepTo) {
-		return sleepTo.Sub(now)
+		return sleepTo.Sub(now), true
 	}
 
-	return 0
+	return 0, false
 }
 
 // lockBucketObject Locks an already resolved bucket until a request can be made
-func (r *RateLimiter) lockBucketObject(b *bucket) *bucket {
-	b.Lock()
+func (r *RateLimiter) lockBucketObject(b *bucket, priority RequestPriority) *bucket {
+	b.lock(priority)
 
-	if wait := r.getWaitTime(b, 1); wait > 0 {
+	if wait, global := r.getWaitTime(b, 1); wait > 0 {
+		r.notifyDelay(RateLimitDelay{Bucket: b.snapshot(), Wait: wait, Global: global})
 		time.Sleep(wait)
 	}
+	r.takeShared(b)
 
 	b.Remaining--
+	b.publish()
 
 	return b
 }
 
+// notifyDelay hands the delay to OnRateLimit, if one is set
+func (r *RateLimiter) notifyDelay(delay RateLimitDelay) {
+	if r.OnRateLimit != nil {
+		r.OnRateLimit(delay)
+	}
+}
+
+// publish stores a copy of the bucket's current state for lock-free readers; the bucket must be locked or not yet shared
+func (b *bucket) publish() {
+	b.state.Store(&BucketState{
+		Route:     b.Key,
+		Hash:      b.Hash,
+		Limit:     b.Limit,
+		Remaining: b.Remaining,
+		Reset:     b.reset,
+	})
+}
+
+// snapshot returns the last published state of the bucket
+func (b *bucket) snapshot() BucketState {
+	if state := b.state.Load(); state != nil {
+		return *state
+	}
+
+	return BucketState{Route: b.Key}
+}
+
 // lockBucket Locks until a request can be made
-func (r *RateLimiter) lockBucket(bucketID string) *bucket {
-	return r.lockBucketObject(r.getBucket(bucketID))
+func (r *RateLimiter) lockBucket(bucketID string, priority RequestPriority) *bucket {
+	return r.lockBucketObject(r.getBucket(bucketID), priority)
 }
 
 // release unlocks the bucket and reads the headers to update the buckets ratelimit info and locks up the whole thing in case if there's a global ratelimit.
 func (b *bucket) release(headers http.Header) error {
 	defer b.Unlock()
+	defer b.persist()
+	defer b.publish()
 
 	if rl := b.customRateLimit; rl != nil {
 		return b.checkCustomLimit(rl)
@@ -152,6 +392,13 @@ func (b *bucket) release(headers http.Header) error {
 	global := headers.Get("X-RateLimit-Global")
 	resetAfter := headers.Get("X-RateLimit-Reset-After")
 
+	if hash := headers.Get("X-RateLimit-Bucket"); hash != "" {
+		b.Hash = hash
+	}
+	if limit, err := strconv.Atoi(headers.Get("X-RateLimit-Limit")); err == nil {
+		b.Limit = limit
+	}
+
 	// Update global and per bucket reset time if the proper headers are available
 	// If global is set, then it will block all buckets until after X-RateLimit-Reset-After
 	// If Retry-After without global is provided it will use that for the new reset time since it's more accurate than X-RateLimit-Reset.
@@ -222,7 +469,6 @@ func (b *bucket) checkResetAfter(resetAfter string, global string) error {
 
 	if global != "" {
 		atomic.StoreInt64(b.global, resetAt.UnixNano())
-
 	} else {
 		b.reset = resetAt
 	}
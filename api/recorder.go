@@ -0,0 +1,296 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// redacted - what tokens are replaced with in recordings
+const redacted = "[REDACTED]"
+
+// ErrNotRecorded - returned by a Replayer for a request it has no recorded response left for
+var ErrNotRecorded = errors.New("no recorded response for the request")
+
+var (
+	// tokenPath - webhook and interaction tokens in paths, e.g. /webhooks/ID/TOKEN and /interactions/ID/TOKEN/callback
+	tokenPath = regexp.MustCompile(`(/(?:webhooks|interactions)/\d+/)[^/?]+`)
+	// tokenField - JSON fields holding secrets, e.g. the token of a created webhook
+	tokenField = regexp.MustCompile(`("(?:token|access_token|refresh_token|client_secret)"\s*:\s*)"[^"]*"`)
+)
+
+// RecordedExchange - a request and the response it got, as written by a Recorder and served by a Replayer
+//
+// The Authorization header, webhook and interaction tokens in the URL, and token fields in the bodies are redacted.
+type RecordedExchange struct {
+	Time           time.Time     `json:"time"`                      // when the request was sent
+	Duration       time.Duration `json:"duration"`                  // how long Discord took to respond
+	Method         string        `json:"method"`                    // the HTTP method
+	URL            string        `json:"url"`                       // the request URL
+	RequestHeader  http.Header   `json:"request_header,omitempty"`  // the request headers
+	RequestBody    string        `json:"request_body,omitempty"`    // the request body; binary bodies such as uploads are summarised
+	Status         int           `json:"status,omitempty"`          // the response status code
+	ResponseHeader http.Header   `json:"response_header,omitempty"` // the response headers
+	ResponseBody   string        `json:"response_body,omitempty"`   // the response body
+	Error          string        `json:"error,omitempty"`           // the error when no response was received
+}
+
+// Recorder - writes every request a RateLimiter sends, and the response it got, as JSON lines a Replayer can serve back
+//
+// Add it to a RateLimiter with Use(recorder.Middleware()); it only observes, so requests are sent as usual.
+type Recorder struct {
+	Match func(req *http.Request) bool // which requests are recorded; all of them when nil
+
+	mu      sync.Mutex
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+// NewRecorder - Creates a Recorder writing to w
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{encoder: json.NewEncoder(w)}
+}
+
+// RecordToFile - Creates a Recorder appending to the file at path, which is created readable only by the current user
+//
+//goland:noinspection GoUnusedExportedFunction
+func RecordToFile(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{encoder: json.NewEncoder(file), closer: file}, nil
+}
+
+// Close - Closes the file a Recorder from RecordToFile writes to
+func (r *Recorder) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+
+	return r.closer.Close()
+}
+
+// Middleware - Returns the Middleware that records requests and responses
+func (r *Recorder) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if r.Match != nil && !r.Match(req) {
+				return next(req)
+			}
+
+			requestBody, err := readBody(&req.Body)
+			if err != nil {
+				return nil, err
+			}
+
+			exchange := &RecordedExchange{
+				Time:          time.Now(),
+				Method:        req.Method,
+				URL:           redactURL(req.URL.String()),
+				RequestHeader: redactHeader(req.Header),
+				RequestBody:   redactBody(requestBody),
+			}
+
+			resp, err := next(req)
+			exchange.Duration = time.Since(exchange.Time)
+			if err != nil {
+				exchange.Error = err.Error()
+				r.write(exchange)
+				return nil, err
+			}
+
+			responseBody, err := readBody(&resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			exchange.Status = resp.StatusCode
+			exchange.ResponseHeader = resp.Header.Clone()
+			exchange.ResponseBody = redactBody(responseBody)
+			r.write(exchange)
+
+			return resp, nil
+		}
+	}
+}
+
+func (r *Recorder) write(exchange *RecordedExchange) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// A failed write must not fail the request being recorded
+	_ = r.encoder.Encode(exchange)
+}
+
+// Replayer - answers requests with the responses a Recorder wrote, instead of sending them to Discord
+//
+// Add it to a RateLimiter with Use(replayer.Middleware()). Requests are matched on method and URL, and each recorded
+// response is served once, in the order it was recorded; a request with none left fails with ErrNotRecorded.
+type Replayer struct {
+	KeepRateLimits bool // serve the recorded rate limit headers, so buckets wait as they did; they are dropped by default
+
+	mu        sync.Mutex
+	exchanges map[string][]*RecordedExchange
+}
+
+// NewReplayer - Creates a Replayer serving the exchanges read from r
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewReplayer(r io.Reader) (*Replayer, error) {
+	p := &Replayer{exchanges: make(map[string][]*RecordedExchange)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 64<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var exchange RecordedExchange
+		if err := json.Unmarshal(scanner.Bytes(), &exchange); err != nil {
+			return nil, fmt.Errorf("recording line %d: %w", line, err)
+		}
+		key := exchangeKey(exchange.Method, exchange.URL)
+		p.exchanges[key] = append(p.exchanges[key], &exchange)
+	}
+
+	return p, scanner.Err()
+}
+
+// ReplayFile - Creates a Replayer serving the exchanges in the file at path
+//
+//goland:noinspection GoUnusedExportedFunction
+func ReplayFile(path string) (*Replayer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return NewReplayer(file)
+}
+
+// Remaining - Returns how many recorded responses have not been served yet
+func (p *Replayer) Remaining() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	remaining := 0
+	for _, exchanges := range p.exchanges {
+		remaining += len(exchanges)
+	}
+
+	return remaining
+}
+
+// Middleware - Returns the Middleware that serves the recorded responses; requests are never sent to Discord
+func (p *Replayer) Middleware() Middleware {
+	return func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			key := exchangeKey(req.Method, redactURL(req.URL.String()))
+
+			p.mu.Lock()
+			exchanges := p.exchanges[key]
+			if len(exchanges) == 0 {
+				p.mu.Unlock()
+				return nil, fmt.Errorf("%w: %s", ErrNotRecorded, key)
+			}
+			exchange := exchanges[0]
+			p.exchanges[key] = exchanges[1:]
+			p.mu.Unlock()
+
+			if exchange.Error != "" {
+				return nil, errors.New(exchange.Error)
+			}
+
+			header := exchange.ResponseHeader.Clone()
+			if header == nil {
+				header = http.Header{}
+			}
+			if !p.KeepRateLimits {
+				header = withoutRateLimitHeaders(header)
+			}
+
+			return &http.Response{
+				Status:        strconv.Itoa(exchange.Status) + " " + http.StatusText(exchange.Status),
+				StatusCode:    exchange.Status,
+				Header:        header,
+				Body:          io.NopCloser(strings.NewReader(exchange.ResponseBody)),
+				ContentLength: int64(len(exchange.ResponseBody)),
+				Request:       req,
+			}, nil
+		}
+	}
+}
+
+func exchangeKey(method, url string) string {
+	return method + " " + url
+}
+
+// readBody reads a request or response body and replaces it, so it can still be sent or decoded
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return data, err
+}
+
+func redactURL(u string) string {
+	return tokenPath.ReplaceAllString(u, "${1}"+redacted)
+}
+
+func redactHeader(header http.Header) http.Header {
+	clean := header.Clone()
+	if auth := clean.Get("Authorization"); auth != "" {
+		if scheme, _, ok := strings.Cut(auth, " "); ok {
+			clean.Set("Authorization", scheme+" "+redacted)
+		} else {
+			clean.Set("Authorization", redacted)
+		}
+	}
+
+	return clean
+}
+
+func redactBody(body []byte) string {
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("[%d bytes of binary data]", len(body))
+	}
+
+	return tokenField.ReplaceAllString(string(body), `${1}"`+redacted+`"`)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRecorderAndReplayer(t *testing.T) {
+	var recording bytes.Buffer
+	recorder := NewRecorder(&recording)
+
+	live := NewRatelimiter()
+	live.SetBearerToken("oauth-secret")
+	live.Use(recorder.Middleware(), func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			body := `{"id":"1","token":"webhook-secret","name":"hook"}`
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{"X-Ratelimit-Remaining": {"0"}}, Body: io.NopCloser(strings.NewReader(body))}, nil
+		}
+	})
+
+	route := api + "/webhooks/1/interaction-secret"
+	resp, err := live.Request(http.MethodPost, route, map[string]string{"content": "hi"}, nil)
+	if err != nil {
+		t.Fatalf("Request() error = %v", err)
+	}
+	if body, _ := io.ReadAll(resp.Body); !strings.Contains(string(body), "webhook-secret") {
+		t.Errorf("recorded response body = %s, want it passed through unredacted", body)
+	}
+
+	saved := recording.String()
+	for _, secret := range []string{"webhook-secret", "interaction-secret", "oauth-secret"} {
+		if strings.Contains(saved, secret) {
+			t.Errorf("recording contains %q:\n%s", secret, saved)
+		}
+	}
+
+	replayer, err := NewReplayer(strings.NewReader(saved))
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+	replay := NewRatelimiter()
+	replay.Use(replayer.Middleware())
+
+	resp, err = replay.Request(http.MethodPost, route, map[string]string{"content": "hi"}, nil)
+	if err != nil {
+		t.Fatalf("replayed Request() error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), `"name":"hook"`) || resp.Header.Get("X-Ratelimit-Remaining") != "" {
+		t.Errorf("replayed response = %d %v %s, want the recorded body without rate limit headers", resp.StatusCode, resp.Header, body)
+	}
+	if replayer.Remaining() != 0 {
+		t.Errorf("Remaining() = %d, want 0", replayer.Remaining())
+	}
+
+	if _, err = replay.Request(http.MethodPost, route, nil, nil); !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("second replayed Request() error = %v, want ErrNotRecorded", err)
+	}
+}
@@ -12,10 +12,16 @@
  *  If not, see <http://www.gnu.org/licenses/>.
  */
 
+//go:generate go run ../internal/enumgen -output enums_string.go -flags ApplicationFlags,ChannelFlag,GuildMemberFlag,MessageFlags,Permission,SystemChannelFlags,UserFlags
+
 package api
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 )
 
 var (
@@ -42,6 +48,7 @@ const (
 	customEmojiAnimatedFormat Format = "<a:%s:%s>" // customEmojiAnimatedFormat - <a:NAME:ID>
 	unixTimestampFormat       Format = "<t:%s>"    // unixTimestampFormat - <t:TIMESTAMP>
 	unixTimestampStyledFormat Format = "<t:%s:%s>" // unixTimestampStyledFormat - <t:TIMESTAMP:STYLE>
+	slashCommandFormat        Format = "</%s:%s>"  // slashCommandFormat - </NAME:COMMAND_ID>
 )
 
 // TimestampStyle - Timestamps will display the given timestamp in the user's timezone and locale.
@@ -58,6 +65,89 @@ const (
 	RelativeTime  TimestampStyle = "R" // RelativeTime - 2 months ago
 )
 
+// FormatTimestamp - Returns a <t:TIMESTAMP:STYLE> marker that clients render in the reader's timezone and locale; an empty style uses ShortDateTime
+//
+//goland:noinspection GoUnusedExportedFunction
+func FormatTimestamp(t time.Time, style TimestampStyle) string {
+	unix := strconv.FormatInt(t.Unix(), 10)
+	if style == "" {
+		return fmt.Sprintf(string(unixTimestampFormat), unix)
+	}
+
+	return fmt.Sprintf(string(unixTimestampStyledFormat), unix, style)
+}
+
+// MentionUser - Returns a <@USER_ID> mention
+//
+//goland:noinspection GoUnusedExportedFunction
+func MentionUser(userID Snowflake) string {
+	return fmt.Sprintf(string(userFormat), userID.String())
+}
+
+// MentionChannel - Returns a <#CHANNEL_ID> mention
+//
+//goland:noinspection GoUnusedExportedFunction
+func MentionChannel(channelID Snowflake) string {
+	return fmt.Sprintf(string(ChannelFormat), channelID.String())
+}
+
+// MentionRole - Returns a <@&ROLE_ID> mention
+//
+//goland:noinspection GoUnusedExportedFunction
+func MentionRole(roleID Snowflake) string {
+	return fmt.Sprintf(string(roleFormat), roleID.String())
+}
+
+// MentionCommand - Returns a </NAME:COMMAND_ID> mention; name may include a subcommand group and subcommand separated by spaces
+//
+//goland:noinspection GoUnusedExportedFunction
+func MentionCommand(name string, commandID Snowflake) string {
+	return fmt.Sprintf(string(slashCommandFormat), name, commandID.String())
+}
+
+// CommandMention - Returns a </NAME SUBCOMMAND:COMMAND_ID> mention; an empty subcommand mentions the command itself, and a subcommand in a group is given as "group subcommand"
+//
+//goland:noinspection GoUnusedExportedFunction
+func CommandMention(name, subcommand string, id Snowflake) string {
+	if subcommand != "" {
+		name += " " + subcommand
+	}
+
+	return MentionCommand(name, id)
+}
+
+// markdownEscaper - escapes the characters Discord treats as markdown, with the backslash first so escapes are not doubled
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"_", `\_`,
+	"~", `\~`,
+	"`", "\\`",
+	"|", `\|`,
+	">", `\>`,
+	"#", `\#`,
+	"-", `\-`,
+	"[", `\[`,
+	"]", `\]`,
+)
+
+// EscapeMarkdown - Escapes markdown so user supplied text renders literally in message content and embeds
+//
+//goland:noinspection GoUnusedExportedFunction
+func EscapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
+
+// mentionEscaper - breaks mass mentions with a zero width space so they render without pinging
+var mentionEscaper = strings.NewReplacer("@everyone", "@\u200beveryone", "@here", "@\u200bhere")
+
+// EscapeMentions - Stops @everyone and @here in user supplied text from pinging; prefer AllowedMentions where the payload supports it
+//
+//goland:noinspection GoUnusedExportedFunction
+func EscapeMentions(text string) string {
+	return mentionEscaper.Replace(text)
+}
+
 const (
 	// ImageBaseURL - The root URL for image links
 	ImageBaseURL string = "https://cdn.discordapp.com/"
@@ -68,7 +158,88 @@ func PtrStr(s *string) string {
 	return *s
 }
 
+// Locale - a language Discord supports for localization, sent as the user's locale and the guild's preferred locale
+type Locale string
+
+//goland:noinspection GoUnusedConst
+const (
+	Indonesian          Locale = "id"     // Bahasa Indonesia
+	Danish              Locale = "da"     // Dansk
+	German              Locale = "de"     // Deutsch
+	EnglishUK           Locale = "en-GB"  // English, UK
+	EnglishUS           Locale = "en-US"  // English, US
+	Spanish             Locale = "es-ES"  // Español
+	SpanishLATAM        Locale = "es-419" // Español, LATAM
+	French              Locale = "fr"     // Français
+	Croatian            Locale = "hr"     // Hrvatski
+	Italian             Locale = "it"     // Italiano
+	Lithuanian          Locale = "lt"     // Lietuviškai
+	Hungarian           Locale = "hu"     // Magyar
+	Dutch               Locale = "nl"     // Nederlands
+	Norwegian           Locale = "no"     // Norsk
+	Polish              Locale = "pl"     // Polski
+	PortugueseBrazilian Locale = "pt-BR"  // Português do Brasil
+	Romanian            Locale = "ro"     // Română
+	Finnish             Locale = "fi"     // Suomi
+	Swedish             Locale = "sv-SE"  // Svenska
+	Vietnamese          Locale = "vi"     // Tiếng Việt
+	Turkish             Locale = "tr"     // Türkçe
+	Czech               Locale = "cs"     // Čeština
+	Greek               Locale = "el"     // Ελληνικά
+	Bulgarian           Locale = "bg"     // български
+	Russian             Locale = "ru"     // Pусский
+	Ukrainian           Locale = "uk"     // Українська
+	Hindi               Locale = "hi"     // हिन्दी
+	Thai                Locale = "th"     // ไทย
+	ChineseChina        Locale = "zh-CN"  // 中文
+	Japanese            Locale = "ja"     // 日本語
+	ChineseTaiwan       Locale = "zh-TW"  // 繁體中文
+	Korean              Locale = "ko"     // 한국어
+)
+
+// locales - every Locale Discord accepts as a localization key
+var locales = map[Locale]bool{
+	Indonesian: true, Danish: true, German: true, EnglishUK: true, EnglishUS: true, Spanish: true, SpanishLATAM: true,
+	French: true, Croatian: true, Italian: true, Lithuanian: true, Hungarian: true, Dutch: true, Norwegian: true,
+	Polish: true, PortugueseBrazilian: true, Romanian: true, Finnish: true, Swedish: true, Vietnamese: true,
+	Turkish: true, Czech: true, Greek: true, Bulgarian: true, Russian: true, Ukrainian: true, Hindi: true, Thai: true,
+	ChineseChina: true, Japanese: true, ChineseTaiwan: true, Korean: true,
+}
+
+// IsValid - Checks whether Discord supports the Locale
+func (l Locale) IsValid() bool {
+	return locales[l]
+}
+
+// LocalizationMap - translations of a name or description keyed by Locale
+type LocalizationMap map[Locale]string
+
+// Get - Returns the translation for the locale, or fallback when there is none
+func (m LocalizationMap) Get(locale Locale, fallback string) string {
+	if value, ok := m[locale]; ok && value != "" {
+		return value
+	}
+
+	return fallback
+}
+
+// Validate - Checks that every key is a Locale Discord supports and every value is 1 to maxLength characters
+func (m LocalizationMap) Validate(maxLength int) error {
+	for locale, value := range m {
+		if !locale.IsValid() {
+			return fmt.Errorf("%q is not a locale supported by Discord", locale)
+		}
+		if length := utf8.RuneCountInString(value); length < 1 || length > maxLength {
+			return fmt.Errorf("the %s localization must be between 1 and %d characters", locale, maxLength)
+		}
+	}
+
+	return nil
+}
+
 // LocalizationDict - officially supported languages by Discord
+//
+// Deprecated: use LocalizationMap, which is keyed by Locale and can be validated
 type LocalizationDict struct {
 	Danish              string `json:"da,omitempty"`
 	German              string `json:"de,omitempty"`
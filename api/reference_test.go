@@ -0,0 +1,78 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	ts := time.Unix(1618953630, 0)
+	tests := []struct {
+		name  string
+		style TimestampStyle
+		want  string
+	}{
+		{
+			name:  "Default Style",
+			style: "",
+			want:  "<t:1618953630>",
+		},
+		{
+			name:  "Relative Time",
+			style: RelativeTime,
+			want:  "<t:1618953630:R>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatTimestamp(ts, tt.style); got != tt.want {
+				t.Errorf("FormatTimestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "Plain Text",
+			text: quickBrownFox,
+			want: quickBrownFox,
+		},
+		{
+			name: "Emphasis",
+			text: "**bold** _italic_ ~~strike~~",
+			want: `\*\*bold\*\* \_italic\_ \~\~strike\~\~`,
+		},
+		{
+			name: "Code And Spoilers",
+			text: "`code` ||spoiler|| \\",
+			want: "\\`code\\` \\|\\|spoiler\\|\\| \\\\",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeMarkdown(tt.text); got != tt.want {
+				t.Errorf("EscapeMarkdown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
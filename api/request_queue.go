@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RequestPriority - the order requests waiting on the same bucket, or jobs waiting in a RequestQueue, are served in
+type RequestPriority int
+
+//goland:noinspection GoUnusedConst
+const (
+	PriorityLow    RequestPriority = iota // background and bulk work; yields to everything else
+	PriorityNormal                        // the default for REST calls
+	PriorityHigh                          // latency-sensitive calls such as interaction responses
+)
+
+// routePriority - interaction callbacks and followups must be answered within seconds, so they skip the line
+func routePriority(route string) RequestPriority {
+	if strings.Contains(route, "/interactions/") || strings.Contains(route, "/webhooks/"+ApplicationID.String()+"/") {
+		return PriorityHigh
+	}
+
+	return PriorityNormal
+}
+
+// priorityLock - a mutex that hands itself to the highest priority waiter first, and in arrival order within a priority
+type priorityLock struct {
+	mu      sync.Mutex
+	held    bool
+	waiters [PriorityHigh + 1][]chan struct{}
+}
+
+// Lock - acquire the lock at PriorityNormal
+func (l *priorityLock) Lock() {
+	l.lock(PriorityNormal)
+}
+
+func (l *priorityLock) lock(priority RequestPriority) {
+	l.mu.Lock()
+	if !l.held {
+		l.held = true
+		l.mu.Unlock()
+		return
+	}
+
+	ready := make(chan struct{})
+	l.waiters[priority] = append(l.waiters[priority], ready)
+	l.mu.Unlock()
+
+	// Ownership is handed over directly by Unlock
+	<-ready
+}
+
+// Unlock - release the lock, passing it to the next waiter if there is one
+func (l *priorityLock) Unlock() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for priority := PriorityHigh; priority >= PriorityLow; priority-- {
+		if len(l.waiters[priority]) > 0 {
+			next := l.waiters[priority][0]
+			l.waiters[priority] = l.waiters[priority][1:]
+			close(next)
+			return
+		}
+	}
+
+	l.held = false
+}
+
+// RequestQueue - runs queued jobs, typically bulk or batch operations, on a fixed number of workers in priority order
+//
+// Jobs that are not PriorityHigh wait to start while any high priority request is in flight on the RateLimiter, so background work cannot starve interaction responses on shared buckets.
+type RequestQueue struct {
+	limiter *RateLimiter
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	jobs   [PriorityHigh + 1][]func()
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// highPriorityPoll - how often a waiting job re-checks for in-flight high priority requests
+const highPriorityPoll = 50 * time.Millisecond
+
+// NewRequestQueue - creates a RequestQueue bound to the given RateLimiter and starts its workers
+func NewRequestQueue(limiter *RateLimiter, workers int) *RequestQueue {
+	if workers < 1 {
+		workers = 1
+	}
+
+	q := &RequestQueue{limiter: limiter}
+	q.cond = sync.NewCond(&q.mu)
+
+	q.wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go q.work()
+	}
+
+	return q
+}
+
+// Enqueue - schedule a job to run at the given priority; returns false if the queue has been closed
+func (q *RequestQueue) Enqueue(priority RequestPriority, job func()) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return false
+	}
+
+	q.jobs[priority] = append(q.jobs[priority], job)
+	q.cond.Signal()
+
+	return true
+}
+
+// Len - the number of jobs waiting to start
+func (q *RequestQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	total := 0
+	for _, jobs := range q.jobs {
+		total += len(jobs)
+	}
+
+	return total
+}
+
+// Close - stop accepting jobs and wait for the queued ones to finish
+func (q *RequestQueue) Close() {
+	q.mu.Lock()
+	q.closed = true
+	q.cond.Broadcast()
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+func (q *RequestQueue) work() {
+	defer q.wg.Done()
+
+	for {
+		job, priority, ok := q.next()
+		if !ok {
+			return
+		}
+
+		for priority < PriorityHigh && atomic.LoadInt64(&q.limiter.highPending) > 0 {
+			time.Sleep(highPriorityPoll)
+		}
+
+		job()
+	}
+}
+
+// next blocks until a job is available, returning false once the queue is closed and drained
+func (q *RequestQueue) next() (func(), RequestPriority, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for {
+		for priority := PriorityHigh; priority >= PriorityLow; priority-- {
+			if len(q.jobs[priority]) > 0 {
+				job := q.jobs[priority][0]
+				q.jobs[priority] = q.jobs[priority][1:]
+				return job, priority, true
+			}
+		}
+
+		if q.closed {
+			return nil, 0, false
+		}
+
+		q.cond.Wait()
+	}
+}
@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ResponseCache - caches GET responses for a while, so read-heavy bots do not fetch the same guild, channel or user over and over
+//
+// Add it to a RateLimiter with UseCache, so fresh responses are served before the request waits on its bucket.
+// Responses with an ETag are revalidated with If-None-Match once they expire. Expired responses are removed as new ones are stored.
+// A successful PATCH, PUT, POST or DELETE removes the cached responses for the same path and the paths below it.
+type ResponseCache struct {
+	TTL   time.Duration                // how long a response is served without asking Discord
+	Match func(req *http.Request) bool // which GET requests are cached; guild, channel and user lookups when nil
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+	pruned  time.Time // when expired entries were last removed
+}
+
+type cacheEntry struct {
+	path    string
+	header  http.Header
+	body    []byte
+	etag    string
+	expires time.Time
+}
+
+// defaultCacheable - single guild, channel and user lookups, e.g. /guilds/ID, /channels/ID and /users/@me
+var defaultCacheable = regexp.MustCompile(`/(guilds|channels|users)/[^/]+$`)
+
+// NewResponseCache - Creates a ResponseCache that serves responses for the given TTL
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	return &ResponseCache{TTL: ttl, entries: make(map[string]*cacheEntry)}
+}
+
+// UseCache - Serves GET requests from the cache before they wait on a bucket, and adds the cache's Middleware to fill it
+//
+// Fresh responses are returned without locking the bucket, so they neither wait for nor use up requests.
+func (r *RateLimiter) UseCache(cache *ResponseCache) {
+	r.cache.Store(cache)
+	r.Use(cache.Middleware())
+}
+
+// cached returns a fresh cached response for the request, if the RateLimiter has a cache
+func (r *RateLimiter) cached(method, route string) (*http.Response, bool) {
+	cache := r.cache.Load()
+	if cache == nil || method != http.MethodGet {
+		return nil, false
+	}
+
+	req, err := http.NewRequest(method, route, nil)
+	if err != nil {
+		return nil, false
+	}
+
+	return cache.lookup(req)
+}
+
+// Middleware - Returns the Middleware that fills the cache and revalidates expired responses
+//
+// Added with Use rather than UseCache, fresh responses are only served once the bucket is free, and each one uses up a request.
+func (c *ResponseCache) Middleware() Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				resp, err := next(req)
+				if err == nil && resp.StatusCode < http.StatusMultipleChoices {
+					c.invalidate(req.URL.Path)
+				}
+				return resp, err
+			}
+
+			if !c.matches(req) {
+				return next(req)
+			}
+
+			return c.get(req, next)
+		}
+	}
+}
+
+func (c *ResponseCache) matches(req *http.Request) bool {
+	if c.Match != nil {
+		return c.Match(req)
+	}
+
+	return defaultCacheable.MatchString(req.URL.Path)
+}
+
+// lookup returns a fresh cached response for the request without sending it
+func (c *ResponseCache) lookup(req *http.Request) (*http.Response, bool) {
+	if !c.matches(req) {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	entry, ok := c.entries[req.URL.RequestURI()]
+	c.mu.Unlock()
+
+	if !ok || !time.Now().Before(entry.expires) {
+		return nil, false
+	}
+
+	return entry.response(req, nil), true
+}
+
+// get serves the request from the cache or sends it; entries are keyed without the host, so middleware that redirects requests does not split the cache
+func (c *ResponseCache) get(req *http.Request, next RoundTripFunc) (*http.Response, error) {
+	key := req.URL.RequestURI()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+
+	if ok && time.Now().Before(entry.expires) {
+		return entry.response(req, nil), nil
+	}
+	if ok && entry.etag != "" {
+		req.Header.Set("If-None-Match", entry.etag)
+	}
+
+	resp, err := next(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ok && resp.StatusCode == http.StatusNotModified:
+		_ = resp.Body.Close()
+		c.store(key, entry.path, entry.header, entry.body)
+		return entry.response(req, resp.Header), nil
+	case resp.StatusCode == http.StatusOK:
+		body, err := io.ReadAll(resp.Body)
+		_ = resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, req.URL.Path, resp.Header, body)
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	return resp, nil
+}
+
+func (c *ResponseCache) store(key, path string, header http.Header, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+	}
+	c.prune(time.Now())
+	c.entries[key] = &cacheEntry{
+		path:    path,
+		header:  withoutRateLimitHeaders(header),
+		body:    body,
+		etag:    header.Get("ETag"),
+		expires: time.Now().Add(c.TTL),
+	}
+}
+
+// prune - removes entries that can no longer be served, at most once per TTL; the caller holds c.mu
+//
+// An entry with an ETag is kept for one more TTL after it expires, so a lookup soon after can still be revalidated.
+func (c *ResponseCache) prune(now time.Time) {
+	if now.Sub(c.pruned) < c.TTL {
+		return
+	}
+	c.pruned = now
+
+	for key, entry := range c.entries {
+		expires := entry.expires
+		if entry.etag != "" {
+			expires = expires.Add(c.TTL)
+		}
+		if now.After(expires) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// response builds a 200 response from the entry; live headers, e.g. from a 304, replace the cached rate limit headers
+func (e *cacheEntry) response(req *http.Request, live http.Header) *http.Response {
+	header := e.header.Clone()
+	for name, values := range live {
+		if strings.HasPrefix(name, "X-Ratelimit-") {
+			header[name] = values
+		}
+	}
+
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+func withoutRateLimitHeaders(header http.Header) http.Header {
+	clean := make(http.Header, len(header))
+	for name, values := range header {
+		if !strings.HasPrefix(name, "X-Ratelimit-") {
+			clean[name] = values
+		}
+	}
+
+	return clean
+}
+
+// invalidate removes the entries whose path contains the given path as whole segments, e.g. /guilds/1 matches /api/v10/guilds/1/roles
+func (c *ResponseCache) invalidate(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if i := strings.Index(entry.path, path); i >= 0 {
+			if rest := entry.path[i+len(path):]; rest == "" || rest[0] == '/' {
+				delete(c.entries, key)
+			}
+		}
+	}
+}
+
+// InvalidateGuild - Removes the cached responses for the guild, e.g. when a GuildUpdate event arrives
+func (c *ResponseCache) InvalidateGuild(guildID Snowflake) {
+	c.invalidate("/guilds/" + guildID.String())
+}
+
+// InvalidateChannel - Removes the cached responses for the channel, e.g. when a ChannelUpdate event arrives
+func (c *ResponseCache) InvalidateChannel(channelID Snowflake) {
+	c.invalidate("/channels/" + channelID.String())
+}
+
+// InvalidateUser - Removes the cached responses for the user; use "@me" for the current user
+func (c *ResponseCache) InvalidateUser(userID Snowflake) {
+	c.invalidate("/users/" + userID.String())
+}
+
+// Clear - Removes every cached response
+func (c *ResponseCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*cacheEntry)
+}
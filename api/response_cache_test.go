@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestResponseCache(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			hits++
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = io.WriteString(w, strconv.Itoa(hits))
+	}))
+	defer server.Close()
+
+	cache := NewResponseCache(time.Minute)
+	limiter := NewRatelimiter()
+	limiter.UseCache(cache)
+
+	get := func(path string) string {
+		t.Helper()
+		resp, err := limiter.Request(http.MethodGet, server.URL+path, nil, nil)
+		if err != nil {
+			t.Fatalf("Request(%s) error = %v", path, err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	if a, b := get("/api/v10/guilds/1"), get("/api/v10/guilds/1"); a != "1" || b != "1" || hits != 1 {
+		t.Errorf("cached GET = %q then %q after %d hits, want 1 hit", a, b, hits)
+	}
+	if get("/api/v10/guilds/1/members/2"); hits != 2 {
+		t.Errorf("uncached GET hits = %d, want 2", hits)
+	}
+
+	resp, err := limiter.Request(http.MethodPatch, server.URL+"/api/v10/guilds/1", nil, nil)
+	if err != nil {
+		t.Fatalf("Request(PATCH) error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if got := get("/api/v10/guilds/1"); got != "3" {
+		t.Errorf("GET after PATCH = %q, want a fresh response", got)
+	}
+
+	cache.TTL = 0
+	get("/api/v10/channels/5")
+	if got := get("/api/v10/channels/5"); got != "4" || hits != 5 {
+		t.Errorf("revalidated GET = %q after %d hits, want the cached body after a 304", got, hits)
+	}
+}
+
+func TestResponseCacheHitsKeepBucket(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("X-RateLimit-Limit", "5")
+		w.Header().Set("X-RateLimit-Remaining", "4")
+		w.Header().Set("X-RateLimit-Reset-After", "60")
+		_, _ = io.WriteString(w, "{}")
+	}))
+	defer server.Close()
+
+	limiter := NewRatelimiter()
+	limiter.UseCache(NewResponseCache(time.Minute))
+
+	route := server.URL + "/api/v10/users/@me"
+	for i := 0; i < 5; i++ {
+		resp, err := limiter.Request(http.MethodGet, route, nil, nil)
+		if err != nil {
+			t.Fatalf("Request() error = %v", err)
+		}
+		_ = resp.Body.Close()
+	}
+
+	if hits != 1 {
+		t.Errorf("server hits = %d, want 1", hits)
+	}
+	if state, ok := limiter.Bucket(route); !ok || state.Remaining != 4 {
+		t.Errorf("Bucket() = %+v, %v, want 4 remaining after 4 cache hits", state, ok)
+	}
+}
+
+func TestResponseCachePrunesExpiredEntries(t *testing.T) {
+	cache := NewResponseCache(time.Minute)
+	tagged := http.Header{}
+	tagged.Set("ETag", `"v2"`)
+
+	cache.store("/users/1", "/users/1", http.Header{}, []byte("1"))
+	cache.store("/users/2", "/users/2", tagged, []byte("2"))
+	for _, entry := range cache.entries {
+		entry.expires = time.Now().Add(-time.Second)
+	}
+
+	cache.pruned = time.Time{}
+	cache.store("/users/3", "/users/3", http.Header{}, []byte("3"))
+
+	for key, want := range map[string]bool{"/users/1": false, "/users/2": true, "/users/3": true} {
+		if _, ok := cache.entries[key]; ok != want {
+			t.Errorf("entry %s kept = %v, want %v", key, ok, want)
+		}
+	}
+}
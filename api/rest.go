@@ -22,6 +22,8 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -37,72 +39,182 @@ func init() {
 }
 
 // Request - send an HTTP request with rate limiting
+//
+// Interaction responses are sent with PriorityHigh; everything else with PriorityNormal.
 func (r *RateLimiter) Request(method, route string, data any, reason *string) (*http.Response, error) {
-	return r.requestWithBucketID(method, route, strings.SplitN(route, "?", 2)[0], data, reason)
+	return r.RequestWithPriority(method, route, data, reason, routePriority(route))
 }
 
-func (r *RateLimiter) requestWithBucketID(method, route, bucketID string, data any, reason *string) (*http.Response,
+// RequestWithPriority - send an HTTP request with rate limiting, queueing ahead of or behind other requests waiting on the same bucket
+func (r *RateLimiter) RequestWithPriority(method, route string, data any, reason *string, priority RequestPriority) (*http.Response, error) {
+	return r.requestWithBucketID(method, route, strings.SplitN(route, "?", 2)[0], data, reason, priority)
+}
+
+func (r *RateLimiter) requestWithBucketID(method, route, bucketID string, data any, reason *string, priority RequestPriority) (*http.Response,
 	error) {
-	return r.request(method, route, "application/json", bucketID, data, 0, reason)
+	return r.request(method, route, "application/json", bucketID, data, 0, reason, priority)
 }
 
 func (r *RateLimiter) request(method, route, contentType, bucketID string,
 	b any,
 	sequence int,
-	reason *string) (*http.Response, error) {
+	reason *string,
+	priority RequestPriority) (*http.Response, error) {
 	if bucketID == "" {
 		bucketID = strings.SplitN(route, "?", 2)[0]
 	}
 
-	return r.lockedRequest(method, route, contentType, b, r.lockBucket(bucketID), sequence, reason)
+	if resp, ok := r.cached(method, route); ok {
+		return resp, nil
+	}
+
+	atomic.AddInt64(&r.pending, 1)
+	defer atomic.AddInt64(&r.pending, -1)
+
+	if priority == PriorityHigh {
+		atomic.AddInt64(&r.highPending, 1)
+		defer atomic.AddInt64(&r.highPending, -1)
+	}
+
+	defer r.waitEmojiGate(method, route)()
+
+	return r.lockedRequest(method, route, contentType, b, r.lockBucket(bucketID, priority), sequence, reason, priority)
 }
 
-func processBody(b any, bucket *bucket) (*bytes.Buffer, error) {
-	var buffer bytes.Buffer
-	if b != nil {
-		encoder := json.NewEncoder(&buffer)
-		encoder.SetEscapeHTML(false)
-		err := encoder.Encode(&b)
+// maxReasonLength - Discord accepts 1-512 characters in the X-Audit-Log-Reason header
+const maxReasonLength = 512
+
+// WithReason - Returns a reason suitable for the `reason *string` parameter of any mutating endpoint.
+//
+// An empty reason returns nil, so no X-Audit-Log-Reason header is sent.
+func WithReason(reason string) *string {
+	if reason == "" {
+		return nil
+	}
+
+	return &reason
+}
+
+// encodeReason - truncates the reason to the characters Discord will accept and URL-encodes it, as header values must be ASCII
+func encodeReason(reason string) string {
+	if runes := []rune(reason); len(runes) > maxReasonLength {
+		reason = string(runes[:maxReasonLength])
+	}
+
+	return url.PathEscape(reason)
+}
+
+// SetBearerToken - Sends every request made through the RateLimiter with the OAuth2 access token instead of the bot Token
+//
+// Bearer tokens are rate limited per token, so give each user their own RateLimiter, e.g. through NewBearerClient. An empty token reverts to the bot Token.
+func (r *RateLimiter) SetBearerToken(token string) {
+	if token == "" {
+		r.bearerToken.Store(nil)
+		return
+	}
+
+	r.bearerToken.Store(&token)
+}
+
+// authorization - the Authorization header for requests made through the RateLimiter
+func (r *RateLimiter) authorization() string {
+	if token := r.bearerToken.Load(); token != nil {
+		return "Bearer " + *token
+	}
+
+	return fmt.Sprintf("Bot %s", Token)
+}
+
+// bodyEncoder - a buffer and the encoder writing to it, reused across requests so encoding a body does not grow a new buffer each time
+type bodyEncoder struct {
+	buffer  bytes.Buffer
+	encoder *json.Encoder
+}
+
+// maxPooledBody - buffers that grew past this, e.g. for a large bulk overwrite, are dropped rather than kept in the pool
+const maxPooledBody = 64 << 10
+
+var bodyEncoders = sync.Pool{
+	New: func() any {
+		e := &bodyEncoder{}
+		e.encoder = json.NewEncoder(&e.buffer)
+		e.encoder.SetEscapeHTML(false)
+		return e
+	},
+}
+
+// processBody encodes the request body; the result is copied out of the pooled buffer, as the transport may read it after the request returns
+func processBody(b any, bucket *bucket) ([]byte, error) {
+	if raw, ok := b.(*rawBody); ok {
+		return raw.data, nil
+	}
+	if b == nil {
+		return nil, nil
+	}
+
+	if codec.Load() != nil {
+		data, err := JSONMarshal(b)
 		if err != nil {
 			_ = bucket.release(nil)
 			return nil, err
 		}
+		return data, nil
+	}
+
+	e := bodyEncoders.Get().(*bodyEncoder)
+	defer func() {
+		if e.buffer.Cap() <= maxPooledBody {
+			e.buffer.Reset()
+			bodyEncoders.Put(e)
+		}
+	}()
+
+	if err := e.encoder.Encode(b); err != nil {
+		_ = bucket.release(nil)
+		return nil, err
 	}
 
-	return &buffer, nil
+	return append([]byte(nil), e.buffer.Bytes()...), nil
 }
 
 func (r *RateLimiter) lockedRequest(method, route, contentType string,
 	b any,
 	bucket *bucket,
 	sequence int,
-	reason *string) (*http.Response, error) {
+	reason *string,
+	priority RequestPriority) (*http.Response, error) {
 
-	buffer, err := processBody(b, bucket)
+	if r.circuitOpen() {
+		_ = bucket.release(nil)
+		return nil, ErrInvalidRequestLimit
+	}
+
+	body, err := processBody(r.applyDefaultAllowedMentions(b), bucket)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(method, route, bytes.NewReader(buffer.Bytes()))
+	req, err := http.NewRequest(method, route, bytes.NewReader(body))
 	if err != nil {
 		_ = bucket.release(nil)
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", fmt.Sprintf("Bot %s", Token))
+	req.Header.Set("Authorization", r.authorization())
 
-	if b != nil {
+	if raw, ok := b.(*rawBody); ok {
+		req.Header.Set("Content-Type", raw.contentType)
+	} else if b != nil {
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	if reason != nil {
-		req.Header.Set("X-Audit-Log-Reason", *reason)
+	if reason != nil && *reason != "" {
+		req.Header.Set("X-Audit-Log-Reason", encodeReason(*reason))
 	}
 
 	req.Header.Set("User-Agent", UserAgent)
 
-	client := http.Client{}
-	resp, err := client.Do(req)
+	resp, err := r.roundTrip(req)
 
 	if err != nil {
 		_ = bucket.release(nil)
@@ -114,6 +226,10 @@ func (r *RateLimiter) lockedRequest(method, route, contentType string,
 		return nil, err
 	}
 
+	if isInvalidRequest(resp) {
+		r.invalidRequests.add(time.Now())
+	}
+
 	switch resp.StatusCode {
 	case http.StatusTooManyRequests:
 		log.Warnln(log.FuncName(), "Rate Limited!")
@@ -122,13 +238,20 @@ func (r *RateLimiter) lockedRequest(method, route, contentType string,
 
 		var rlr rateLimitResponse
 		err = json.NewDecoder(resp.Body).Decode(&rlr)
+		_ = resp.Body.Close()
 		if err != nil {
 			return nil, err
 		}
 
-		time.Sleep(time.Duration(rlr.RetryAfter * float64(time.Second)))
+		wait := time.Duration(rlr.RetryAfter * float64(time.Second))
+		if rlr.Global || resp.Header.Get("X-RateLimit-Global") != "" {
+			// Hold every bucket, not only this one, until the global limit lifts
+			r.setGlobalReset(time.Now().Add(wait))
+		}
+		r.notifyDelay(RateLimitDelay{Bucket: bucket.snapshot(), Wait: wait, Global: rlr.Global, Exceeded: true})
+		time.Sleep(wait)
 
-		return r.lockedRequest(method, route, contentType, b, r.lockBucketObject(bucket), sequence, reason)
+		return r.lockedRequest(method, route, contentType, b, r.lockBucketObject(bucket, priority), sequence, reason, priority)
 	}
 
 	return resp, nil
@@ -163,6 +286,26 @@ func fireGetRequest(u *url.URL, data any, reason *string) ([]byte, error) {
 	return b, nil
 }
 
+// fire - sends the request through the RateLimiter and reads the response; the package-level fire helpers always use Rest
+func (r *RateLimiter) fire(method string, u *url.URL, data any, reason *string) ([]byte, error) {
+	resp, err := r.Request(method, u.String(), data, reason)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return []byte{}, err // we return an empty byte slice here to avoid nil pointer problems
+	}
+
+	return b, nil
+}
+
 func firePostRequest(u *url.URL, data any, reason *string) ([]byte, error) {
 	resp, err := Rest.Request(http.MethodPost, u.String(), data, reason)
 	if err != nil {
@@ -234,3 +377,23 @@ func fireDeleteRequest(u *url.URL, reason *string) error {
 
 	return nil
 }
+
+// fireDeleteRequestWithResponse - for the few DELETE endpoints that return the deleted object instead of a 204
+func fireDeleteRequestWithResponse(u *url.URL, reason *string) ([]byte, error) {
+	resp, err := Rest.Request(http.MethodDelete, u.String(), nil, reason)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Errorln(log.FuncName(), err)
+		return []byte{}, err // we return an empty byte slice here to avoid nil pointer problems
+	}
+
+	return b, nil
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubRest - Points Rest at the handler until the test ends; each request is answered with the status and body it returns
+//
+// discordtest imports this package, so tests inside it stub the transport here instead.
+func stubRest(t testing.TB, handler func(req *http.Request) (int, string)) {
+	t.Helper()
+
+	previous := Rest
+	Rest = NewRatelimiter()
+	Rest.Use(func(RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			status, body := handler(req)
+			return &http.Response{StatusCode: status, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(body))}, nil
+		}
+	})
+	t.Cleanup(func() { Rest = previous })
+}
+
+func BenchmarkProcessBody(b *testing.B) {
+	payload := &CreateMessageJSON{Content: "The quick brown fox jumps over the lazy dog, again and again and again."}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := processBody(payload, nil); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -74,6 +74,9 @@ const (
 	listPublicArchivedThreads                      = "%s/channels/%s/threads/archived/public"
 	triggerTypingIndicator                         = "%s/channels/%s/typing"
 	listJoinedPrivateArchivedThreads               = "%s/channels/%s/users/@me/threads/archived/private"
+	setVoiceChannelStatus                          = "%s/channels/%s/voice-status"
+	listDirectoryEntries                           = "%s/channels/%s/directory-entries"
+	searchDirectoryEntries                         = "%s/channels/%s/directory-entries/search"
 	listGuildEmojis                                = "%s/guilds/%s/emojis"
 	createGuildEmoji                               = listGuildEmojis
 	getGuildEmoji                                  = "%s/guilds/%s/emojis/%s"
@@ -132,9 +135,14 @@ const (
 	getGuildWelcomeScreen                          = "%s/guilds/%s/welcome-screen"
 	modifyGuildWelcomeScreen                       = getGuildWelcomeScreen
 	getGuildOnboarding                             = "%s/guilds/%s/onboarding"
+	getGuildMemberVerification                     = "%s/guilds/%s/member-verification"
+	modifyGuildMemberVerification                  = getGuildMemberVerification
+	listGuildJoinRequests                          = "%s/guilds/%s/requests"
+	actionGuildJoinRequest                         = "%s/guilds/%s/requests/id/%s"
 	getGuildWidgetSettings                         = "%s/guilds/%s/widget"
 	modifyGuildWidget                              = getGuildWidgetSettings
 	getGuildWidget                                 = "%s/guilds/%s/widget.json"
+	getGuildWidgetImage                            = "%s/guilds/%s/widget.png"
 	deleteInvite                                   = "%s/invites/%s"
 	getInvite                                      = "%s/invites/%s"
 	listGuildStickers                              = "%s/guilds/%s/stickers"
@@ -147,6 +155,8 @@ const (
 	getAvatarUrlGif                                = "avatars/%s/%s.gif"
 	getAvatarUrlPng                                = "avatars/%s/%s.png"
 	getDefaultUserAvatarUrl                        = "embed/avatars/%s.png"
+	getAvatarDecorationUrl                         = "avatar-decoration-presets/%s.png"
+	getGuildTagBadgeUrl                            = "guild-tag-badges/%s/%s.png"
 	getCurrentUser                                 = "%s/users/@me"
 	modifyCurrentUser                              = getCurrentUser
 	createDM                                       = "%s/users/@me/channels"
@@ -190,4 +200,6 @@ const (
 	createAutoModerationRule                       = listAutoModerationRulesForGuild
 	modifyAutoModerationRule                       = getAutoModerationRule
 	deleteAutoModerationRule                       = getAutoModerationRule
+
+	refreshAttachmentURLs = "%s/attachments/refresh-urls"
 )
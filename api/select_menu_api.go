@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// NewSelectOption - Build a new SelectOption
+func NewSelectOption(label, value string) *SelectOption {
+	return &SelectOption{Label: label, Value: value}
+}
+
+// SetDescription - sets the Description shown under the option's label
+func (o *SelectOption) SetDescription(d string) *SelectOption {
+	o.Description = d
+
+	return o
+}
+
+// SetEmoji - sets the Emoji shown beside the option
+func (o *SelectOption) SetEmoji(e *Emoji) *SelectOption {
+	o.Emoji = e
+
+	return o
+}
+
+// SetDefault - sets whether the option is selected by default
+func (o *SelectOption) SetDefault(d bool) *SelectOption {
+	o.Default = d
+
+	return o
+}
+
+// NewSelectMenu - Build a new string select menu
+func NewSelectMenu(customID string) *Component {
+	return &Component{Type: ComponentTypeSelectMenu, CustomID: customID}
+}
+
+// SetPlaceholder - sets the Placeholder shown when nothing is selected
+func (c *Component) SetPlaceholder(p string) *Component {
+	c.Placeholder = p
+
+	return c
+}
+
+// SetMinValues - sets the fewest options that must be chosen
+func (c *Component) SetMinValues(n int) *Component {
+	c.MinValues = n
+
+	return c
+}
+
+// SetMaxValues - sets the most options that can be chosen
+func (c *Component) SetMaxValues(n int) *Component {
+	c.MaxValues = n
+
+	return c
+}
+
+// AddOption - adds an option to the select menu
+func (c *Component) AddOption(o *SelectOption) *Component {
+	c.Options = append(c.Options, o)
+
+	return c
+}
+
+// AddOptions - adds multiple options to the select menu
+func (c *Component) AddOptions(o []*SelectOption) *Component {
+	c.Options = append(c.Options, o...)
+
+	return c
+}
+
+// ChunkSelectMenus - Splits the options across as many select menus as needed, each in its own action row
+//
+// The menus are copies of template with the options set and ":n" appended to its CustomID, so a handler can tell them apart.
+// Returns a LimitError when the options need more than ActionRowCount menus; paginate them with PaginateSelectMenu instead.
+//
+//goland:noinspection GoUnusedExportedFunction
+func ChunkSelectMenus(template *Component, options []*SelectOption) ([]*Component, error) {
+	if menus := (len(options) + SelectOptionCount - 1) / SelectOptionCount; menus > ActionRowCount {
+		return nil, &LimitError{Field: "select menus", Length: menus, Limit: ActionRowCount}
+	}
+
+	var rows []*Component
+	for n := 0; n*SelectOptionCount < len(options); n++ {
+		page := options[n*SelectOptionCount : min((n+1)*SelectOptionCount, len(options))]
+		rows = append(rows, selectMenuRow(template, fmt.Sprintf("%s:%d", template.CustomID, n), page))
+	}
+
+	return rows, nil
+}
+
+// PaginateSelectMenu - Returns two action rows: a copy of template holding the given page of options, and Previous and Next buttons to change page
+//
+// The buttons' custom IDs are the template's CustomID followed by ":page:" and the page they lead to; read it back with SelectMenuPage.
+// page is clamped to the pages available.
+//
+//goland:noinspection GoUnusedExportedFunction
+func PaginateSelectMenu(template *Component, options []*SelectOption, page int) []*Component {
+	pages := max((len(options)+SelectOptionCount-1)/SelectOptionCount, 1)
+	page = max(min(page, pages-1), 0)
+
+	start := page * SelectOptionCount
+	menu := selectMenuRow(template, template.CustomID, options[start:min(start+SelectOptionCount, len(options))])
+
+	button := func(label string, target int, disabled bool) *Component {
+		return &Component{
+			Type:     ComponentTypeButton,
+			Style:    ButtonSecondary,
+			Label:    label,
+			CustomID: template.CustomID + ":page:" + strconv.Itoa(target),
+			Disabled: disabled,
+		}
+	}
+	indicator := &Component{
+		Type:     ComponentTypeButton,
+		Style:    ButtonSecondary,
+		Label:    fmt.Sprintf("%d/%d", page+1, pages),
+		CustomID: template.CustomID + ":page",
+		Disabled: true,
+	}
+
+	nav := &Component{Type: ComponentTypeActionRow, Components: []*Component{
+		button("Previous", page-1, page == 0),
+		indicator,
+		button("Next", page+1, page == pages-1),
+	}}
+
+	return []*Component{menu, nav}
+}
+
+// SelectMenuPage - Returns the page a PaginateSelectMenu button leads to, and whether the custom ID is one of its buttons
+//
+//goland:noinspection GoUnusedExportedFunction
+func SelectMenuPage(menuCustomID, customID string) (int, bool) {
+	rest, ok := strings.CutPrefix(customID, menuCustomID+":page:")
+	if !ok {
+		return 0, false
+	}
+
+	page, err := strconv.Atoi(rest)
+	if err != nil || page < 0 {
+		return 0, false
+	}
+
+	return page, true
+}
+
+// selectMenuRow wraps a copy of the template, holding the options, in an action row; MaxValues is capped at the number of options
+func selectMenuRow(template *Component, customID string, options []*SelectOption) *Component {
+	menu := *template
+	menu.CustomID = customID
+	menu.Options = options
+	if menu.MaxValues > len(options) {
+		menu.MaxValues = len(options)
+	}
+	if menu.MinValues > len(options) {
+		menu.MinValues = len(options)
+	}
+
+	return &Component{Type: ComponentTypeActionRow, Components: []*Component{&menu}}
+}
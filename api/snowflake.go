@@ -15,6 +15,7 @@
 package api
 
 import (
+	"fmt"
 	"strconv"
 	"time"
 )
@@ -43,15 +44,29 @@ func (s Snowflake) String() string {
 	return string(s)
 }
 
-// ToBinary - Type converts a Snowflake into its binary representation
+// ToBinary - Type converts a Snowflake into its 64-bit binary representation
 func (s Snowflake) ToBinary() string {
-	var b []byte
+	id, _ := strconv.ParseUint(string(s), 10, 64)
 
-	for _, c := range s {
-		b = strconv.AppendInt(b, int64(c), 2)
+	return fmt.Sprintf("%064b", id)
+}
+
+// Compare - Orders snowflakes numerically, and so by creation time, returning -1, 0 or 1
+//
+// IDs are compared without parsing them, as a longer ID is always the larger.
+func (s Snowflake) Compare(other Snowflake) int {
+	switch {
+	case len(s) < len(other):
+		return -1
+	case len(s) > len(other):
+		return 1
+	case s < other:
+		return -1
+	case s > other:
+		return 1
 	}
 
-	return string(b)
+	return 0
 }
 
 // StringToSnowflake - Type converts a string into a Snowflake
@@ -61,17 +76,16 @@ func StringToSnowflake(s string) *Snowflake {
 }
 
 // ParseSnowflake - Breaks down a Snowflake and assigns each value to the FormattedSnowflake struct
+//
+// Timestamp is in milliseconds since the Unix epoch.
 func (s Snowflake) ParseSnowflake() FormattedSnowflake {
-	bin := s.ToBinary()
-	tStamp, _ := strconv.ParseInt(bin[0:42], 2, 64)
-	worker, _ := strconv.ParseInt(bin[42:47], 2, 64)
-	process, _ := strconv.ParseInt(bin[47:52], 2, 64)
-	incr, _ := strconv.ParseInt(bin[52:64], 2, 64)
+	id, _ := strconv.ParseUint(string(s), 10, 64)
+
 	return FormattedSnowflake{
-		Timestamp:         tStamp + discordEpoch,
-		InternalWorkerID:  worker,
-		InternalProcessID: process,
-		Increment:         incr,
+		Timestamp:         int64(id>>22) + discordEpoch,
+		InternalWorkerID:  int64(id >> 17 & 0x1F),
+		InternalProcessID: int64(id >> 12 & 0x1F),
+		Increment:         int64(id & 0xFFF),
 	}
 }
 
@@ -79,5 +93,5 @@ func (s Snowflake) ParseSnowflake() FormattedSnowflake {
 //
 // Useful for determining when the object belonging to the Snowflake was created
 func (s Snowflake) Timestamp() time.Time {
-	return time.Unix(0, s.ParseSnowflake().Timestamp)
+	return time.UnixMilli(s.ParseSnowflake().Timestamp)
 }
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnowflakeCompare(t *testing.T) {
+	tests := []struct {
+		a, b Snowflake
+		want int
+	}{
+		{a: "99", b: "100", want: -1},
+		{a: "1000", b: "999", want: 1},
+		{a: "123", b: "123", want: 0},
+		{a: "124", b: "123", want: 1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%q.Compare(%q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestSnowflakeTimestamp(t *testing.T) {
+	// The example from Discord's reference documentation
+	id := Snowflake("175928847299117063")
+
+	parsed := id.ParseSnowflake()
+	if parsed.Timestamp != 1462015105796 || parsed.InternalWorkerID != 1 || parsed.InternalProcessID != 0 || parsed.Increment != 7 {
+		t.Errorf("ParseSnowflake() = %+v, want timestamp 1462015105796, worker 1, process 0, increment 7", parsed)
+	}
+	if got := id.Timestamp().UTC().Format(time.RFC3339Nano); got != "2016-04-30T11:18:25.796Z" {
+		t.Errorf("Timestamp() = %s, want 2016-04-30T11:18:25.796Z", got)
+	}
+	if got := id.ToBinary(); len(got) != 64 || got[:22] != "0000001001110001000001" {
+		t.Errorf("ToBinary() = %s, want 64 zero-padded bits", got)
+	}
+}
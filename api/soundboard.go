@@ -0,0 +1,29 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+// SoundboardSound - A sound that can be played in voice channels through the soundboard
+//
+// Default sounds have no GuildID; guild sounds carry the GuildID and, with ManageGuildExpressions, the User that uploaded them.
+type SoundboardSound struct {
+	Name      string     `json:"name"`               // the name of this sound
+	SoundID   Snowflake  `json:"sound_id"`           // the id of this sound
+	Volume    float64    `json:"volume"`             // the volume of this sound, from 0 to 1
+	EmojiID   *Snowflake `json:"emoji_id"`           // the id of this sound's custom emoji
+	EmojiName *string    `json:"emoji_name"`         // the unicode character of this sound's standard emoji
+	GuildID   Snowflake  `json:"guild_id,omitempty"` // the id of the guild this sound is in
+	Available bool       `json:"available"`          // whether this sound can be used, may be false due to loss of Server Boosts
+	User      *User      `json:"user,omitempty"`     // the user who created this sound
+}
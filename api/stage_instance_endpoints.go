@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -38,7 +37,7 @@ func CreateStageInstance(payload CreateStageInstanceJSON, reason *string) (*Stag
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &stageInstance)
+	err = decodeResponse(u, responseBytes, &stageInstance)
 
 	return stageInstance, err
 }
@@ -62,7 +61,7 @@ func (s *StageInstance) GetStageInstance() (*StageInstance, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &stageInstance)
+	err = decodeResponse(u, responseBytes, &stageInstance)
 
 	return stageInstance, err
 }
@@ -82,7 +81,7 @@ func (s *StageInstance) ModifyStageInstance(payload ModifyStageInstanceJSON, rea
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &stageInstance)
+	err = decodeResponse(u, responseBytes, &stageInstance)
 
 	return stageInstance, err
 }
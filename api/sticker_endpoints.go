@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -32,7 +31,7 @@ func (s *Sticker) GetSticker() (*Sticker, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &sticker)
+	err = decodeResponse(u, responseBytes, &sticker)
 
 	return sticker, err
 }
@@ -50,7 +49,7 @@ func ListStickerPacks() (*ListStickerPacksResponse, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &listStickerPacksResponse)
+	err = decodeResponse(u, responseBytes, &listStickerPacksResponse)
 
 	return listStickerPacksResponse, err
 }
@@ -73,7 +72,7 @@ func (g *Guild) ListGuildStickers() ([]*Sticker, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &stickers)
+	err = decodeResponse(u, responseBytes, &stickers)
 
 	return stickers, err
 }
@@ -91,7 +90,7 @@ func (g *Guild) GetGuildSticker(stickerID Snowflake) (*Sticker, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &sticker)
+	err = decodeResponse(u, responseBytes, &sticker)
 
 	return sticker, err
 }
@@ -112,17 +111,17 @@ func (g *Guild) GetGuildSticker(stickerID Snowflake) (*Sticker, error) {
 //
 // Uploaded stickers are constrained to 5 seconds in length for animated stickers, and 320 x 320 pixels.
 // TODO: FormData fields
-func (g *Guild) CreateGuildSticker() (*Sticker, error) {
+func (g *Guild) CreateGuildSticker(reason *string) (*Sticker, error) {
 	u := parseRoute(fmt.Sprintf(createGuildSticker, api, g.ID.String()))
 
 	var sticker *Sticker
-	responseBytes, err := firePostRequest(u, nil, nil)
+	responseBytes, err := firePostRequest(u, nil, reason)
 	if err != nil {
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &sticker)
+	err = decodeResponse(u, responseBytes, &sticker)
 
 	return sticker, err
 }
@@ -153,7 +152,7 @@ func (g *Guild) ModifyGuildSticker(stickerID Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &sticker)
+	err = decodeResponse(u, responseBytes, &sticker)
 
 	return sticker, err
 }
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+
+	utils "github.com/veteran-software/discord-api-wrapper/v10/utilities"
+)
+
+// ErrInvalidAutoArchiveDuration - returned when an auto archive duration is not one Discord accepts
+var ErrInvalidAutoArchiveDuration = errors.New("auto_archive_duration must be one of 60, 1440, 4320, 10080")
+
+// autoArchiveDurations - the inactivity periods, in minutes, after which Discord can archive a thread
+var autoArchiveDurations = []int{60, 1440, 4320, 10080}
+
+// IsValidAutoArchiveDuration - Checks the duration is one of 60, 1440, 4320 or 10080 minutes
+func IsValidAutoArchiveDuration(minutes int) bool {
+	return utils.Contains(autoArchiveDurations, minutes)
+}
+
+// Archive - Archives the thread; it is unarchived again when someone sends a message unless it is also locked
+func (c *Channel) Archive(reason *string) (*Channel, error) {
+	archived := true
+
+	return c.ModifyThread(ModifyThreadJSON{Archived: &archived}, reason)
+}
+
+// Unarchive - Unarchives the thread
+//
+// Requires the ManageThreads permission if the thread is locked; otherwise only SendMessages.
+func (c *Channel) Unarchive(reason *string) (*Channel, error) {
+	archived := false
+
+	return c.ModifyThread(ModifyThreadJSON{Archived: &archived}, reason)
+}
+
+// Lock - Locks the thread so only members with ManageThreads can unarchive it
+//
+// A locked thread that is not archived can still be posted in by members with ManageThreads.
+func (c *Channel) Lock(reason *string) (*Channel, error) {
+	locked := true
+
+	return c.ModifyThread(ModifyThreadJSON{Locked: &locked}, reason)
+}
+
+// Unlock - Unlocks the thread
+func (c *Channel) Unlock(reason *string) (*Channel, error) {
+	locked := false
+
+	return c.ModifyThread(ModifyThreadJSON{Locked: &locked}, reason)
+}
+
+// SetAutoArchiveDuration - Sets how many minutes of inactivity archive the thread; one of 60, 1440, 4320 or 10080
+func (c *Channel) SetAutoArchiveDuration(minutes int, reason *string) (*Channel, error) {
+	if !IsValidAutoArchiveDuration(minutes) {
+		return nil, ErrInvalidAutoArchiveDuration
+	}
+
+	return c.ModifyThread(ModifyThreadJSON{AutoArchiveDuration: &minutes}, reason)
+}
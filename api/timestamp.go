@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"time"
+)
+
+// Timestamp - an ISO8601 timestamp as Discord sends it, e.g. "2017-07-11T17:27:07.299000+00:00"
+//
+// JSON null and "" decode to the zero Timestamp, and the zero Timestamp encodes as null.
+// Fields that are null until something happens, such as Message.EditedTimestamp, are *Timestamp so nil can be told apart from a real time.
+type Timestamp struct {
+	time.Time
+}
+
+// NewTimestamp - Wraps a time.Time, e.g. for Embed.Timestamp
+func NewTimestamp(t time.Time) *Timestamp {
+	return &Timestamp{Time: t}
+}
+
+// MarshalJSON - Encodes the time in RFC 3339 format with fractional seconds, or null when it is zero
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	b := make([]byte, 0, len(time.RFC3339Nano)+2)
+	b = append(b, '"')
+	b = t.UTC().AppendFormat(b, time.RFC3339Nano)
+
+	return append(b, '"'), nil
+}
+
+// localTimestamp - the layout of the few timestamps Discord sends without a zone offset, such as webhook event times; they are UTC
+const localTimestamp = "2006-01-02T15:04:05.999999999"
+
+// UnmarshalJSON - Decodes an ISO8601 timestamp with or without fractional seconds; null and "" leave the Timestamp zero
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) || bytes.Equal(data, []byte(`""`)) {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	err := t.Time.UnmarshalJSON(data)
+	if err == nil {
+		return nil
+	}
+
+	if parsed, localErr := time.Parse(`"`+localTimestamp+`"`, string(data)); localErr == nil {
+		t.Time = parsed
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		want time.Time
+		out  string
+	}{
+		{
+			name: "Fractional with offset",
+			json: `"2017-07-11T17:27:07.299000+00:00"`,
+			want: time.Date(2017, 7, 11, 17, 27, 7, 299000000, time.UTC),
+			out:  `"2017-07-11T17:27:07.299Z"`,
+		},
+		{
+			name: "Whole seconds",
+			json: `"2021-04-01T00:00:00+00:00"`,
+			want: time.Date(2021, 4, 1, 0, 0, 0, 0, time.UTC),
+			out:  `"2021-04-01T00:00:00Z"`,
+		},
+		{
+			name: "Without offset",
+			json: `"2024-10-18T14:42:53.064834"`,
+			want: time.Date(2024, 10, 18, 14, 42, 53, 64834000, time.UTC),
+			out:  `"2024-10-18T14:42:53.064834Z"`,
+		},
+		{
+			name: "Null",
+			json: `null`,
+			out:  `null`,
+		},
+		{
+			name: "Empty string",
+			json: `""`,
+			out:  `null`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ts Timestamp
+			if err := json.Unmarshal([]byte(tt.json), &ts); err != nil {
+				t.Fatalf("UnmarshalJSON() error = %v", err)
+			}
+			if !ts.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", ts.Time, tt.want)
+			}
+
+			out, err := json.Marshal(ts)
+			if err != nil {
+				t.Fatalf("MarshalJSON() error = %v", err)
+			}
+			if string(out) != tt.out {
+				t.Errorf("MarshalJSON() = %s, want %s", out, tt.out)
+			}
+		})
+	}
+}
+
+func TestGuildMemberTimestamps(t *testing.T) {
+	var member GuildMember
+	err := json.Unmarshal([]byte(`{"joined_at":"2015-04-26T06:26:56.936000+00:00","premium_since":null,"communication_disabled_until":null}`), &member)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if member.JoinedAt.Year() != 2015 || member.PremiumSince != nil || member.IsTimedOut() {
+		t.Errorf("GuildMember = %+v", member)
+	}
+}
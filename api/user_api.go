@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxGuildsPerPage - GetCurrentUserGuilds returns at most 200 guilds per request
+const maxGuildsPerPage uint64 = 200
+
+// FetchAllGuilds - Returns every Guild the current user is a member of, following GetCurrentUserGuilds page by page
+//
+// Pages are requested in ascending ID order; the loop waits out the route's rate limit between pages and stops early when the context is done.
+//
+//goland:noinspection GoUnusedExportedFunction
+func FetchAllGuilds(ctx context.Context) ([]*Guild, error) {
+	return fetchAllGuilds(ctx, Rest, func(after *Snowflake, limit *uint64) ([]*Guild, error) {
+		return GetCurrentUserGuilds(nil, after, limit)
+	})
+}
+
+func fetchAllGuilds(ctx context.Context, r *RateLimiter, getPage func(after *Snowflake, limit *uint64) ([]*Guild, error)) ([]*Guild, error) {
+	route := fmt.Sprintf(getCurrentUserGuilds, api)
+	limit := maxGuildsPerPage
+
+	var (
+		all   []*Guild
+		after *Snowflake
+	)
+	for {
+		if err := r.waitForBucket(ctx, route); err != nil {
+			return all, err
+		}
+
+		page, err := getPage(after, &limit)
+		if err != nil {
+			return all, err
+		}
+		all = append(all, page...)
+
+		if uint64(len(page)) < limit || page[len(page)-1] == nil {
+			return all, nil
+		}
+		after = &page[len(page)-1].ID
+	}
+}
+
+// badges - the UserFlags shown as badges on a profile, in the order Discord displays them
+var badges = []UserFlags{
+	Staff, Partner, CertifiedModerator, HypeSquad, HouseBravery, HouseBrilliance, HouseBalance,
+	BugHunterLevel1, BugHunterLevel2, ActiveDeveloper, VerifiedDeveloper, PremiumEarlySupporter,
+}
+
+// HasBadge - Checks whether the user's public flags include every bit of the given UserFlags
+func (u *User) HasBadge(flag UserFlags) bool {
+	return u.PublicFlags&flag == flag
+}
+
+// Badges - Returns the badge flags set on the user's public flags, in the order Discord displays them
+func (u *User) Badges() []UserFlags {
+	var set []UserFlags
+	for _, badge := range badges {
+		if u.HasBadge(badge) {
+			set = append(set, badge)
+		}
+	}
+
+	return set
+}
+
+// HasNitro - Checks whether the user has any Nitro subscription
+//
+// PremiumType is only sent for the current user or with an OAuth2 token, so this is false for other users.
+func (u *User) HasNitro() bool {
+	return u.PremiumType != None
+}
+
+// ServerTag - Returns the server tag the user displays, or an empty string when they do not display one
+func (u *User) ServerTag() string {
+	primary := u.PrimaryGuild
+	if primary == nil {
+		primary = u.Clan
+	}
+	if primary == nil || primary.Tag == nil || primary.IdentityEnabled == nil || !*primary.IdentityEnabled {
+		return ""
+	}
+
+	return *primary.Tag
+}
+
+// URL - Returns the image URL of the avatar decoration
+func (a *AvatarDecorationData) URL() string {
+	return ImageBaseURL + fmt.Sprintf(getAvatarDecorationUrl, a.Asset)
+}
+
+// BadgeURL - Returns the image URL of the server tag badge, or an empty string when there is no badge
+func (p *PrimaryGuild) BadgeURL() string {
+	if p.IdentityGuildID == nil || p.Badge == nil {
+		return ""
+	}
+
+	return ImageBaseURL + fmt.Sprintf(getGuildTagBadgeUrl, p.IdentityGuildID.String(), *p.Badge)
+}
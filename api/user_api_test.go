@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFetchAllGuilds(t *testing.T) {
+	var queries []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		queries = append(queries, req.URL.RawQuery)
+
+		after, _ := strconv.Atoi(req.URL.Query().Get("after"))
+		var page []*Guild
+		for id := after + 1; id <= min(after+int(maxGuildsPerPage), 450); id++ {
+			page = append(page, &Guild{ID: Snowflake(strconv.Itoa(id))})
+		}
+
+		data, _ := json.Marshal(page)
+		return http.StatusOK, string(data)
+	})
+
+	guilds, err := FetchAllGuilds(context.Background())
+	if err != nil {
+		t.Fatalf("FetchAllGuilds() error = %v", err)
+	}
+
+	if len(guilds) != 450 || guilds[449].ID != "450" {
+		t.Errorf("FetchAllGuilds() returned %d guilds, want 450", len(guilds))
+	}
+	want := []string{"limit=200", "after=200&limit=200", "after=400&limit=200"}
+	if strings.Join(queries, " ") != strings.Join(want, " ") {
+		t.Errorf("FetchAllGuilds() queries = %q, want %q", queries, want)
+	}
+}
+
+func TestUserBadges(t *testing.T) {
+	data := `{"id":"1","public_flags":4194370,"premium_type":2,` +
+		`"avatar_decoration_data":{"asset":"a_hash","sku_id":"2"},` +
+		`"primary_guild":{"identity_guild_id":"3","identity_enabled":true,"tag":"GO","badge":"badge"}}`
+
+	var u User
+	if err := json.Unmarshal([]byte(data), &u); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if !u.HasBadge(ActiveDeveloper) || !u.HasBadge(Partner|HouseBravery) || u.HasBadge(Staff) {
+		t.Errorf("HasBadge() with public flags %v gave the wrong answer", u.PublicFlags)
+	}
+	if got := u.Badges(); len(got) != 3 || got[0] != Partner || got[1] != HouseBravery || got[2] != ActiveDeveloper {
+		t.Errorf("Badges() = %v, want [Partner HouseBravery ActiveDeveloper]", got)
+	}
+	if !u.HasNitro() || u.ServerTag() != "GO" {
+		t.Errorf("HasNitro() = %v, ServerTag() = %q, want true and GO", u.HasNitro(), u.ServerTag())
+	}
+	if got := u.PrimaryGuild.BadgeURL(); got != ImageBaseURL+"guild-tag-badges/3/badge.png" {
+		t.Errorf("BadgeURL() = %q", got)
+	}
+	if got := u.AvatarDecorationData.URL(); got != ImageBaseURL+"avatar-decoration-presets/a_hash.png" {
+		t.Errorf("URL() = %q", got)
+	}
+}
@@ -15,9 +15,9 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 	"strconv"
+	"sync"
 
 	log "github.com/veteran-software/nowlive-logging"
 )
@@ -37,7 +37,7 @@ func GetCurrentUser() (*User, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &user)
+	err = decodeResponse(u, responseBytes, &user)
 
 	return user, err
 }
@@ -55,7 +55,7 @@ func (u *User) GetUser() (*User, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &user)
+	err = decodeResponse(route, responseBytes, &user)
 
 	return user, err
 }
@@ -75,15 +75,15 @@ func ModifyCurrentUser(payload *ModifyCurrentUserJSON) (*User, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &user)
+	err = decodeResponse(u, responseBytes, &user)
 
 	return user, err
 }
 
 // ModifyCurrentUserJSON - JSON payload
 type ModifyCurrentUserJSON struct {
-	Username string  `json:"username,omitempty"`
-	Avatar   *string `json:"avatar,omitempty"`
+	Username string            `json:"username,omitempty"` // the user's username; changing it may cause the discriminator to be randomized
+	Avatar   *Nullable[string] `json:"avatar,omitempty"`   // the avatar as a data URI; Null removes it
 }
 
 // GetCurrentUserGuilds - Returns a list of partial Guild objects the current user is a member of. Requires the `guilds` OAuth2 scope.
@@ -113,7 +113,7 @@ func GetCurrentUserGuilds(before *Snowflake, after *Snowflake, limit *uint64) ([
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guilds)
+	err = decodeResponse(u, responseBytes, &guilds)
 
 	return guilds, err
 }
@@ -129,7 +129,8 @@ func (g *Guild) GetCurrentUserGuildMember() (*GuildMember, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &guildMember)
+	err = decodeResponse(u, responseBytes, &guildMember)
+	g.setMemberGuild(guildMember)
 
 	return guildMember, err
 }
@@ -149,6 +150,8 @@ func (g *Guild) LeaveGuild() error {
 //
 //	If you open a significant amount of DMs too quickly, your bot may be rate limited or blocked from opening new ones.
 //
+//	The returned channel is cached per recipient; use (*User).CreateDM or GetDMChannel to reuse it instead of calling this endpoint again.
+//
 //goland:noinspection GoUnusedExportedFunction
 func CreateDM(payload *CreateDmJSON) (*Channel, error) {
 	u := parseRoute(fmt.Sprintf(createDM, api))
@@ -160,11 +163,40 @@ func CreateDM(payload *CreateDmJSON) (*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
+	if err == nil && channel != nil {
+		dmChannels.Store(payload.RecipientID, channel)
+	}
 
 	return channel, err
 }
 
+// dmChannels - DM channels keyed by the recipient's user ID; a DM channel never changes for a given recipient
+var dmChannels sync.Map
+
+// GetDMChannel - Returns the DM Channel with the recipient, only calling CreateDM the first time
+//
+//goland:noinspection GoUnusedExportedFunction
+func GetDMChannel(recipientID Snowflake) (*Channel, error) {
+	if channel, ok := dmChannels.Load(recipientID); ok {
+		return channel.(*Channel), nil
+	}
+
+	return CreateDM(&CreateDmJSON{RecipientID: recipientID})
+}
+
+// ForgetDMChannel - Drops the cached DM Channel for the recipient, e.g. after receiving a ChannelDelete event for it
+//
+//goland:noinspection GoUnusedExportedFunction
+func ForgetDMChannel(recipientID Snowflake) {
+	dmChannels.Delete(recipientID)
+}
+
+// CreateDM - Returns the DM Channel with the User, creating it on first use
+func (u *User) CreateDM() (*Channel, error) {
+	return GetDMChannel(u.ID)
+}
+
 // CreateDmJSON - JSON payload
 type CreateDmJSON struct {
 	RecipientID Snowflake `json:"recipient_id"` // the recipient to open a DM channel with
@@ -179,7 +211,7 @@ type CreateDmJSON struct {
 //	This endpoint is limited to 10 active group DMs.
 //
 //goland:noinspection GoUnusedExportedFunction
-func CreateGroupDM(payload *CreateDmJSON) (*Channel, error) {
+func CreateGroupDM(payload *CreateGroupDmJSON) (*Channel, error) {
 	u := parseRoute(fmt.Sprintf(createGroupDM, api))
 
 	var channel *Channel
@@ -189,7 +221,7 @@ func CreateGroupDM(payload *CreateDmJSON) (*Channel, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &channel)
+	err = decodeResponse(u, responseBytes, &channel)
 
 	return channel, err
 }
@@ -213,7 +245,7 @@ func GetUserConnections() ([]*Connection, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &connections)
+	err = decodeResponse(u, responseBytes, &connections)
 
 	return connections, err
 }
@@ -254,7 +286,7 @@ func GetUserApplicationRoleConnection(applicationID *Snowflake) (*ApplicationRol
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &connection)
+	err = decodeResponse(u, responseBytes, &connection)
 
 	return connection, err
 }
@@ -275,7 +307,7 @@ func UpdateUserApplicationRoleConnection(applicationID *Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &connection)
+	err = decodeResponse(u, responseBytes, &connection)
 
 	return connection, err
 }
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Message limits enforced by Discord; a payload past any of them is rejected with a Bad Request response
+//
+//goland:noinspection GoUnusedConst
+const (
+	ContentLimit      = 2000 // 2000 characters of message content
+	EmbedCount        = 10   // Up to 10 embeds per message
+	EmbedTotalLimit   = 6000 // 6000 characters across every embed of a message
+	ActionRowCount    = 5    // Up to 5 action rows per message
+	CustomIDLimit     = 100  // 100 characters in a component custom_id
+	SelectOptionCount = 25   // Up to 25 options in a select menu
+	ChoiceCount       = 25   // Up to 25 autocomplete choices
+)
+
+// LimitError - A single field of a payload that is past one of Discord's limits
+//
+// Validate joins every LimitError it finds with errors.Join; use errors.As to inspect the first of them.
+type LimitError struct {
+	Field  string // the offending field, e.g. "embeds[1].fields[3].value"
+	Length int    // the length or count of the field
+	Limit  int    // the most Discord accepts
+}
+
+// Error - implements the error interface
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s: %d exceeds the limit of %d", e.Field, e.Length, e.Limit)
+}
+
+// limitChecker - collects a LimitError for every check that fails
+type limitChecker struct {
+	errs []error
+}
+
+func (c *limitChecker) count(field string, length, limit int) {
+	if length > limit {
+		c.errs = append(c.errs, &LimitError{Field: field, Length: length, Limit: limit})
+	}
+}
+
+func (c *limitChecker) text(field, value string, limit int) int {
+	length := utf8.RuneCountInString(value)
+	c.count(field, length, limit)
+
+	return length
+}
+
+func (c *limitChecker) err() error {
+	return errors.Join(c.errs...)
+}
+
+// Validate - Checks the Embed against Discord's limits, returning every field that is too long
+func (e *Embed) Validate() error {
+	var c limitChecker
+	e.validate(&c, "embed")
+
+	return c.err()
+}
+
+// validate - checks the embed and returns its character count towards EmbedTotalLimit
+func (e *Embed) validate(c *limitChecker, prefix string) int {
+	total := c.text(prefix+".title", e.Title, TitleLimit)
+	total += c.text(prefix+".description", e.Description, DescriptionLimit)
+	if e.Footer != nil {
+		total += c.text(prefix+".footer.text", e.Footer.Text, FooterTextLimit)
+	}
+	if e.Author != nil {
+		total += c.text(prefix+".author.name", e.Author.Name, AuthorNameLimit)
+	}
+
+	c.count(prefix+".fields", len(e.Fields), FieldCount)
+	for i, field := range e.Fields {
+		if field == nil {
+			continue
+		}
+		total += c.text(fmt.Sprintf("%s.fields[%d].name", prefix, i), field.Name, FieldNameLimit)
+		total += c.text(fmt.Sprintf("%s.fields[%d].value", prefix, i), field.Value, FieldValueLimit)
+	}
+	c.count(prefix, total, EmbedTotalLimit)
+
+	return total
+}
+
+func validateEmbeds(c *limitChecker, embeds []*Embed) {
+	c.count("embeds", len(embeds), EmbedCount)
+
+	total, single := 0, false
+	for i, embed := range embeds {
+		if embed != nil {
+			length := embed.validate(c, fmt.Sprintf("embeds[%d]", i))
+			single = single || length > EmbedTotalLimit
+			total += length
+		}
+	}
+	// an embed that is over the limit on its own has already been reported
+	if !single {
+		c.count("embeds total", total, EmbedTotalLimit)
+	}
+}
+
+func validateComponents(c *limitChecker, components []*Component) {
+	c.errs = append(c.errs, messageComponentErrors(components)...)
+	validateComponentTree(c, "components", components)
+}
+
+func validateComponentTree(c *limitChecker, prefix string, components []*Component) {
+	for i, component := range components {
+		if component == nil {
+			continue
+		}
+		field := fmt.Sprintf("%s[%d]", prefix, i)
+		c.text(field+".custom_id", component.CustomID, CustomIDLimit)
+		c.count(field+".options", len(component.Options), SelectOptionCount)
+		validateComponentTree(c, field+".components", component.Components)
+	}
+}
+
+// Validate - Checks the payload against Discord's limits, returning every field that would cause a Bad Request response
+func (p *CreateMessageJSON) Validate() error {
+	var c limitChecker
+	c.text("content", p.Content, ContentLimit)
+	validateEmbeds(&c, p.Embeds)
+	validateComponents(&c, p.Components)
+
+	return c.err()
+}
+
+// Validate - Checks the payload against Discord's limits, returning every field that would cause a Bad Request response
+func (p *ExecuteWebhookJSON) Validate() error {
+	var c limitChecker
+	c.text("content", p.Content, ContentLimit)
+	validateEmbeds(&c, p.Embeds)
+	validateComponents(&c, p.Components)
+
+	return c.err()
+}
+
+// Validate - Checks the payload against Discord's limits, returning every field that would cause a Bad Request response
+func (p *InteractionCallbackDataMessages) Validate() error {
+	var c limitChecker
+	c.text("content", p.Content, ContentLimit)
+	validateEmbeds(&c, p.Embeds)
+	validateComponents(&c, p.Components)
+
+	return c.err()
+}
+
+// Validate - Checks the payload against Discord's limits, returning every field that would cause a Bad Request response
+func (p *InteractionCallbackDataAutocomplete) Validate() error {
+	var c limitChecker
+	c.count("choices", len(p.Choices), ChoiceCount)
+
+	return c.err()
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCreateMessageJSONValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload CreateMessageJSON
+		want    []string
+	}{
+		{"valid", CreateMessageJSON{Content: "hello", Embeds: []*Embed{{Title: "title"}}}, nil},
+		{"content counts characters not bytes", CreateMessageJSON{Content: strings.Repeat("é", ContentLimit)}, nil},
+		{"content", CreateMessageJSON{Content: strings.Repeat("a", ContentLimit+1)}, []string{"content"}},
+		{"embed count", CreateMessageJSON{Embeds: make([]*Embed, EmbedCount+1)}, []string{"embeds"}},
+		{
+			"embed fields",
+			CreateMessageJSON{Embeds: []*Embed{nil, {Fields: []*Field{{Name: "ok", Value: strings.Repeat("a", FieldValueLimit+1)}}, Footer: &Footer{}}}},
+			[]string{"embeds[1].fields[0].value"},
+		},
+		{
+			"embed total",
+			CreateMessageJSON{Embeds: []*Embed{{Description: strings.Repeat("a", DescriptionLimit)}, {Description: strings.Repeat("a", DescriptionLimit)}}},
+			[]string{"embeds total"},
+		},
+		{
+			"single embed total",
+			CreateMessageJSON{Embeds: []*Embed{{Fields: []*Field{
+				{Value: strings.Repeat("a", FieldValueLimit)},
+				{Value: strings.Repeat("a", FieldValueLimit)},
+				{Value: strings.Repeat("a", FieldValueLimit)},
+				{Value: strings.Repeat("a", FieldValueLimit)},
+				{Value: strings.Repeat("a", FieldValueLimit)},
+				{Value: strings.Repeat("a", FieldValueLimit)},
+			}}}},
+			[]string{"embeds[0]"},
+		},
+		{
+			"components",
+			CreateMessageJSON{Components: []*Component{{Type: ComponentTypeActionRow, Components: []*Component{{CustomID: strings.Repeat("a", CustomIDLimit+1)}}}}},
+			[]string{"components[0].components[0].custom_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.payload.Validate()
+
+			var got []string
+			if err != nil {
+				for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+					var limitErr *LimitError
+					if errors.As(e, &limitErr) {
+						got = append(got, limitErr.Field)
+					}
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Validate() fields = %v, want %v (err = %v)", got, tt.want, err)
+			}
+		})
+	}
+}
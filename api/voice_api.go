@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+)
+
+// ErrNoVoiceRegion - returned when none of the voice regions can be used for a voice channel
+var ErrNoVoiceRegion = errors.New("no usable voice region")
+
+// OptimalVoiceRegion - Returns the region Discord marks as optimal, or the first region that is neither deprecated nor custom
+//
+// Returns nil when none of the regions can be used.
+//
+//goland:noinspection GoUnusedExportedFunction
+func OptimalVoiceRegion(regions []*VoiceRegion) *VoiceRegion {
+	var fallback *VoiceRegion
+	for _, region := range regions {
+		if region == nil || region.Deprecated || region.Custom {
+			continue
+		}
+		if region.Optimal {
+			return region
+		}
+		if fallback == nil {
+			fallback = region
+		}
+	}
+
+	return fallback
+}
+
+// FindVoiceRegion - Returns the region with the given ID, or nil when it is not in the list
+//
+//goland:noinspection GoUnusedExportedFunction
+func FindVoiceRegion(regions []*VoiceRegion, id string) *VoiceRegion {
+	for _, region := range regions {
+		if region != nil && region.ID == id {
+			return region
+		}
+	}
+
+	return nil
+}
+
+// OptimalVoiceRegion - Returns the optimal voice region for the guild, including its VIP servers
+//
+// Set the region's ID as the rtc_region when creating or updating a voice or stage channel.
+func (g *Guild) OptimalVoiceRegion() (*VoiceRegion, error) {
+	regions, err := g.GetGuildVoiceRegions()
+	if err != nil {
+		return nil, err
+	}
+
+	region := OptimalVoiceRegion(regions)
+	if region == nil {
+		return nil, ErrNoVoiceRegion
+	}
+
+	return region, nil
+}
+
+// MigrateVoiceRegion - Moves a voice or stage channel off a deprecated or unknown rtc_region
+//
+// The channel is moved to the optimal region, or to automatic when no region can be used.
+// Channels with an automatic or usable region are returned unchanged without a request.
+func (c *Channel) MigrateVoiceRegion(regions []*VoiceRegion, reason *string) (*Channel, error) {
+	if c.RtcRegion == nil {
+		return c, nil
+	}
+	if current := FindVoiceRegion(regions, *c.RtcRegion); current != nil && !current.Deprecated {
+		return c, nil
+	}
+
+	rtcRegion := Null[string]()
+	if region := OptimalVoiceRegion(regions); region != nil {
+		rtcRegion = NewNullable(region.ID)
+	}
+
+	return c.ModifyGuildVoiceChannel(ModifyGuildVoiceChannelJSON{RtcRegion: rtcRegion}, reason)
+}
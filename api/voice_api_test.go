@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestOptimalVoiceRegion(t *testing.T) {
+	regions := []*VoiceRegion{
+		{ID: "old", Deprecated: true, Optimal: true},
+		{ID: "event", Custom: true},
+		{ID: "us-east"},
+		{ID: "us-west", Optimal: true},
+	}
+
+	tests := []struct {
+		name    string
+		regions []*VoiceRegion
+		want    string
+	}{
+		{"optimal", regions, "us-west"},
+		{"fallback", regions[:3], "us-east"},
+		{"none", regions[:2], ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if region := OptimalVoiceRegion(tt.regions); region != nil {
+			got = region.ID
+		}
+		if got != tt.want {
+			t.Errorf("OptimalVoiceRegion(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestChannelMigrateVoiceRegion(t *testing.T) {
+	var sent []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		data, _ := io.ReadAll(req.Body)
+		sent = append(sent, string(data))
+
+		return http.StatusOK, `{"id":"1"}`
+	})
+
+	regions := []*VoiceRegion{{ID: "old", Deprecated: true}, {ID: "us-west", Optimal: true}}
+	for _, region := range []*string{nil, &regions[1].ID, &regions[0].ID} {
+		if _, err := (&Channel{ID: "1", RtcRegion: region}).MigrateVoiceRegion(regions, nil); err != nil {
+			t.Fatalf("MigrateVoiceRegion() error = %v", err)
+		}
+	}
+
+	if len(sent) != 1 {
+		t.Fatalf("MigrateVoiceRegion() sent %d requests, want 1", len(sent))
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(sent[0]), &payload); err != nil || payload["rtc_region"] != "us-west" {
+		t.Errorf("MigrateVoiceRegion() payload = %s, want rtc_region us-west", sent[0])
+	}
+}
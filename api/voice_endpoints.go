@@ -15,7 +15,6 @@
 package api
 
 import (
-	"encoding/json"
 	"fmt"
 
 	log "github.com/veteran-software/nowlive-logging"
@@ -34,7 +33,7 @@ func ListVoiceRegions() ([]*VoiceRegion, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &voiceRegions)
+	err = decodeResponse(u, responseBytes, &voiceRegions)
 
 	return voiceRegions, err
 }
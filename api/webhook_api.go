@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// WebhookNameLimit - Webhook names, and the username overrides sent with ExecuteWebhook, are 1-80 characters
+const WebhookNameLimit = 80
+
+// ErrInvalidWebhookName - the name is empty, longer than WebhookNameLimit or contains a phrase Discord prohibits
+var ErrInvalidWebhookName = errors.New("webhook length is incorrect or the name contains a prohibited phrase")
+
+// ValidateWebhookName - Checks the name against the restrictions Discord applies to webhook names and username overrides
+//
+// Discord trims leading and trailing whitespace before checking the length, and rejects names containing 'clyde' or 'discord' in any case.
+func ValidateWebhookName(name string) error {
+	name = strings.TrimSpace(name)
+	length := utf8.RuneCountInString(name)
+	lower := strings.ToLower(name)
+	if length < 1 || length > WebhookNameLimit || strings.Contains(lower, "clyde") || strings.Contains(lower, "discord") {
+		return ErrInvalidWebhookName
+	}
+
+	return nil
+}
+
+// webhookNames - remembers the result of validating each override name, as bridge bots send as the same few users over and over
+//
+// The cache is cleared once it holds maxCachedWebhookNames names rather than evicting them one by one.
+var webhookNames = struct {
+	sync.RWMutex
+	valid map[string]error
+}{valid: make(map[string]error)}
+
+const maxCachedWebhookNames = 4096
+
+func validateWebhookOverride(name string) error {
+	webhookNames.RLock()
+	err, ok := webhookNames.valid[name]
+	webhookNames.RUnlock()
+	if ok {
+		return err
+	}
+
+	err = ValidateWebhookName(name)
+
+	webhookNames.Lock()
+	if len(webhookNames.valid) >= maxCachedWebhookNames {
+		clear(webhookNames.valid)
+	}
+	webhookNames.valid[name] = err
+	webhookNames.Unlock()
+
+	return err
+}
+
+// AvatarURL - Returns the URL of the Webhook's default avatar, or "" when it has none
+func (w *Webhook) AvatarURL() string {
+	if w.Avatar == nil || *w.Avatar == "" {
+		return ""
+	}
+
+	return ImageBaseURL + fmt.Sprintf(getAvatarUrlPng, w.ID, *w.Avatar)
+}
+
+// SendAs - Executes the Webhook with the username and avatar overridden, e.g. to relay a message as the user who sent it elsewhere
+//
+// Overrides identical to the Webhook's own name or avatar are left out of the request; an empty name or avatarURL keeps the default.
+// The payload is not modified. Waits for the Message, so it is returned.
+func (w *Webhook) SendAs(name, avatarURL string, payload *ExecuteWebhookJSON) (*Message, error) {
+	send, err := w.overridden(name, avatarURL, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.ExecuteWebhook(true, nil, send)
+}
+
+// overridden - a copy of the payload with the overrides SendAs would send
+func (w *Webhook) overridden(name, avatarURL string, payload *ExecuteWebhookJSON) (*ExecuteWebhookJSON, error) {
+	if name != "" {
+		if err := validateWebhookOverride(name); err != nil {
+			return nil, err
+		}
+	}
+
+	var send ExecuteWebhookJSON
+	if payload != nil {
+		send = *payload
+	}
+
+	send.Username = name
+	if w.Name != nil && name == *w.Name {
+		send.Username = ""
+	}
+	send.AvatarURL = avatarURL
+	if avatarURL == w.AvatarURL() {
+		send.AvatarURL = ""
+	}
+
+	return &send, nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestValidateWebhookName(t *testing.T) {
+	tests := []struct {
+		name string
+		want error
+	}{
+		{"Bridge", nil},
+		{strings.Repeat("é", WebhookNameLimit), nil},
+		{"", ErrInvalidWebhookName},
+		{strings.Repeat("a", WebhookNameLimit+1), ErrInvalidWebhookName},
+		{"Not Clyde", ErrInvalidWebhookName},
+		{"discord bridge", ErrInvalidWebhookName},
+		{"ClYdE", ErrInvalidWebhookName},
+		{"   ", ErrInvalidWebhookName},
+		{" " + strings.Repeat("a", WebhookNameLimit) + " ", nil},
+	}
+
+	for _, tt := range tests {
+		if got := ValidateWebhookName(tt.name); got != tt.want {
+			t.Errorf("ValidateWebhookName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWebhookSendAs(t *testing.T) {
+	var sent ExecuteWebhookJSON
+	stubRest(t, func(req *http.Request) (int, string) {
+		sent = ExecuteWebhookJSON{}
+		_ = json.NewDecoder(req.Body).Decode(&sent)
+		return http.StatusOK, `{"id":"2"}`
+	})
+
+	name, avatar := "Relay", "abc"
+	w := &Webhook{ID: "1", Token: "token", Name: &name, Avatar: &avatar}
+	payload := &ExecuteWebhookJSON{Content: "hi"}
+
+	if _, err := w.SendAs("Alice", "https://example.com/alice.png", payload); err != nil {
+		t.Fatalf("SendAs() error = %v", err)
+	}
+	if sent.Username != "Alice" || sent.AvatarURL != "https://example.com/alice.png" || payload.Username != "" {
+		t.Errorf("SendAs() sent %q, %q and left the payload's username %q", sent.Username, sent.AvatarURL, payload.Username)
+	}
+
+	if _, err := w.SendAs("Relay", w.AvatarURL(), payload); err != nil {
+		t.Fatalf("SendAs() error = %v", err)
+	}
+	if sent.Username != "" || sent.AvatarURL != "" {
+		t.Errorf("SendAs() with the webhook's own name and avatar sent %q, %q", sent.Username, sent.AvatarURL)
+	}
+
+	if _, err := w.SendAs("clyde", "", payload); err != ErrInvalidWebhookName {
+		t.Errorf("SendAs() error = %v, want %v", err, ErrInvalidWebhookName)
+	}
+}
+
+func TestCreateWebhookWithoutAvatar(t *testing.T) {
+	var sent map[string]any
+	stubRest(t, func(req *http.Request) (int, string) {
+		body := `{"id":"3"}`
+		switch route := strings.TrimPrefix(req.URL.String(), api); {
+		case strings.HasPrefix(route, "/guilds/7/members/"):
+			body = `{"user":{"id":"100"}}`
+		case route == "/guilds/7":
+			body = `{"id":"7","owner_id":"100"}`
+		default:
+			_ = json.NewDecoder(req.Body).Decode(&sent)
+		}
+
+		return http.StatusOK, body
+	})
+
+	webhook, err := (&Channel{ID: "5", GuildID: "7"}).CreateWebhook("Bridge", nil, nil)
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	if webhook == nil || webhook.ID != "3" {
+		t.Errorf("CreateWebhook() = %+v, want webhook 3", webhook)
+	}
+	if _, ok := sent["avatar"]; ok || sent["name"] != "Bridge" {
+		t.Errorf("CreateWebhook() sent %v, want only the name", sent)
+	}
+}
+
+func TestExecuteWebhookWait(t *testing.T) {
+	var query string
+	stubRest(t, func(req *http.Request) (int, string) {
+		query = req.URL.RawQuery
+		if req.URL.Query().Get("wait") == "true" {
+			return http.StatusOK, `{"id":"2","content":"hi"}`
+		}
+		return http.StatusNoContent, ""
+	})
+
+	w := &Webhook{ID: "1", Token: "token"}
+
+	message, err := w.ExecuteWebhook(true, nil, &ExecuteWebhookJSON{Content: "hi"})
+	if err != nil || message == nil || message.ID != "2" {
+		t.Errorf("ExecuteWebhook(wait) = %+v, %v, want the saved message", message, err)
+	}
+
+	threadID := Snowflake("9")
+	message, err = w.ExecuteWebhook(false, &threadID, &ExecuteWebhookJSON{Content: "hi"})
+	if err != nil || message != nil {
+		t.Errorf("ExecuteWebhook(no wait) = %+v, %v, want nil, nil", message, err)
+	}
+	if query != "thread_id=9&wait=false" {
+		t.Errorf("ExecuteWebhook(no wait) query = %q", query)
+	}
+}
@@ -15,11 +15,10 @@
 package api
 
 import (
-	"encoding/json"
+	"bytes"
 	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 
 	log "github.com/veteran-software/nowlive-logging"
 	"github.com/vincent-petithory/dataurl"
@@ -33,23 +32,24 @@ import (
 //
 // Webhook names follow our naming restrictions that can be found in our Usernames and Nicknames documentation, with the following additional stipulations:
 //
-//   - Webhook names cannot be: 'clyde'
+//   - Webhook names cannot contain the substrings 'clyde' or 'discord' (case-insensitive)
+//
+// The avatar is optional; pass nil to create the webhook with the default avatar.
 //
 // This endpoint supports the "X-Audit-Log-Reason" header.
 func (c *Channel) CreateWebhook(name string, avatar *dataurl.DataURL, reason *string) (*Webhook, error) {
-	if len(name) < 1 ||
-		len(name) > 80 ||
-		strings.Contains(strings.ToLower(name), "clyde") ||
-		strings.Contains(strings.ToLower(name), "discord") {
-		return nil, errors.New("webhook length is incorrect or the name contains a prohibited phrase")
+	if err := ValidateWebhookName(name); err != nil {
+		return nil, err
 	}
 
 	params := struct {
 		Name   string `json:"name"`
 		Avatar string `json:"avatar,omitempty"`
 	}{
-		Name:   name,
-		Avatar: avatar.String(),
+		Name: name,
+	}
+	if avatar != nil {
+		params.Avatar = avatar.String()
 	}
 
 	self, err := c.getSelfMember()
@@ -70,7 +70,7 @@ func (c *Channel) CreateWebhook(name string, avatar *dataurl.DataURL, reason *st
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhook)
+	err = decodeResponse(u, responseBytes, &webhook)
 
 	return webhook, err
 }
@@ -95,7 +95,7 @@ func (c *Channel) GetChannelWebhooks() ([]*Webhook, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhooks)
+	err = decodeResponse(u, responseBytes, &webhooks)
 
 	return webhooks, err
 }
@@ -120,7 +120,7 @@ func (g *Guild) GetGuildWebhooks(c *Channel) ([]*Webhook, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhooks)
+	err = decodeResponse(u, responseBytes, &webhooks)
 
 	return webhooks, err
 }
@@ -136,7 +136,7 @@ func (w *Webhook) GetWebhook() (*Webhook, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhook)
+	err = decodeResponse(u, responseBytes, &webhook)
 
 	return webhook, err
 }
@@ -152,7 +152,7 @@ func (w *Webhook) GetWebhookWithToken() (*Webhook, error) {
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhook)
+	err = decodeResponse(u, responseBytes, &webhook)
 
 	return webhook, err
 }
@@ -201,7 +201,7 @@ func (w *Webhook) ModifyWebhook(name *string, avatar *dataurl.DataURL, channel *
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhook)
+	err = decodeResponse(u, responseBytes, &webhook)
 
 	return webhook, err
 }
@@ -229,7 +229,7 @@ func (w *Webhook) ModifyWebhookWithToken(name *string, avatar *dataurl.DataURL,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &webhook)
+	err = decodeResponse(u, responseBytes, &webhook)
 
 	return webhook, err
 }
@@ -265,6 +265,9 @@ func (w *Webhook) DeleteWebhookWithToken(reason *string) error {
 // Note that when sending a message, you must provide a value for at least one of content, embeds, or file.
 //
 // wait is required; threadID is optional; pass nil if not needed
+//
+// With wait set, Discord confirms the message was saved and returns it. Without it, Discord answers 204 No Content once the request is accepted,
+// and ExecuteWebhook returns a nil Message with a nil error.
 func (w *Webhook) ExecuteWebhook(wait bool, threadID *Snowflake, payload *ExecuteWebhookJSON) (*Message,
 	error) {
 	u := parseRoute(fmt.Sprintf(executeWebhook, api, w.ID, w.Token))
@@ -284,8 +287,11 @@ func (w *Webhook) ExecuteWebhook(wait bool, threadID *Snowflake, payload *Execut
 		log.Errorln(log.Discord, log.FuncName(), err)
 		return nil, err
 	}
+	if len(bytes.TrimSpace(messageBytes)) == 0 {
+		return nil, nil
+	}
 
-	err = json.Unmarshal(messageBytes, &message)
+	err = decodeResponse(u, messageBytes, &message)
 
 	return message, err
 }
@@ -302,8 +308,8 @@ type ExecuteWebhookJSON struct {
 	PayloadJson     string           `json:"payload_json"`               // JSON encoded body of non-file params; Required - "multipart/form-data" only
 	Attachments     []*Attachment    `json:"attachments,omitempty"`      // Attachment objects with filename and description; Required - false
 	Flags           MessageFlags     `json:"flags,omitempty"`            // MessageFlags combined as a bitfield (only SuppressEmbeds can be set)
-	ThreadName      string           `json:"thread_name"`                // name of thread to create (requires the webhook channel to be a forum channel)
-	AppliedTags     []Snowflake      `json:"applied_tags"`               // array of tag ids to apply to the thread (requires the webhook channel to be a forum or media channel)
+	ThreadName      string           `json:"thread_name,omitempty"`      // name of thread to create (requires the webhook channel to be a forum channel)
+	AppliedTags     []Snowflake      `json:"applied_tags,omitempty"`     // array of tag ids to apply to the thread (requires the webhook channel to be a forum or media channel)
 }
 
 // GetWebhookMessage - Returns a previously-sent webhook message from the same token. Returns a message object on success.
@@ -327,7 +333,7 @@ func (w *Webhook) GetWebhookMessage(msgID *Snowflake, threadID *Snowflake) (*Mes
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
@@ -370,7 +376,7 @@ func (w *Webhook) EditWebhookMessage(msgID *Snowflake,
 		return nil, err
 	}
 
-	err = json.Unmarshal(responseBytes, &message)
+	err = decodeResponse(u, responseBytes, &message)
 
 	return message, err
 }
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	log "github.com/veteran-software/nowlive-logging"
+	"github.com/vincent-petithory/dataurl"
+)
+
+// MaxChannelWebhooks - A channel can have up to 15 webhooks
+const MaxChannelWebhooks = 15
+
+// ErrChannelWebhookLimit - the channel already has MaxChannelWebhooks webhooks, none of which belong to the application
+var ErrChannelWebhookLimit = errors.New("the channel has reached its webhook limit and none of its webhooks belong to the application")
+
+// WebhookPool - Finds or creates one webhook per channel for the application and remembers its token
+//
+// Intended for bridge bots that relay messages into many channels: send through the pool and it reuses the application's own webhook,
+// creating one the first time a channel is used and recreating it when it has been deleted.
+type WebhookPool struct {
+	Name   string           // the name given to webhooks the pool creates, and used to find them again
+	Avatar *dataurl.DataURL // the avatar given to webhooks the pool creates
+
+	mu       sync.Mutex // guards the map only; each channel has its own lock for lookups
+	webhooks map[Snowflake]*pooledWebhook
+}
+
+// pooledWebhook - the cached webhook of one channel
+type pooledWebhook struct {
+	mu      sync.Mutex // held while the channel's webhook is looked up or created
+	webhook *Webhook
+}
+
+// NewWebhookPool - Creates a WebhookPool whose webhooks are created with the name and avatar
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewWebhookPool(name string, avatar *dataurl.DataURL) *WebhookPool {
+	return &WebhookPool{Name: name, Avatar: avatar, webhooks: make(map[Snowflake]*pooledWebhook)}
+}
+
+// Get - Returns the application's webhook in the channel, creating it if the channel has none
+//
+// Webhooks are looked up once per channel and then cached. The channel stays locked while its webhook is looked up or created,
+// so concurrent sends to a new channel create only one, while sends to other channels carry on.
+func (p *WebhookPool) Get(channel *Channel) (*Webhook, error) {
+	p.mu.Lock()
+	entry, ok := p.webhooks[channel.ID]
+	if !ok {
+		entry = &pooledWebhook{}
+		p.webhooks[channel.ID] = entry
+	}
+	p.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.webhook != nil {
+		return entry.webhook, nil
+	}
+
+	webhooks, err := channel.GetChannelWebhooks()
+	if err != nil {
+		return nil, err
+	}
+
+	w := p.owned(webhooks)
+	if w == nil {
+		if len(webhooks) >= MaxChannelWebhooks {
+			return nil, ErrChannelWebhookLimit
+		}
+
+		if w, err = channel.CreateWebhook(p.Name, p.Avatar, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	entry.webhook = w
+
+	return w, nil
+}
+
+// owned - the first webhook the application created that can be executed, preferring one with the pool's name
+func (p *WebhookPool) owned(webhooks []*Webhook) *Webhook {
+	var found *Webhook
+	for _, w := range webhooks {
+		if w == nil || w.Type != WebhookTypeIncoming || w.Token == "" || w.ApplicationID == nil || *w.ApplicationID != ApplicationID {
+			continue
+		}
+		if w.Name != nil && *w.Name == p.Name {
+			return w
+		}
+		if found == nil {
+			found = w
+		}
+	}
+
+	return found
+}
+
+// Forget - Drops the cached webhook of the channel, so the next send looks it up again
+func (p *WebhookPool) Forget(channelID Snowflake) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.webhooks, channelID)
+}
+
+// Send - Executes the channel's webhook with the payload, recreating the webhook once if it has been deleted
+func (p *WebhookPool) Send(channel *Channel, payload *ExecuteWebhookJSON) (*Message, error) {
+	return p.SendAs(channel, "", "", payload)
+}
+
+// SendAs - Executes the channel's webhook as Webhook.SendAs does, recreating the webhook once if it has been deleted
+func (p *WebhookPool) SendAs(channel *Channel, name, avatarURL string, payload *ExecuteWebhookJSON) (*Message, error) {
+	for attempt := 0; ; attempt++ {
+		w, err := p.Get(channel)
+		if err != nil {
+			return nil, err
+		}
+
+		message, err := w.sendAsChecked(name, avatarURL, payload)
+		if !errors.Is(err, errUnknownWebhook) || attempt > 0 {
+			return message, err
+		}
+
+		p.Forget(channel.ID)
+	}
+}
+
+// errUnknownWebhook - the webhook was deleted, or its token reset, since it was cached
+var errUnknownWebhook = errors.New("unknown webhook")
+
+// sendAsChecked - SendAs, reporting errUnknownWebhook when Discord no longer recognises the webhook and an error for any other failed response
+func (w *Webhook) sendAsChecked(name, avatarURL string, payload *ExecuteWebhookJSON) (*Message, error) {
+	send, err := w.overridden(name, avatarURL, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	u := parseRoute(fmt.Sprintf(executeWebhook, api, w.ID, w.Token))
+	u.RawQuery = "wait=true"
+
+	resp, err := Rest.Request(http.MethodPost, u.String(), send, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil, err
+	}
+	defer func(Body io.ReadCloser) {
+		_ = Body.Close()
+	}(resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusNotFound, http.StatusUnauthorized:
+		return nil, errUnknownWebhook
+	}
+
+	responseBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("executing webhook %s: status %d: %s", w.ID, resp.StatusCode, bytes.TrimSpace(responseBytes))
+	}
+
+	var message *Message
+	err = decodeResponse(u, responseBytes, &message)
+
+	return message, err
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWebhookPoolRecreatesDeletedWebhook(t *testing.T) {
+	previousID := ApplicationID
+	ApplicationID = "100"
+	defer func() { ApplicationID = previousID }()
+
+	appID := ApplicationID
+	name := "Bridge"
+	current := "2"
+	rejected := false
+
+	var requests []string
+	stubRest(t, func(req *http.Request) (int, string) {
+		route := strings.TrimPrefix(req.URL.String(), api)
+
+		status, body := http.StatusOK, `{"id":"9"}`
+		switch {
+		case strings.HasPrefix(route, "/guilds/7/members/"):
+			body = `{"user":{"id":"100"}}`
+		case route == "/guilds/7":
+			body = `{"id":"7","owner_id":"100"}`
+		case req.Method == http.MethodGet:
+			webhooks := []*Webhook{
+				{ID: "1", Type: WebhookTypeIncoming, Token: "t1"},
+				{ID: Snowflake(current), Type: WebhookTypeIncoming, Token: "t" + current, ApplicationID: &appID, Name: &name},
+			}
+			data, _ := json.Marshal(webhooks)
+			body = string(data)
+		case strings.HasSuffix(req.URL.Path, "/webhooks/2/t2"):
+			status, body = http.StatusNotFound, `{"message":"Unknown Webhook","code":10015}`
+			current = "3"
+		case rejected && strings.HasSuffix(req.URL.Path, "/webhooks/3/t3"):
+			status, body = http.StatusBadRequest, `{"message":"Invalid Form Body","code":50035}`
+		}
+		if !strings.HasPrefix(route, "/guilds/") {
+			requests = append(requests, req.Method+" "+route)
+		}
+
+		return status, body
+	})
+
+	pool := NewWebhookPool(name, nil)
+	message, err := pool.Send(&Channel{ID: "5", GuildID: "7"}, &ExecuteWebhookJSON{Content: "hi"})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if message == nil || message.ID != "9" {
+		t.Errorf("Send() = %+v, want message 9", message)
+	}
+
+	want := []string{"GET /channels/5/webhooks", "POST /webhooks/2/t2?wait=true", "GET /channels/5/webhooks", "POST /webhooks/3/t3?wait=true"}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Send() requests = %q, want %q", requests, want)
+	}
+
+	rejected = true
+	if message, err = pool.Send(&Channel{ID: "5", GuildID: "7"}, &ExecuteWebhookJSON{Content: "hi"}); err == nil || message != nil {
+		t.Errorf("Send() rejected = %+v, %v, want an error and no message", message, err)
+	}
+}
+
+func TestWebhookPoolCachedChannelDoesNotWait(t *testing.T) {
+	previousID := ApplicationID
+	ApplicationID = "100"
+	defer func() { ApplicationID = previousID }()
+
+	appID := ApplicationID
+	started, release := make(chan struct{}), make(chan struct{})
+	stubRest(t, func(req *http.Request) (int, string) {
+		switch route := strings.TrimPrefix(req.URL.String(), api); {
+		case strings.HasPrefix(route, "/guilds/7/members/"):
+			return http.StatusOK, `{"user":{"id":"100"}}`
+		case route == "/guilds/7":
+			return http.StatusOK, `{"id":"7","owner_id":"100"}`
+		case strings.HasPrefix(route, "/channels/5/"):
+			close(started)
+			<-release
+		}
+		data, _ := json.Marshal([]*Webhook{{ID: "1", Type: WebhookTypeIncoming, Token: "t1", ApplicationID: &appID}})
+		return http.StatusOK, string(data)
+	})
+
+	pool := NewWebhookPool("Bridge", nil)
+	if _, err := pool.Get(&Channel{ID: "6", GuildID: "7"}); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	slow := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(&Channel{ID: "5", GuildID: "7"})
+		slow <- err
+	}()
+	<-started
+
+	cached := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(&Channel{ID: "6", GuildID: "7"})
+		cached <- err
+	}()
+	select {
+	case err := <-cached:
+		if err != nil {
+			t.Errorf("Get() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("Get() of a cached channel waited for another channel's lookup")
+	}
+
+	close(release)
+	if err := <-slow; err != nil {
+		t.Errorf("Get() error = %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package auditlog tails a guild's audit log over REST, for bots that cannot receive GUILD_AUDIT_LOG_ENTRY_CREATE from the gateway.
+package auditlog
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+const (
+	// DefaultInterval - how often a Poller checks for new entries when Interval is not set
+	DefaultInterval = 30 * time.Second
+	// DefaultMaxBackoff - the longest a Poller waits between attempts after failures when MaxBackoff is not set
+	DefaultMaxBackoff = 10 * time.Minute
+
+	pageSize = 100
+	seenSize = 500
+)
+
+// Poller - polls GetGuildAuditLog and emits each new entry once, oldest first
+//
+// Set After to resume from an entry ID saved from LastID; when it is empty, polling starts from the newest entry and history is not replayed.
+type Poller struct {
+	Guild      *api.Guild         // the guild to poll; only the ID is used
+	Interval   time.Duration      // the time between polls; DefaultInterval when zero
+	Jitter     time.Duration      // up to this much is added to every interval, so many pollers do not hit the API in lockstep; Interval/10 when zero
+	MaxBackoff time.Duration      // the longest wait between attempts after consecutive failures; DefaultMaxBackoff when zero
+	UserID     *api.Snowflake     // only emit entries made by this user
+	ActionType *api.AuditLogEvent // only emit entries of this type
+	After      api.Snowflake      // emit entries newer than this ID
+	OnError    func(err error)    // receives failed polls; they are logged when nil
+
+	mu    sync.Mutex    // guards last, which LastID reads while the poll goroutine moves it
+	last  api.Snowflake // only written by the poll goroutine, through setLast
+	seen  map[api.Snowflake]struct{}
+	order []api.Snowflake
+	fetch func(after *api.Snowflake, limit uint64) (*api.AuditLog, error)
+}
+
+// Start - Polls until the context ends, sending new entries on the returned channel, which is closed when polling stops
+//
+// A Poller must not be started more than once at the same time.
+func (p *Poller) Start(ctx context.Context) <-chan *api.AuditLogEntry {
+	out := make(chan *api.AuditLogEntry, pageSize)
+
+	go func() {
+		defer close(out)
+		p.run(ctx, out)
+	}()
+
+	return out
+}
+
+// LastID - Returns the ID of the newest entry emitted so far, to resume from with After
+func (p *Poller) LastID() api.Snowflake {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.last
+}
+
+// setLast - moves the cursor; the poll goroutine reads last without locking, as it is the only writer
+func (p *Poller) setLast(id api.Snowflake) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.last = id
+}
+
+func (p *Poller) run(ctx context.Context, out chan<- *api.AuditLogEntry) {
+	if p.fetch == nil {
+		p.fetch = p.fetchAuditLog
+	}
+	p.seen = make(map[api.Snowflake]struct{}, seenSize)
+	p.setLast(p.After)
+
+	failures := 0
+	wait := time.Duration(0)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+
+		more, err := p.poll(ctx, out)
+		switch {
+		case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+			return
+		case err != nil:
+			failures++
+			p.error(err)
+			wait = p.backoff(failures)
+		case more:
+			// A full page means there are more entries waiting; fetch them without waiting for the next interval
+			failures, wait = 0, 0
+		default:
+			failures, wait = 0, p.interval()
+		}
+
+		wait = max(wait, p.rateLimitWait())
+	}
+}
+
+// poll - fetches one page of entries after the cursor and emits them, reporting whether the page was full
+func (p *Poller) poll(ctx context.Context, out chan<- *api.AuditLogEntry) (bool, error) {
+	if p.last == "" {
+		// Start from the newest entry without replaying history
+		auditLog, err := p.fetch(nil, 1)
+		if err != nil {
+			return false, err
+		}
+		if len(auditLog.AuditLogEntries) == 0 {
+			p.setLast("0")
+		} else {
+			p.setLast(auditLog.AuditLogEntries[0].ID)
+		}
+		return false, nil
+	}
+
+	after := p.last
+	auditLog, err := p.fetch(&after, pageSize)
+	if err != nil {
+		return false, err
+	}
+
+	entries := auditLog.AuditLogEntries
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID.Compare(entries[j].ID) < 0
+	})
+
+	for _, entry := range entries {
+		if entry.ID.Compare(p.last) <= 0 || p.markSeen(entry.ID) {
+			continue
+		}
+		if !p.matches(entry) {
+			p.setLast(entry.ID)
+			continue
+		}
+
+		select {
+		case out <- entry:
+			p.setLast(entry.ID)
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+	}
+
+	return len(entries) == pageSize, nil
+}
+
+// matches - applies the filters; they are not sent as query parameters, so the cursor keeps moving past entries that are filtered out
+func (p *Poller) matches(entry *api.AuditLogEntry) bool {
+	if p.UserID != nil && (entry.UserID == nil || *entry.UserID != *p.UserID) {
+		return false
+	}
+	if p.ActionType != nil && entry.ActionType != *p.ActionType {
+		return false
+	}
+
+	return true
+}
+
+// markSeen - remembers the most recent entry IDs, reporting whether the ID was already emitted
+func (p *Poller) markSeen(id api.Snowflake) bool {
+	if _, ok := p.seen[id]; ok {
+		return true
+	}
+
+	p.seen[id] = struct{}{}
+	p.order = append(p.order, id)
+	if len(p.order) > seenSize {
+		delete(p.seen, p.order[0])
+		p.order = p.order[1:]
+	}
+
+	return false
+}
+
+func (p *Poller) fetchAuditLog(after *api.Snowflake, limit uint64) (*api.AuditLog, error) {
+	if p.Guild == nil {
+		return nil, errors.New("poller has no guild")
+	}
+
+	return p.Guild.GetGuildAuditLog(nil, nil, nil, after, &limit)
+}
+
+func (p *Poller) interval() time.Duration {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+
+	jitter := p.Jitter
+	if jitter <= 0 {
+		jitter = interval / 10
+	}
+
+	return interval + time.Duration(rand.Int63n(int64(jitter)+1))
+}
+
+// backoff - doubles the interval for every consecutive failure, up to MaxBackoff
+func (p *Poller) backoff(failures int) time.Duration {
+	limit := p.MaxBackoff
+	if limit <= 0 {
+		limit = DefaultMaxBackoff
+	}
+
+	wait := p.interval()
+	for i := 1; i < failures && wait < limit; i++ {
+		wait *= 2
+	}
+
+	return min(wait, limit)
+}
+
+// rateLimitWait - how long until the audit log bucket has requests left, so polling never queues behind the RateLimiter
+func (p *Poller) rateLimitWait() time.Duration {
+	if p.Guild == nil {
+		return 0
+	}
+
+	suffix := "/guilds/" + p.Guild.ID.String() + "/audit-logs"
+	reset := api.Rest.GlobalReset()
+	for _, state := range api.Rest.Buckets() {
+		if strings.HasSuffix(state.Route, suffix) && state.Remaining == 0 && state.Reset.After(reset) {
+			reset = state.Reset
+		}
+	}
+
+	return max(0, time.Until(reset))
+}
+
+func (p *Poller) error(err error) {
+	if p.OnError != nil {
+		p.OnError(err)
+		return
+	}
+
+	log.Errorln(log.Discord, log.FuncName(), err)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package auditlog
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestPollerEmitsNewEntriesOnce(t *testing.T) {
+	pages := [][]api.Snowflake{
+		{"100", "99"},  // initial fetch of the newest entry
+		{"101", "102"}, // ascending, as with after
+		{"102", "103"}, // overlaps the previous page
+	}
+
+	calls := 0
+	p := &Poller{
+		Interval: time.Millisecond,
+		Jitter:   time.Millisecond,
+		fetch: func(after *api.Snowflake, limit uint64) (*api.AuditLog, error) {
+			auditLog := &api.AuditLog{}
+			if calls < len(pages) {
+				for _, id := range pages[calls] {
+					auditLog.AuditLogEntries = append(auditLog.AuditLogEntries, &api.AuditLogEntry{ID: id})
+				}
+			}
+			calls++
+			return auditLog, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var got []api.Snowflake
+	for entry := range p.Start(ctx) {
+		got = append(got, entry.ID)
+		if len(got) == 3 {
+			cancel()
+		}
+	}
+
+	want := []api.Snowflake{"101", "102", "103"}
+	if len(got) != len(want) {
+		t.Fatalf("Start() emitted %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Start() emitted %v, want %v", got, want)
+		}
+	}
+	if p.LastID() != "103" {
+		t.Errorf("LastID() = %q, want %q", p.LastID(), "103")
+	}
+}
+
+func TestPollerLastIDWhilePolling(t *testing.T) {
+	next := 100
+	p := &Poller{
+		Interval: time.Millisecond,
+		Jitter:   time.Millisecond,
+		fetch: func(after *api.Snowflake, limit uint64) (*api.AuditLog, error) {
+			next++
+			return &api.AuditLog{AuditLogEntries: []*api.AuditLogEntry{{ID: api.Snowflake(strconv.Itoa(next))}}}, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	entries := p.Start(ctx)
+	for range entries {
+		if p.LastID() == "" {
+			t.Fatal("LastID() is empty after an entry was emitted")
+		}
+	}
+}
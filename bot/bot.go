@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package bot runs the gateway connections, interactions HTTP server and REST client of a bot together, and shuts them down in order.
+package bot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+)
+
+// CloseNormal - the websocket close code for a normal closure; Discord ends the session and the bot goes offline straight away
+const CloseNormal = int(gateway.CloseNormalClosure)
+
+// DefaultShutdownTimeout - how long Run waits for in-flight work once its context ends, when Bot.ShutdownTimeout is zero
+const DefaultShutdownTimeout = 30 * time.Second
+
+// ErrStopping - returned by Bot.Dispatch for events that arrive after shutdown has begun
+var ErrStopping = errors.New("bot is shutting down")
+
+// DispatchFunc - delivers a Dispatch event to the bot, e.g. from a gateway read loop
+type DispatchFunc func(eventName string, data json.RawMessage) error
+
+// Gateway - a gateway connection, e.g. one shard, owned by a Bot
+type Gateway interface {
+	// Open connects and starts passing Dispatch events to dispatch; it returns once the connection is established
+	Open(ctx context.Context, dispatch DispatchFunc) error
+	// Close sends a close frame with the code and closes the connection
+	Close(code int) error
+}
+
+// Bot - owns the parts of a bot and shuts them down in order when Run's context ends
+//
+// Shutdown stops intake first: gateways are closed and the HTTP server stops accepting requests.
+// It then waits for in-flight event and interaction handlers, lets the Queue finish its jobs, and flushes the requests still waiting on the REST rate limiter.
+type Bot struct {
+	Gateways   []Gateway            // connections opened by Run and closed with CloseCode on shutdown
+	Dispatcher *dispatch.Dispatcher // receives the events passed to Dispatch
+	Pool       *dispatch.Pool       // optional; events are queued on it instead of handled on the gateway's goroutine, and it is closed on shutdown
+	HTTP       *http.Server         // optional; e.g. serving a router for interactions or a webhookevents.Handler
+	Listener   net.Listener         // optional; HTTP serves on it instead of listening on HTTP.Addr
+	Queue      *api.RequestQueue    // optional; closed after handlers finish so queued jobs still run
+	Rest       *api.RateLimiter     // flushed last; api.Rest when nil
+
+	CloseCode       int           // sent to each Gateway on shutdown; CloseNormal when zero
+	ShutdownTimeout time.Duration // DefaultShutdownTimeout when zero
+
+	mu       sync.RWMutex
+	stopping bool
+	inFlight sync.WaitGroup
+}
+
+// Dispatch - Passes an event to the Pool or Dispatcher, tracking it so shutdown waits for its handlers
+//
+// Events that arrive once shutdown has begun are dropped with ErrStopping.
+func (b *Bot) Dispatch(eventName string, data json.RawMessage) error {
+	b.mu.RLock()
+	if b.stopping {
+		b.mu.RUnlock()
+		return ErrStopping
+	}
+	b.inFlight.Add(1)
+	b.mu.RUnlock()
+	defer b.inFlight.Done()
+
+	if b.Pool != nil {
+		return b.Pool.Dispatch(eventName, data)
+	}
+	if b.Dispatcher == nil {
+		return nil
+	}
+
+	return b.Dispatcher.Dispatch(eventName, data)
+}
+
+// Run - Opens the gateways, serves HTTP, and blocks until the context ends or the HTTP server fails, then shuts everything down
+//
+// The returned error joins the failure that stopped the bot, if any, with the errors met while shutting down.
+func (b *Bot) Run(ctx context.Context) error {
+	b.mu.Lock()
+	b.stopping = false
+	b.mu.Unlock()
+
+	var opened []Gateway
+	for _, g := range b.Gateways {
+		if err := g.Open(ctx, b.Dispatch); err != nil {
+			return errors.Join(err, b.shutdown(opened))
+		}
+		opened = append(opened, g)
+	}
+
+	served := make(chan error, 1)
+	if b.HTTP != nil {
+		go func() {
+			var err error
+			if b.Listener != nil {
+				err = b.HTTP.Serve(b.Listener)
+			} else {
+				err = b.HTTP.ListenAndServe()
+			}
+			if errors.Is(err, http.ErrServerClosed) {
+				err = nil
+			}
+			served <- err
+		}()
+	}
+
+	var failure error
+	select {
+	case <-ctx.Done():
+	case failure = <-served:
+	}
+
+	return errors.Join(failure, b.shutdown(opened))
+}
+
+// shutdown stops intake, then drains handlers, the queue and the rate limiter within the shutdown timeout
+func (b *Bot) shutdown(gateways []Gateway) error {
+	b.mu.Lock()
+	b.stopping = true
+	b.mu.Unlock()
+
+	timeout := b.ShutdownTimeout
+	if timeout <= 0 {
+		timeout = DefaultShutdownTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	code := b.CloseCode
+	if code == 0 {
+		code = CloseNormal
+	}
+
+	var errs []error
+	for _, g := range gateways {
+		errs = append(errs, g.Close(code))
+	}
+	if b.HTTP != nil {
+		// Shutdown waits for in-flight requests, so interactions being handled still get their response
+		errs = append(errs, b.HTTP.Shutdown(ctx))
+	}
+
+	errs = append(errs, wait(ctx, b.inFlight.Wait))
+	if b.Pool != nil {
+		errs = append(errs, wait(ctx, b.Pool.Close))
+	}
+	if b.Queue != nil {
+		errs = append(errs, wait(ctx, b.Queue.Close))
+	}
+
+	rest := b.Rest
+	if rest == nil {
+		rest = api.Rest
+	}
+	errs = append(errs, rest.Flush(ctx))
+
+	return errors.Join(errs...)
+}
+
+// wait runs fn, giving up when the context ends; fn keeps running in the background if it does
+func wait(ctx context.Context, fn func()) error {
+	done := make(chan struct{})
+	go func() {
+		fn()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
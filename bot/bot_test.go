@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package bot
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+)
+
+type fakeGateway struct {
+	dispatch DispatchFunc
+	opened   chan struct{}
+	closed   int
+}
+
+func (g *fakeGateway) Open(_ context.Context, dispatch DispatchFunc) error {
+	g.dispatch = dispatch
+	close(g.opened)
+	return nil
+}
+
+func (g *fakeGateway) Close(code int) error {
+	g.closed = code
+	return nil
+}
+
+func TestRunShutdown(t *testing.T) {
+	rest := api.NewRatelimiter()
+	var answered atomic.Bool
+	rest.Use(func(api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			time.Sleep(50 * time.Millisecond)
+			answered.Store(true)
+			return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(nil)), Request: req}, nil
+		}
+	})
+
+	started := make(chan struct{})
+	var handled atomic.Bool
+	d := dispatch.New()
+	d.AddHandler(events.MessageCreate, func(any) {
+		close(started)
+		time.Sleep(50 * time.Millisecond)
+		go func() { _, _ = rest.Request(http.MethodGet, "https://discord.com/api/v10/users/@me", nil, nil) }()
+		for rest.Pending() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		handled.Store(true)
+	})
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gateway := &fakeGateway{opened: make(chan struct{})}
+	b := &Bot{
+		Gateways:   []Gateway{gateway},
+		Dispatcher: d,
+		HTTP:       &http.Server{Handler: http.NotFoundHandler()},
+		Listener:   listener,
+		Rest:       rest,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() { result <- b.Run(ctx) }()
+
+	<-gateway.opened
+	go func() { _ = gateway.dispatch("MESSAGE_CREATE", json.RawMessage(`{"content":"hi"}`)) }()
+	<-started
+	cancel()
+
+	if err := <-result; err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !handled.Load() {
+		t.Error("Run() returned before the in-flight handler finished")
+	}
+	if !answered.Load() || rest.Pending() != 0 {
+		t.Errorf("Run() returned with %d pending requests", rest.Pending())
+	}
+	if gateway.closed != CloseNormal {
+		t.Errorf("Close() code = %d, want %d", gateway.closed, CloseNormal)
+	}
+	if err := gateway.dispatch("MESSAGE_CREATE", json.RawMessage(`{}`)); !errors.Is(err, ErrStopping) {
+		t.Errorf("Dispatch() after shutdown error = %v, want ErrStopping", err)
+	}
+	if _, err := http.Get("http://" + listener.Addr().String()); err == nil {
+		t.Error("HTTP server still serving after shutdown")
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package collectors waits on gateway events for interactive flows, such as confirmation buttons and reaction menus.
+//
+// Collectors are registered on a dispatch.Dispatcher and remove themselves once they finish.
+package collectors
+
+import (
+	"context"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/interactions"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// WaitForComponent - Waits for the first message component interaction that the filter accepts; a nil filter accepts any.
+//
+// Returns ctx.Err() when the context is cancelled or its deadline passes first, so pass a context with a timeout.
+//
+// The interaction must still be responded to within 3 seconds of being returned.
+//
+//goland:noinspection GoUnusedExportedFunction
+func WaitForComponent(ctx context.Context, d *dispatch.Dispatcher, filter func(*api.Interaction) bool) (*api.Interaction, error) {
+	matched := make(chan *api.Interaction, 1)
+
+	remove := dispatch.On(d, events.InteractionCreate, func(event *interactions.Create) {
+		interaction := (*api.Interaction)(event)
+		if interaction.Type != api.InteractionTypeMessageComponent {
+			return
+		}
+		if filter != nil && !filter(interaction) {
+			return
+		}
+
+		select {
+		case matched <- interaction:
+		default:
+		}
+	})
+	defer remove()
+
+	select {
+	case interaction := <-matched:
+		return interaction, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// SendAndWaitForComponent - Sends a message with components and waits for the first interaction with them that the filter accepts.
+//
+// When the context ends first, the message's components are disabled so stale buttons cannot be clicked.
+//
+// An interaction is returned untouched so it can be responded to within 3 seconds; to disable the components as well,
+// respond with UpdateMessage and DisableComponents, or call DisableMessageComponents once the response is sent.
+//
+//goland:noinspection GoUnusedExportedFunction
+func SendAndWaitForComponent(ctx context.Context,
+	d *dispatch.Dispatcher,
+	channel *api.Channel,
+	payload api.CreateMessageJSON,
+	filter func(*api.Interaction) bool) (*api.Interaction, *api.Message, error) {
+	message, err := channel.CreateMessage(payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	interaction, err := WaitForComponent(ctx, d, func(i *api.Interaction) bool {
+		if i.Message == nil || i.Message.ID != message.ID {
+			return false
+		}
+
+		return filter == nil || filter(i)
+	})
+	if err != nil {
+		if _, disableErr := DisableMessageComponents(channel, message); disableErr != nil {
+			log.Errorln(log.Discord, log.FuncName(), disableErr)
+		}
+	}
+
+	return interaction, message, err
+}
+
+// DisableMessageComponents - Edits the message so that every one of its components is disabled
+//
+//goland:noinspection GoUnusedExportedFunction
+func DisableMessageComponents(channel *api.Channel, message *api.Message) (*api.Message, error) {
+	if len(message.Components) == 0 {
+		return message, nil
+	}
+
+	return channel.EditMessage(message.ID.String(), api.EditMessageJSON{Components: DisableComponents(message.Components)})
+}
+
+// DisableComponents - Returns a copy of the components, and any nested inside them, with Disabled set
+//
+//goland:noinspection GoUnusedExportedFunction
+func DisableComponents(components []*api.Component) []*api.Component {
+	disabled := make([]*api.Component, 0, len(components))
+	for _, component := range components {
+		if component == nil {
+			continue
+		}
+
+		c := *component
+		if c.Type != api.ComponentTypeActionRow {
+			c.Disabled = true
+		}
+		c.Components = DisableComponents(component.Components)
+
+		disabled = append(disabled, &c)
+	}
+
+	return disabled
+}
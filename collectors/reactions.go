@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package collectors
+
+import (
+	"context"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/messages"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// reactionBuffer - how many reactions CollectReactions holds for a slow reader when there is no maxReactions
+const reactionBuffer = 100
+
+// CollectReactions - Collects reactions added to the message that the filter accepts, until maxReactions have been collected or the context ends.
+//
+// Reactions are sent on the returned channel, which is closed when collecting stops; a maxReactions of 0 collects until the context ends.
+// The filter runs for every reaction on the message, including the bot's own, so use it to skip those or to accept only certain emoji or users.
+//
+// Without a maxReactions, reactions arriving while 100 are waiting to be read are dropped rather than holding up other event handlers.
+//
+//goland:noinspection GoUnusedExportedFunction
+func CollectReactions(ctx context.Context,
+	d *dispatch.Dispatcher,
+	messageID api.Snowflake,
+	maxReactions int,
+	filter func(*messages.MessageReactionAdd) bool) <-chan *messages.MessageReactionAdd {
+	buffer := maxReactions
+	if buffer <= 0 {
+		buffer = reactionBuffer
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan *messages.MessageReactionAdd, buffer)
+
+	var (
+		mu        sync.Mutex
+		collected int
+		finished  bool
+	)
+
+	remove := dispatch.On(d, events.MessageReactionAdd, func(reaction *messages.MessageReactionAdd) {
+		if reaction.MessageID != messageID {
+			return
+		}
+		if filter != nil && !filter(reaction) {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if finished {
+			return
+		}
+
+		select {
+		case out <- reaction:
+			collected++
+		default:
+			log.Warnln(log.Discord, log.FuncName(), "reaction buffer is full; dropping reaction on", messageID)
+			return
+		}
+
+		if maxReactions > 0 && collected >= maxReactions {
+			cancel()
+		}
+	})
+
+	go func() {
+		<-ctx.Done()
+		remove()
+
+		mu.Lock()
+		finished = true
+		close(out)
+		mu.Unlock()
+	}()
+
+	return out
+}
@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package commands
+
+import (
+	"context"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// Registry - remembers the IDs of the registered chat input commands, so messages can mention them by name
+//
+// Fill it with Registry.Sync, or with Record after registering commands another way. The zero value is ready to use.
+type Registry struct {
+	mu  sync.RWMutex
+	ids map[registryKey]api.Snowflake
+}
+
+type registryKey struct {
+	GuildID api.Snowflake
+	Name    string
+}
+
+// Sync - Runs Sync and records the registered commands, so they can be mentioned by name afterwards
+func (r *Registry) Sync(ctx context.Context, appID api.Snowflake, guildID api.Snowflake, desired []*api.ApplicationCommand) (*SyncResult, error) {
+	result, err := Sync(ctx, appID, guildID, desired)
+	if err != nil {
+		return result, err
+	}
+
+	r.Record(guildID, result.Commands)
+
+	return result, nil
+}
+
+// Record - Replaces the commands recorded for the guild, or the global commands when guildID is empty; only chat input commands can be mentioned, so others are skipped
+func (r *Registry) Record(guildID api.Snowflake, commands []*api.ApplicationCommand) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.ids == nil {
+		r.ids = make(map[registryKey]api.Snowflake)
+	}
+	for key := range r.ids {
+		if key.GuildID == guildID {
+			delete(r.ids, key)
+		}
+	}
+	for _, command := range commands {
+		if keyOf(command).Type == api.CommandTypeChatInput && command.ID != "" {
+			r.ids[registryKey{GuildID: guildID, Name: command.Name}] = command.ID
+		}
+	}
+}
+
+// ID - Returns the ID of the named command in the guild, falling back to the global command of that name; use an empty guildID for global commands only
+func (r *Registry) ID(guildID api.Snowflake, name string) (api.Snowflake, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if guildID != "" {
+		if id, ok := r.ids[registryKey{GuildID: guildID, Name: name}]; ok {
+			return id, true
+		}
+	}
+	id, ok := r.ids[registryKey{Name: name}]
+
+	return id, ok
+}
+
+// Mention - Returns a mention of the global command, or plain "/name subcommand" text when the command has not been recorded
+func (r *Registry) Mention(name, subcommand string) string {
+	return r.MentionIn("", name, subcommand)
+}
+
+// MentionIn - Returns a mention of the command as seen in the guild, preferring a guild command over a global one of the same name
+//
+// It returns plain "/name subcommand" text when the command has not been recorded, so the message still reads correctly.
+func (r *Registry) MentionIn(guildID api.Snowflake, name, subcommand string) string {
+	id, ok := r.ID(guildID, name)
+	if !ok {
+		if subcommand != "" {
+			return "/" + name + " " + subcommand
+		}
+		return "/" + name
+	}
+
+	return api.CommandMention(name, subcommand, id)
+}
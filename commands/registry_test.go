@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package commands
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/discordtest"
+)
+
+func TestRegistry(t *testing.T) {
+	s := discordtest.Start(t)
+	s.Reply(http.MethodGet, "/applications/{application.id}/commands", http.StatusOK,
+		`[{"id":"1","type":1,"name":"ping","description":"Pong!"},{"id":"3","type":2,"name":"Profile"}]`)
+	s.Reply(http.MethodPost, "/applications/{application.id}/commands", http.StatusOK,
+		`{"id":"2","type":1,"name":"help","description":"Lists the commands"}`)
+
+	var registry Registry
+	desired := []*api.ApplicationCommand{
+		{Name: "ping", Description: "Pong!"},
+		{Type: api.CommandTypeUser, Name: "Profile"},
+		{Name: "help", Description: "Lists the commands"},
+	}
+	if _, err := registry.Sync(context.Background(), "10", "", desired); err != nil {
+		t.Fatalf("Registry.Sync() error = %v", err)
+	}
+	registry.Record("20", []*api.ApplicationCommand{{ID: "4", Type: api.CommandTypeChatInput, Name: "ping"}})
+
+	tests := []struct {
+		name       string
+		guildID    api.Snowflake
+		command    string
+		subcommand string
+		want       string
+	}{
+		{name: "registered", command: "ping", want: "</ping:1>"},
+		{name: "created during sync", command: "help", subcommand: "commands", want: "</help commands:2>"},
+		{name: "guild command", guildID: "20", command: "ping", want: "</ping:4>"},
+		{name: "global fallback", guildID: "20", command: "help", want: "</help:2>"},
+		{name: "not a chat input command", command: "Profile", want: "/Profile"},
+		{name: "unknown", command: "stats", subcommand: "daily", want: "/stats daily"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registry.MentionIn(tt.guildID, tt.command, tt.subcommand); got != tt.want {
+				t.Errorf("MentionIn() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package commands
+
+import (
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func fetch(appID, guildID api.Snowflake) ([]*api.ApplicationCommand, error) {
+	if guildID == "" {
+		return api.GetGlobalApplicationCommands(appID, true)
+	}
+
+	return api.GetGuildApplicationCommands(&appID, &guildID, true)
+}
+
+func bulkOverwrite(appID, guildID api.Snowflake, desired []*api.ApplicationCommand) ([]*api.ApplicationCommand, error) {
+	if guildID == "" {
+		return api.BulkOverwriteGlobalApplicationCommands(&appID, desired)
+	}
+
+	return api.BulkOverwriteGuildApplicationCommands(&appID, &guildID, desired)
+}
+
+func create(appID, guildID api.Snowflake, command *api.ApplicationCommand) (*api.ApplicationCommand, error) {
+	payload := api.CreateApplicationCommandJSON{
+		Name:                     command.Name,
+		NameLocalizations:        command.NameLocalizations,
+		Description:              command.Description,
+		DescriptionLocalizations: command.DescriptionLocalizations,
+		Options:                  command.Options,
+		DefaultMemberPermissions: command.DefaultMemberPermissions,
+		DmPermission:             command.DmPermission,
+		Type:                     command.Type,
+	}
+
+	if guildID == "" {
+		return api.CreateGlobalApplicationCommand(appID, payload)
+	}
+
+	return api.CreateGuildApplicationCommand(&appID, &guildID, &payload)
+}
+
+func edit(appID, guildID api.Snowflake, command *api.ApplicationCommand) error {
+	payload := api.EditApplicationCommandJSON{
+		Name:                     command.Name,
+		NameLocalizations:        command.NameLocalizations,
+		Description:              command.Description,
+		DescriptionLocalizations: command.DescriptionLocalizations,
+		Options:                  command.Options,
+		DefaultMemberPermissions: command.DefaultMemberPermissions,
+		DmPermission:             command.DmPermission,
+	}
+
+	var err error
+	if guildID == "" {
+		_, err = api.EditGlobalApplicationCommand(appID, command.ID.String(), payload)
+	} else {
+		_, err = api.EditGuildApplicationCommand(&appID, &guildID, command.ID.String(), &payload)
+	}
+
+	return err
+}
+
+func remove(appID, guildID api.Snowflake, command *api.ApplicationCommand) error {
+	if guildID == "" {
+		return api.DeleteGlobalApplicationCommand(&appID, command.ID.String())
+	}
+
+	return api.DeleteGuildApplicationCommand(&appID, &guildID, command.ID.String())
+}
@@ -0,0 +1,191 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package commands keeps an application's registered commands in step with the set it declares.
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// SyncResult - what Sync changed
+type SyncResult struct {
+	Created         []*api.ApplicationCommand // commands that were not registered yet
+	Updated         []*api.ApplicationCommand // registered commands that differed from the desired definition
+	Deleted         []*api.ApplicationCommand // registered commands that are no longer desired
+	Unchanged       int                       // registered commands that already matched
+	BulkOverwritten bool                      // whether the changes were applied with a single bulk overwrite
+	Commands        []*api.ApplicationCommand // the commands registered after the sync, with their IDs
+}
+
+// Changed - Checks whether Sync made any request that changed the registered commands
+func (r *SyncResult) Changed() bool {
+	return len(r.Created)+len(r.Updated)+len(r.Deleted) > 0
+}
+
+// Sync - Registers the desired commands for the application, globally when guildID is empty, issuing only the requests needed.
+//
+// The registered commands are fetched and compared with the desired ones by type and name. Nothing is sent when they match;
+// a single change is made with a create, edit or delete, and several changes with one bulk overwrite.
+func Sync(ctx context.Context, appID api.Snowflake, guildID api.Snowflake, desired []*api.ApplicationCommand) (*SyncResult, error) {
+	registered, err := fetch(appID, guildID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := diff(registered, desired)
+	if !result.Changed() {
+		result.Commands = registered
+		return result, nil
+	}
+
+	if err = ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(result.Created)+len(result.Updated)+len(result.Deleted) > 1 {
+		result.BulkOverwritten = true
+		result.Commands, err = bulkOverwrite(appID, guildID, desired)
+		return result, err
+	}
+
+	switch {
+	case len(result.Created) == 1:
+		var created *api.ApplicationCommand
+		if created, err = create(appID, guildID, result.Created[0]); err == nil {
+			result.Commands = append(registered, created)
+		}
+	case len(result.Updated) == 1:
+		if err = edit(appID, guildID, result.Updated[0]); err == nil {
+			result.Commands = append(without(registered, result.Updated[0].ID), result.Updated[0])
+		}
+	case len(result.Deleted) == 1:
+		if err = remove(appID, guildID, result.Deleted[0]); err == nil {
+			result.Commands = without(registered, result.Deleted[0].ID)
+		}
+	}
+
+	return result, err
+}
+
+// without - Returns the commands other than the one with the given ID
+func without(commands []*api.ApplicationCommand, id api.Snowflake) []*api.ApplicationCommand {
+	kept := make([]*api.ApplicationCommand, 0, len(commands))
+	for _, command := range commands {
+		if command.ID != id {
+			kept = append(kept, command)
+		}
+	}
+
+	return kept
+}
+
+type commandKey struct {
+	Type api.ApplicationCommandType
+	Name string
+}
+
+func keyOf(command *api.ApplicationCommand) commandKey {
+	commandType := command.Type
+	if commandType == 0 {
+		commandType = api.CommandTypeChatInput
+	}
+
+	return commandKey{Type: commandType, Name: command.Name}
+}
+
+// diff - Compares the registered commands with the desired ones; Updated holds the desired definitions with the registered IDs
+func diff(registered, desired []*api.ApplicationCommand) *SyncResult {
+	result := &SyncResult{}
+
+	existing := make(map[commandKey]*api.ApplicationCommand, len(registered))
+	for _, command := range registered {
+		existing[keyOf(command)] = command
+	}
+
+	for _, command := range desired {
+		key := keyOf(command)
+
+		current, ok := existing[key]
+		if !ok {
+			result.Created = append(result.Created, command)
+			continue
+		}
+		delete(existing, key)
+
+		if equal(current, command) {
+			result.Unchanged++
+			continue
+		}
+
+		updated := *command
+		updated.ID = current.ID
+		result.Updated = append(result.Updated, &updated)
+	}
+
+	for _, command := range registered {
+		if _, ok := existing[keyOf(command)]; ok {
+			result.Deleted = append(result.Deleted, command)
+		}
+	}
+
+	return result
+}
+
+// commandSpec - the fields of a command that can be changed by registering it, with Discord's defaults filled in
+type commandSpec struct {
+	Type                     api.ApplicationCommandType      `json:"type"`
+	Name                     string                          `json:"name"`
+	NameLocalizations        api.LocalizationMap             `json:"name_localizations,omitempty"`
+	Description              string                          `json:"description"`
+	DescriptionLocalizations api.LocalizationMap             `json:"description_localizations,omitempty"`
+	Options                  []*api.ApplicationCommandOption `json:"options,omitempty"`
+	DefaultMemberPermissions string                          `json:"default_member_permissions,omitempty"`
+	DmPermission             bool                            `json:"dm_permission"`
+}
+
+func specOf(command *api.ApplicationCommand) commandSpec {
+	spec := commandSpec{
+		Type:                     keyOf(command).Type,
+		Name:                     command.Name,
+		NameLocalizations:        command.NameLocalizations,
+		Description:              command.Description,
+		DescriptionLocalizations: command.DescriptionLocalizations,
+		Options:                  command.Options,
+		DmPermission:             command.DmPermission == nil || *command.DmPermission,
+	}
+	if command.DefaultMemberPermissions != nil {
+		spec.DefaultMemberPermissions = *command.DefaultMemberPermissions
+	}
+
+	return spec
+}
+
+// equal - Compares the JSON of both commands, so that numbers decoded from Discord compare equal to the ints they were declared with
+func equal(a, b *api.ApplicationCommand) bool {
+	left, err := json.Marshal(specOf(a))
+	if err != nil {
+		return false
+	}
+	right, err := json.Marshal(specOf(b))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(left, right)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package commands
+
+import (
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestDiff(t *testing.T) {
+	dmPermission := true
+	registered := []*api.ApplicationCommand{
+		{ID: "1", Type: api.CommandTypeChatInput, Name: "ping", Description: "Pong!", DmPermission: &dmPermission},
+		{ID: "2", Type: api.CommandTypeChatInput, Name: "echo", Description: "Repeats you"},
+		{ID: "3", Type: api.CommandTypeUser, Name: "Profile"},
+	}
+	desired := []*api.ApplicationCommand{
+		{Name: "ping", Description: "Pong!"},
+		{Name: "echo", Description: "Repeats what you say"},
+		{Name: "help", Description: "Lists the commands"},
+	}
+
+	got := diff(registered, desired)
+
+	if got.Unchanged != 1 {
+		t.Errorf("diff() Unchanged = %d, want 1", got.Unchanged)
+	}
+	if len(got.Created) != 1 || got.Created[0].Name != "help" {
+		t.Errorf("diff() Created = %v, want [help]", got.Created)
+	}
+	if len(got.Updated) != 1 || got.Updated[0].Name != "echo" || got.Updated[0].ID != "2" {
+		t.Errorf("diff() Updated = %v, want [echo] with ID 2", got.Updated)
+	}
+	if len(got.Deleted) != 1 || got.Deleted[0].Name != "Profile" {
+		t.Errorf("diff() Deleted = %v, want [Profile]", got.Deleted)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package customid packs a name and a few values into a component custom_id, optionally signed so users cannot tamper with them.
+//
+// An encoded custom ID looks like "poll?id=123&page=2", followed by "~" and a signature when a key is used.
+package customid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+var (
+	// ErrInvalid - returned when a custom ID was not made by Encode, or its name is empty or contains '?' or '~'
+	ErrInvalid = errors.New("custom id is not in the customid format")
+	// ErrBadSignature - returned when a signed custom ID's signature is missing or does not match its contents
+	ErrBadSignature = errors.New("custom id signature does not match")
+)
+
+// signatureSize - the bytes of the HMAC kept in a custom ID; 9 bytes encode to 12 characters
+const signatureSize = 9
+
+// Codec - encodes and decodes custom IDs, signing them when it has a key
+//
+// The zero value encodes unsigned custom IDs.
+type Codec struct {
+	key []byte
+}
+
+// NewCodec - Creates a Codec that signs custom IDs with HMAC-SHA256 under the key and rejects unsigned or altered ones
+//
+// Keep the key secret and the same across restarts, or the components already sent stop working.
+func NewCodec(key []byte) *Codec {
+	return &Codec{key: append([]byte(nil), key...)}
+}
+
+var unsigned = &Codec{}
+
+// Encode - Packs the name and values into an unsigned custom ID
+//
+//goland:noinspection GoUnusedExportedFunction
+func Encode(name string, values map[string]string) (string, error) {
+	return unsigned.Encode(name, values)
+}
+
+// Decode - Unpacks an unsigned custom ID made by Encode
+//
+//goland:noinspection GoUnusedExportedFunction
+func Decode(customID string) (name string, values map[string]string, err error) {
+	return unsigned.Decode(customID)
+}
+
+// Name - Returns the name of a custom ID made by Encode, without checking its values or signature
+func Name(customID string) string {
+	name, _, _ := strings.Cut(customID, "?")
+	name, _, _ = strings.Cut(name, "~")
+
+	return name
+}
+
+// Encode - Packs the name and values into a custom ID, returning an *api.LimitError if it would be longer than api.CustomIDLimit
+func (c *Codec) Encode(name string, values map[string]string) (string, error) {
+	if name == "" || strings.ContainsAny(name, "?~") {
+		return "", ErrInvalid
+	}
+
+	query := make(url.Values, len(values))
+	for key, value := range values {
+		query.Set(key, value)
+	}
+
+	customID := name
+	if len(query) > 0 {
+		customID += "?" + query.Encode()
+	}
+	if len(c.key) > 0 {
+		customID += "~" + c.sign(customID)
+	}
+
+	if len(customID) > api.CustomIDLimit {
+		return "", &api.LimitError{Field: fmt.Sprintf("custom_id %q", name), Length: len(customID), Limit: api.CustomIDLimit}
+	}
+
+	return customID, nil
+}
+
+// Decode - Unpacks a custom ID made by Encode, checking its signature when the Codec has a key
+func (c *Codec) Decode(customID string) (name string, values map[string]string, err error) {
+	body := customID
+	if len(c.key) > 0 {
+		var signature string
+		var found bool
+		body, signature, found = cutLast(customID, "~")
+		if !found || !hmac.Equal([]byte(signature), []byte(c.sign(body))) {
+			return "", nil, ErrBadSignature
+		}
+	}
+
+	name, encoded, _ := strings.Cut(body, "?")
+	if name == "" || strings.Contains(name, "~") {
+		return "", nil, ErrInvalid
+	}
+
+	query, err := url.ParseQuery(encoded)
+	if err != nil {
+		return "", nil, ErrInvalid
+	}
+
+	values = make(map[string]string, len(query))
+	for key := range query {
+		values[key] = query.Get(key)
+	}
+
+	return name, values, nil
+}
+
+func (c *Codec) sign(body string) string {
+	mac := hmac.New(sha256.New, c.key)
+	mac.Write([]byte(body))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:signatureSize])
+}
+
+func cutLast(s, sep string) (before, after string, found bool) {
+	if i := strings.LastIndex(s, sep); i >= 0 {
+		return s[:i], s[i+len(sep):], true
+	}
+
+	return s, "", false
+}
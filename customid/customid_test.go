@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package customid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestCodec(t *testing.T) {
+	signed := NewCodec([]byte("secret"))
+
+	tests := []struct {
+		name   string
+		codec  *Codec
+		values map[string]string
+	}{
+		{"unsigned", unsigned, map[string]string{"id": "123", "page": "2"}},
+		{"signed", signed, map[string]string{"id": "123", "note": "a&b=c ~d"}},
+		{"no values", signed, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			customID, err := tt.codec.Encode("poll", tt.values)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if Name(customID) != "poll" {
+				t.Errorf("Name(%q) = %q", customID, Name(customID))
+			}
+
+			name, values, err := tt.codec.Decode(customID)
+			if err != nil || name != "poll" || len(values) != len(tt.values) {
+				t.Fatalf("Decode(%q) = %q, %v, %v", customID, name, values, err)
+			}
+			for key, want := range tt.values {
+				if values[key] != want {
+					t.Errorf("Decode(%q)[%s] = %q, want %q", customID, key, values[key], want)
+				}
+			}
+		})
+	}
+
+	if customID, _ := Encode("poll", map[string]string{"id": "123"}); customID != "poll?id=123" {
+		t.Errorf("Encode() = %q, want poll?id=123", customID)
+	}
+
+	customID, _ := signed.Encode("poll", map[string]string{"id": "123"})
+	for _, tampered := range []string{strings.Replace(customID, "123", "124", 1), "poll?id=123", customID + "x"} {
+		if _, _, err := signed.Decode(tampered); !errors.Is(err, ErrBadSignature) {
+			t.Errorf("Decode(%q) error = %v, want ErrBadSignature", tampered, err)
+		}
+	}
+	if _, _, err := NewCodec([]byte("other")).Decode(customID); !errors.Is(err, ErrBadSignature) {
+		t.Errorf("Decode() with another key error = %v, want ErrBadSignature", err)
+	}
+
+	var limit *api.LimitError
+	if _, err := Encode("poll", map[string]string{"data": strings.Repeat("x", 100)}); !errors.As(err, &limit) {
+		t.Errorf("Encode() of a long value error = %v, want a LimitError", err)
+	}
+	if _, err := Encode("a?b", nil); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Encode() of a name with '?' error = %v, want ErrInvalid", err)
+	}
+}
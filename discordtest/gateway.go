@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package discordtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/send"
+)
+
+// defaultHeartbeatInterval - the interval Discord usually sends in Hello
+const defaultHeartbeatInterval = 41250 * time.Millisecond
+
+// Event - a dispatch event the Gateway sends
+type Event struct {
+	Name events.RawType // the event name, e.g. events.MessageCreate
+	Data any            // the event's data, sent as JSON
+}
+
+// Frame - a payload the Gateway received from a client
+type Frame struct {
+	Op gateway.OpCode  `json:"op"` // the opcode of the command
+	D  json.RawMessage `json:"d"`  // the command's data
+}
+
+// Gateway - a fake of the Discord gateway, serving JSON-encoded payloads without compression
+//
+// Each connection is sent Hello, then READY and the scripted events once it identifies, or RESUMED once it resumes a session
+// the Gateway started. Heartbeats are acknowledged; every payload a client sends is kept for Received.
+type Gateway struct {
+	*httptest.Server
+
+	HeartbeatInterval time.Duration           // sent in Hello; defaults to 41.25 seconds
+	User              api.User                // the bot user sent in READY
+	Guilds            []*api.UnavailableGuild // the guilds sent in READY
+
+	mu       sync.Mutex
+	script   []Event
+	conns    map[*gatewayConn]struct{}
+	sessions map[string]bool
+	received []Frame
+}
+
+type gatewayConn struct {
+	ws        *wsConn
+	sessionID string
+	seq       int
+	ready     bool
+}
+
+// NewGateway - Starts a fake gateway; connect to WebsocketURL and Close it when done
+func NewGateway() *Gateway {
+	g := &Gateway{conns: map[*gatewayConn]struct{}{}, sessions: map[string]bool{}}
+	g.Server = httptest.NewServer(http.HandlerFunc(g.serveHTTP))
+
+	return g
+}
+
+// WebsocketURL - Returns the ws:// URL to connect to, in place of the URL returned by Get Gateway
+func (g *Gateway) WebsocketURL() string {
+	return "ws" + strings.TrimPrefix(g.URL, "http")
+}
+
+// Script - Queues events to send to every new session right after READY, in order
+func (g *Gateway) Script(events ...Event) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.script = append(g.script, events...)
+}
+
+// Emit - Sends a dispatch event to every connection that has identified or resumed
+func (g *Gateway) Emit(name events.RawType, data any) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for c := range g.conns {
+		if !c.ready {
+			continue
+		}
+		if err := c.dispatch(name, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Reconnect - Asks every connection to reconnect and resume, as Discord does before moving sessions to another host
+func (g *Gateway) Reconnect() error {
+	return g.broadcast(gateway.Reconnect, nil)
+}
+
+// InvalidateSession - Tells every connection its session is invalid, and whether it may resume
+func (g *Gateway) InvalidateSession(resumable bool) error {
+	return g.broadcast(gateway.InvalidSession, resumable)
+}
+
+// Disconnect - Closes every connection with the close code, e.g. 4000 to test resuming or 4004 to test giving up
+func (g *Gateway) Disconnect(code int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for c := range g.conns {
+		_ = c.ws.writeClose(code, "")
+		delete(g.conns, c)
+	}
+}
+
+// Connections - Returns the number of open connections
+func (g *Gateway) Connections() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return len(g.conns)
+}
+
+// Received - Returns every payload clients sent, oldest first
+func (g *Gateway) Received() []Frame {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]Frame(nil), g.received...)
+}
+
+func (g *Gateway) broadcast(op gateway.OpCode, data any) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for c := range g.conns {
+		if err := c.send(map[string]any{"op": op, "d": data}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *Gateway) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	ws, err := upgrade(w, req)
+	if err != nil {
+		return
+	}
+	c := &gatewayConn{ws: ws}
+
+	interval := g.HeartbeatInterval
+	if interval <= 0 {
+		interval = defaultHeartbeatInterval
+	}
+
+	g.mu.Lock()
+	g.conns[c] = struct{}{}
+	err = c.send(map[string]any{"op": gateway.Hello, "d": receive.Hello{HeartbeatInterval: int(interval.Milliseconds())}})
+	g.mu.Unlock()
+
+	for err == nil {
+		var message []byte
+		if message, err = ws.readMessage(); err != nil {
+			break
+		}
+
+		var frame Frame
+		if json.Unmarshal(message, &frame) != nil {
+			_ = ws.writeClose(4002, "Decode Error")
+			break
+		}
+		err = g.handle(c, frame)
+	}
+
+	g.mu.Lock()
+	delete(g.conns, c)
+	g.mu.Unlock()
+	_ = ws.conn.Close()
+}
+
+func (g *Gateway) handle(c *gatewayConn, frame Frame) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.received = append(g.received, frame)
+
+	switch frame.Op {
+	case gateway.Heartbeat:
+		return c.send(map[string]any{"op": gateway.HeartbeatAck})
+	case gateway.Identify:
+		var identify send.Identify
+		if err := json.Unmarshal(frame.D, &identify); err != nil {
+			return c.ws.writeClose(4002, "Decode Error")
+		}
+
+		c.sessionID = "session-" + strconv.Itoa(len(g.sessions)+1)
+		c.seq = 0
+		g.sessions[c.sessionID] = true
+
+		guilds := g.Guilds
+		if guilds == nil {
+			guilds = []*api.UnavailableGuild{}
+		}
+		ready := receive.Ready{
+			V:                gateway.Version,
+			User:             g.User,
+			Guilds:           guilds,
+			SessionID:        c.sessionID,
+			ResumeGatewayURL: g.WebsocketURL(),
+			Application:      api.Application{ID: g.User.ID},
+		}
+		if identify.Shard != nil {
+			ready.Shard = *identify.Shard
+		}
+
+		return c.start(events.Ready, ready, g.script)
+	case gateway.Resume:
+		var resume send.Resume
+		if err := json.Unmarshal(frame.D, &resume); err != nil {
+			return c.ws.writeClose(4002, "Decode Error")
+		}
+		if !g.sessions[resume.SessionID] {
+			return c.send(map[string]any{"op": gateway.InvalidSession, "d": false})
+		}
+
+		c.sessionID, c.seq = resume.SessionID, resume.Seq
+
+		return c.start(events.Resumed, struct{}{}, nil)
+	}
+
+	return nil
+}
+
+// start sends READY or RESUMED and the scripted events; g.mu must be held
+func (c *gatewayConn) start(name events.RawType, data any, script []Event) error {
+	c.ready = true
+	if err := c.dispatch(name, data); err != nil {
+		return err
+	}
+	for _, event := range script {
+		if err := c.dispatch(event.Name, event.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *gatewayConn) dispatch(name events.RawType, data any) error {
+	c.seq++
+
+	return c.send(map[string]any{"op": gateway.Dispatch, "s": c.seq, "t": name, "d": data})
+}
+
+func (c *gatewayConn) send(payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return c.ws.writeFrame(opText, data)
+}
@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package discordtest
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/send"
+)
+
+type dispatchPayload struct {
+	Op gateway.OpCode  `json:"op"`
+	S  int             `json:"s"`
+	T  string          `json:"t"`
+	D  json.RawMessage `json:"d"`
+}
+
+// dial connects a websocket client to the gateway
+func dial(t *testing.T, g *Gateway) *wsConn {
+	t.Helper()
+
+	host := strings.TrimPrefix(g.WebsocketURL(), "ws://")
+	conn, err := net.Dial("tcp", host)
+	if err != nil {
+		t.Fatalf("net.Dial() error = %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	_, _ = conn.Write([]byte("GET /?v=10&encoding=json HTTP/1.1\r\nHost: " + host + "\r\nUpgrade: websocket\r\nConnection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\nSec-WebSocket-Version: 13\r\n\r\n"))
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatalf("http.ReadResponse() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols || resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("handshake = %s %v, want 101 with the RFC 6455 accept key", resp.Status, resp.Header)
+	}
+
+	return &wsConn{conn: conn, r: r, client: true}
+}
+
+func read(t *testing.T, c *wsConn) dispatchPayload {
+	t.Helper()
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	message, err := c.readMessage()
+	if err != nil {
+		t.Fatalf("readMessage() error = %v", err)
+	}
+
+	var payload dispatchPayload
+	if err = json.Unmarshal(message, &payload); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", message, err)
+	}
+
+	return payload
+}
+
+func write(t *testing.T, c *wsConn, command send.Command) {
+	t.Helper()
+
+	data, err := send.Marshal(command)
+	if err != nil {
+		t.Fatalf("send.Marshal() error = %v", err)
+	}
+	if err = c.writeFrame(opText, data); err != nil {
+		t.Fatalf("writeFrame() error = %v", err)
+	}
+}
+
+func TestGateway(t *testing.T) {
+	g := NewGateway()
+	defer g.Close()
+	g.User = api.User{ID: "1", Username: "bot"}
+	g.Script(Event{Name: events.GuildCreate, Data: map[string]any{"id": "2"}})
+
+	c := dial(t, g)
+	if hello := read(t, c); hello.Op != gateway.Hello || !strings.Contains(string(hello.D), `"heartbeat_interval":41250`) {
+		t.Fatalf("first payload = %+v, want Hello", hello)
+	}
+
+	write(t, c, send.Identify{Token: "token", Shard: &[2]int{0, 1}})
+	ready := read(t, c)
+	var data struct {
+		SessionID string `json:"session_id"`
+		User      api.User
+	}
+	_ = json.Unmarshal(ready.D, &data)
+	if ready.T != string(events.Ready) || ready.S != 1 || data.SessionID == "" || data.User.Username != "bot" {
+		t.Fatalf("payload after Identify = %+v, want READY", ready)
+	}
+	if guild := read(t, c); guild.T != string(events.GuildCreate) || guild.S != 2 {
+		t.Errorf("scripted payload = %+v, want GUILD_CREATE with s 2", guild)
+	}
+
+	write(t, c, send.Heartbeat{})
+	if ack := read(t, c); ack.Op != gateway.HeartbeatAck {
+		t.Errorf("payload after Heartbeat = %+v, want a HeartbeatAck", ack)
+	}
+
+	if err := g.Emit(events.MessageCreate, map[string]any{"content": "hi"}); err != nil {
+		t.Fatalf("Emit() error = %v", err)
+	}
+	if message := read(t, c); message.T != string(events.MessageCreate) || message.S != 3 {
+		t.Errorf("emitted payload = %+v, want MESSAGE_CREATE with s 3", message)
+	}
+
+	g.Disconnect(4000)
+	_ = c.conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, err := c.readMessage(); !errors.Is(err, errClosed) || c.closeCode != 4000 {
+		t.Fatalf("readMessage() after Disconnect = %v with code %d, want a 4000 close", err, c.closeCode)
+	}
+
+	c = dial(t, g)
+	read(t, c)
+	write(t, c, send.Resume{Token: "token", SessionID: data.SessionID, Seq: 3})
+	if resumed := read(t, c); resumed.T != string(events.Resumed) || resumed.S != 4 {
+		t.Errorf("payload after Resume = %+v, want RESUMED with s 4", resumed)
+	}
+
+	if frames := g.Received(); len(frames) != 3 || frames[0].Op != gateway.Identify || frames[2].Op != gateway.Resume {
+		t.Errorf("Received() = %+v, want Identify, Heartbeat and Resume", frames)
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package discordtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Fixture - a captured Discord payload, read from a golden file
+type Fixture struct {
+	Name string // the file name without the .json extension
+	Data []byte // the payload
+}
+
+// RoundTripFunc - decodes a payload into a type, encodes it again and returns the paths of the values that were lost; see RoundTrip
+type RoundTripFunc func(data []byte) ([]string, error)
+
+// LoadFixtures - Returns the .json files in dir, sorted by name; use os.DirFS for a directory on disk or an embed.FS
+func LoadFixtures(fsys fs.FS, dir string) ([]Fixture, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures []Fixture
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok {
+			continue
+		}
+
+		data, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fixtures = append(fixtures, Fixture{Name: name, Data: data})
+	}
+
+	return fixtures, nil
+}
+
+// RoundTrip - Decodes data into a T, encodes it again and returns the paths of the values that were lost or changed on the way
+//
+// A field the payload sends as null, false, 0, "" or empty is not reported when it is omitted, and timestamps are compared
+// as instants, so only data a bot would actually lose is reported, e.g. a field T does not model.
+func RoundTrip[T any](data []byte) ([]string, error) {
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	before, err := decodeTree(data)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeTree(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	var lost []string
+	compareTrees("$", before, after, &lost)
+	sort.Strings(lost)
+
+	return lost, nil
+}
+
+// RunGolden - Runs a subtest for every fixture in each directory of fsys, round-tripping it with the directory's RoundTripFunc
+//
+// A directory is named for the type its payloads decode into, e.g. {"message": discordtest.RoundTrip[api.Message]}.
+func RunGolden(t *testing.T, fsys fs.FS, types map[string]RoundTripFunc) {
+	t.Helper()
+
+	dirs := make([]string, 0, len(types))
+	for dir := range types {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+
+	for _, dir := range dirs {
+		fixtures, err := LoadFixtures(fsys, dir)
+		if err != nil {
+			t.Errorf("LoadFixtures(%s) error = %v", dir, err)
+			continue
+		}
+		if len(fixtures) == 0 {
+			t.Errorf("LoadFixtures(%s) found no fixtures", dir)
+		}
+
+		roundTrip := types[dir]
+		for _, fixture := range fixtures {
+			t.Run(dir+"/"+fixture.Name, func(t *testing.T) {
+				lost, err := roundTrip(fixture.Data)
+				if err != nil {
+					t.Fatalf("round trip error = %v", err)
+				}
+				for _, field := range lost {
+					t.Errorf("%s was lost in the round trip", field)
+				}
+			})
+		}
+	}
+}
+
+func decodeTree(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var tree any
+	err := decoder.Decode(&tree)
+
+	return tree, err
+}
+
+func compareTrees(at string, before, after any, lost *[]string) {
+	switch b := before.(type) {
+	case map[string]any:
+		a, _ := after.(map[string]any)
+		if a == nil && len(b) > 0 {
+			*lost = append(*lost, at)
+			return
+		}
+
+		for key, value := range b {
+			field := at + "." + key
+			if next, ok := a[key]; ok {
+				compareTrees(field, value, next, lost)
+			} else if !isZero(value) {
+				*lost = append(*lost, field)
+			}
+		}
+	case []any:
+		a, _ := after.([]any)
+		if len(a) != len(b) {
+			if len(b) > 0 {
+				*lost = append(*lost, fmt.Sprintf("%s (%d of %d elements)", at, len(a), len(b)))
+			}
+			return
+		}
+		for i := range b {
+			compareTrees(fmt.Sprintf("%s[%d]", at, i), b[i], a[i], lost)
+		}
+	default:
+		if !sameValue(before, after) && !(isZero(before) && isZero(after)) {
+			*lost = append(*lost, at)
+		}
+	}
+}
+
+func sameValue(before, after any) bool {
+	if before == after {
+		return true
+	}
+
+	// Numbers may be re-encoded with a different spelling, and timestamps with a different precision or zone
+	b, a := fmt.Sprint(before), fmt.Sprint(after)
+	if _, isNumber := before.(json.Number); isNumber {
+		var x, y float64
+		_, errX := fmt.Sscan(b, &x)
+		_, errY := fmt.Sscan(a, &y)
+		return errX == nil && errY == nil && x == y
+	}
+	if bt, err := time.Parse(time.RFC3339Nano, b); err == nil {
+		at, err := time.Parse(time.RFC3339Nano, a)
+		return err == nil && bt.Equal(at)
+	}
+
+	return false
+}
+
+func isZero(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case string:
+		return v == ""
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+
+	return false
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package discordtest
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+type goldenUser struct {
+	ID       string     `json:"id"`
+	Bot      bool       `json:"bot,omitempty"`
+	JoinedAt *time.Time `json:"joined_at,omitempty"`
+	Roles    []string   `json:"roles"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{"complete", `{"id":"1","bot":true,"roles":["2"]}`, nil},
+		{"zero values omitted", `{"id":"1","bot":false,"roles":[],"joined_at":null}`, nil},
+		{"timestamp precision", `{"id":"1","roles":[],"joined_at":"2015-04-26T06:26:56.936000+00:00"}`, nil},
+		{"unmodelled fields", `{"id":"1","roles":[],"nick":"nelly","avatar":{"hash":"a"},"flags":0}`, []string{"$.avatar", "$.nick"}},
+	}
+	for _, tt := range tests {
+		lost, err := RoundTrip[goldenUser]([]byte(tt.data))
+		if err != nil {
+			t.Fatalf("RoundTrip(%s) error = %v", tt.name, err)
+		}
+		if strings.Join(lost, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("RoundTrip(%s) = %v, want %v", tt.name, lost, tt.want)
+		}
+	}
+}
+
+func TestLoadFixtures(t *testing.T) {
+	fsys := fstest.MapFS{
+		"user/b.json":    {Data: []byte(`{"id":"2"}`)},
+		"user/a.json":    {Data: []byte(`{"id":"1"}`)},
+		"user/notes.txt": {Data: []byte("ignored")},
+	}
+
+	fixtures, err := LoadFixtures(fsys, "user")
+	if err != nil {
+		t.Fatalf("LoadFixtures() error = %v", err)
+	}
+	if len(fixtures) != 2 || fixtures[0].Name != "a" || string(fixtures[1].Data) != `{"id":"2"}` {
+		t.Errorf("LoadFixtures() = %+v, want a and b", fixtures)
+	}
+
+	RunGolden(t, fsys, map[string]RoundTripFunc{"user": RoundTrip[goldenUser]})
+}
@@ -0,0 +1,348 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+// Package discordtest runs mocks of the Discord REST API and gateway, so bots can be tested without talking to Discord.
+//
+// A Server answers REST requests from fixtures, simulates rate limit headers and injects errors; a Gateway says Hello,
+// accepts Identify and Resume, acknowledges heartbeats and sends scripted dispatch events over a websocket.
+package discordtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// Request - a REST request the Server received
+type Request struct {
+	Method string      // the HTTP method
+	Path   string      // the path without the /api/v10 prefix, e.g. /channels/1/messages
+	Query  url.Values  // the query string
+	Header http.Header // the request headers, including Authorization and X-Audit-Log-Reason
+	Body   []byte      // the request body
+}
+
+// Decode - Unmarshals the request's JSON body into v
+func (r Request) Decode(v any) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Error - an error response in the shape Discord sends, injected with Server.Fail
+type Error struct {
+	Status  int    `json:"-"`       // the HTTP status code
+	Code    int    `json:"code"`    // the Discord JSON error code, e.g. 10003 for Unknown Channel
+	Message string `json:"message"` // the error message
+}
+
+// Server - a mock of the Discord REST API
+//
+// Routes are patterns such as "/channels/{channel.id}/messages"; a segment in braces, or "*", matches any one segment.
+// Requests to a route without a fixture get a 404 Discord error.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	routes   []*route
+	requests []Request
+}
+
+type route struct {
+	method   string
+	segments []string
+	handler  http.HandlerFunc
+	limit    *rateLimit
+	failures []Error
+}
+
+type rateLimit struct {
+	bucket    string
+	limit     int
+	window    time.Duration
+	remaining int
+	resetAt   time.Time
+}
+
+// NewServer - Starts a mock REST API; point a RateLimiter at it with Client or Middleware, and Close it when done
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// Start - Starts a mock REST API and points api.Rest at it until the test ends
+//
+// Tests using Start replace the package-level api.Rest, so they must not run in parallel with each other.
+func Start(t testing.TB) *Server {
+	t.Helper()
+
+	s := NewServer()
+	previous := api.Rest
+	api.Rest = s.Client()
+	t.Cleanup(func() {
+		api.Rest = previous
+		s.Close()
+	})
+
+	return s
+}
+
+// Client - Returns a new RateLimiter that sends its requests to the Server
+func (s *Server) Client() *api.RateLimiter {
+	r := api.NewRatelimiter()
+	r.Use(s.Middleware())
+
+	return r
+}
+
+// Middleware - Returns a middleware that sends requests to the Server instead of Discord
+func (s *Server) Middleware() api.Middleware {
+	target, _ := url.Parse(s.URL)
+
+	return func(next api.RoundTripFunc) api.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme = target.Scheme
+			req.URL.Host = target.Host
+			req.Host = target.Host
+
+			return next(req)
+		}
+	}
+}
+
+// Handle - Answers requests to the route with the handler
+func (s *Server) Handle(method, pattern string, handler http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.route(method, pattern).handler = handler
+}
+
+// Reply - Answers requests to the route with the status and body; a body that is not a string or []byte is sent as JSON
+func (s *Server) Reply(method, pattern string, status int, body any) {
+	var data []byte
+	switch b := body.(type) {
+	case nil:
+	case []byte:
+		data = b
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		if data, err = json.Marshal(b); err != nil {
+			panic(fmt.Sprintf("discordtest: marshalling the %s %s fixture: %v", method, pattern, err))
+		}
+	}
+
+	s.Handle(method, pattern, func(w http.ResponseWriter, _ *http.Request) {
+		if data != nil {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		_, _ = w.Write(data)
+	})
+}
+
+// RateLimit - Limits the route to limit requests per window, sending the X-RateLimit headers Discord does and a 429 once the limit is used up
+func (s *Server) RateLimit(method, pattern string, limit int, window time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.route(method, pattern).limit = &rateLimit{
+		bucket:    strconv.FormatUint(uint64(len(s.routes)), 16) + strings.ReplaceAll(strings.ToLower(pattern), "/", ""),
+		limit:     limit,
+		window:    window,
+		remaining: limit,
+	}
+}
+
+// Fail - Answers the next times requests to the route with the error instead of the fixture
+func (s *Server) Fail(method, pattern string, times int, err Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.route(method, pattern)
+	for i := 0; i < times; i++ {
+		r.failures = append(r.failures, err)
+	}
+}
+
+// Requests - Returns every request the Server received, oldest first
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Request(nil), s.requests...)
+}
+
+// RequestsTo - Returns the requests the Server received for the route, oldest first
+func (s *Server) RequestsTo(method, pattern string) []Request {
+	segments := splitPath(pattern)
+
+	var matched []Request
+	for _, req := range s.Requests() {
+		if req.Method == method && match(segments, splitPath(req.Path)) {
+			matched = append(matched, req)
+		}
+	}
+
+	return matched
+}
+
+// route returns the route for the method and pattern, adding it when it is new; s.mu must be held
+func (s *Server) route(method, pattern string) *route {
+	segments := splitPath(pattern)
+	for _, r := range s.routes {
+		if r.method == method && strings.Join(r.segments, "/") == strings.Join(segments, "/") {
+			return r
+		}
+	}
+
+	r := &route{method: method, segments: segments}
+	s.routes = append(s.routes, r)
+
+	return r
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	path := trimVersion(req.URL.Path)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: req.Method, Path: path, Query: req.URL.Query(), Header: req.Header.Clone(), Body: body})
+
+	var matched *route
+	for _, r := range s.routes {
+		if r.method == req.Method && match(r.segments, splitPath(path)) {
+			matched = r
+			break
+		}
+	}
+	if matched == nil {
+		s.mu.Unlock()
+		writeError(w, Error{Status: http.StatusNotFound, Message: "404: Not Found"})
+		return
+	}
+
+	if limit := matched.limit; limit != nil {
+		if retryAfter, ok := limit.take(w.Header(), time.Now()); !ok {
+			s.mu.Unlock()
+			writeRateLimited(w, retryAfter)
+			return
+		}
+	}
+
+	if len(matched.failures) > 0 {
+		failure := matched.failures[0]
+		matched.failures = matched.failures[1:]
+		s.mu.Unlock()
+		writeError(w, failure)
+		return
+	}
+
+	handler := matched.handler
+	s.mu.Unlock()
+
+	if handler == nil {
+		writeError(w, Error{Status: http.StatusNotFound, Message: "404: Not Found"})
+		return
+	}
+	handler(w, req)
+}
+
+// take uses one request from the limit and sets the rate limit headers, reporting false and the seconds to wait when none are left
+func (l *rateLimit) take(header http.Header, now time.Time) (float64, bool) {
+	if !now.Before(l.resetAt) {
+		l.remaining = l.limit
+		l.resetAt = now.Add(l.window)
+	}
+
+	allowed := l.remaining > 0
+	if allowed {
+		l.remaining--
+	}
+
+	resetAfter := l.resetAt.Sub(now).Seconds()
+	header.Set("X-RateLimit-Limit", strconv.Itoa(l.limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(l.remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatFloat(float64(l.resetAt.UnixMilli())/1000, 'f', 3, 64))
+	header.Set("X-RateLimit-Reset-After", strconv.FormatFloat(resetAfter, 'f', 3, 64))
+	header.Set("X-RateLimit-Bucket", l.bucket)
+	if !allowed {
+		header.Set("X-RateLimit-Scope", "user")
+		header.Set("Retry-After", strconv.Itoa(int(resetAfter)+1))
+	}
+
+	return resetAfter, allowed
+}
+
+func writeError(w http.ResponseWriter, e Error) {
+	writeJSON(w, e.Status, e)
+}
+
+func writeRateLimited(w http.ResponseWriter, retryAfter float64) {
+	writeJSON(w, http.StatusTooManyRequests, map[string]any{"message": "You are being rate limited.", "retry_after": retryAfter, "global": false})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// trimVersion removes the /api or /api/vN prefix from a request path
+func trimVersion(path string) string {
+	path = strings.TrimPrefix(path, "/api")
+	if rest, ok := strings.CutPrefix(path, "/v"); ok {
+		if i := strings.IndexByte(rest, '/'); i >= 0 {
+			if _, err := strconv.Atoi(rest[:i]); err == nil {
+				return rest[i:]
+			}
+		}
+	}
+
+	return path
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+func match(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i, segment := range pattern {
+		if segment == "*" || strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			continue
+		}
+		if segment != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package discordtest
+
+import (
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestServerReply(t *testing.T) {
+	s := Start(t)
+	s.Reply(http.MethodGet, "/channels/{channel.id}", http.StatusOK, api.Channel{ID: "1", Name: "general"})
+
+	channel, err := (&api.Channel{ID: "1"}).GetChannel()
+	if err != nil {
+		t.Fatalf("GetChannel() error = %v", err)
+	}
+	if channel.Name != "general" {
+		t.Errorf("GetChannel() = %+v, want general", channel)
+	}
+
+	requests := s.RequestsTo(http.MethodGet, "/channels/*")
+	if len(requests) != 1 || requests[0].Path != "/channels/1" || requests[0].Header.Get("Authorization") == "" {
+		t.Errorf("RequestsTo() = %+v, want one authorized request to /channels/1", requests)
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	s := Start(t)
+	s.Reply(http.MethodGet, "/channels/{channel.id}", http.StatusOK, api.Channel{ID: "1"})
+	s.RateLimit(http.MethodGet, "/channels/{channel.id}", 1, 200*time.Millisecond)
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		if _, err := (&api.Channel{ID: "1"}).GetChannel(); err != nil {
+			t.Fatalf("GetChannel() error = %v", err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("second GetChannel() returned after %v, want it to wait for the bucket to reset", elapsed)
+	}
+	if requests := s.Requests(); len(requests) != 2 {
+		t.Errorf("Requests() = %d, want 2 with no 429 retries", len(requests))
+	}
+}
+
+func TestServerFail(t *testing.T) {
+	s := Start(t)
+	s.Reply(http.MethodGet, "/users/@me", http.StatusOK, api.User{ID: "1"})
+	s.Fail(http.MethodGet, "/users/@me", 1, Error{Status: http.StatusInternalServerError, Message: "boom"})
+
+	statuses := make([]int, 0, 3)
+	for _, route := range []string{"/users/@me", "/users/@me", "/users/2"} {
+		resp, err := api.Rest.Request(http.MethodGet, "https://discord.com/api/v10"+route, nil, nil)
+		if err != nil {
+			t.Fatalf("Request(%s) error = %v", route, err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		statuses = append(statuses, resp.StatusCode)
+	}
+
+	if statuses[0] != http.StatusInternalServerError || statuses[1] != http.StatusOK || statuses[2] != http.StatusNotFound {
+		t.Errorf("statuses = %v, want [500 200 404]", statuses)
+	}
+}
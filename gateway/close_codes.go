@@ -19,39 +19,98 @@ package gateway
 // In order to prevent broken reconnect loops, you should consider some close codes as a signal to stop reconnecting.
 // This can be because your token expired, or your identification is invalid.
 // This table explains what the application defined close codes for the gateway are, and which close codes you should not attempt to reconnect.
+type CloseCode int
 
-//goland:noinspection GoUnusedExportedFunction
-func GetCloseCode(c int) (code int, reconnect bool, description string) {
+//goland:noinspection GoUnusedConst
+const (
+	CloseNormalClosure        CloseCode = 1000 // sent by the client to end the session; the bot goes offline straight away
+	CloseGoingAway            CloseCode = 1001
+	CloseUnknownError         CloseCode = 4000
+	CloseUnknownOpcode        CloseCode = 4001
+	CloseDecodeError          CloseCode = 4002
+	CloseNotAuthenticated     CloseCode = 4003
+	CloseAuthenticationFailed CloseCode = 4004
+	CloseAlreadyAuthenticated CloseCode = 4005
+	CloseInvalidSequence      CloseCode = 4007
+	CloseRateLimited          CloseCode = 4008
+	CloseSessionTimedOut      CloseCode = 4009
+	CloseInvalidShard         CloseCode = 4010
+	CloseShardingRequired     CloseCode = 4011
+	CloseInvalidAPIVersion    CloseCode = 4012
+	CloseInvalidIntents       CloseCode = 4013
+	CloseDisallowedIntents    CloseCode = 4014
+)
+
+// CloseZombieConnection - a close code this package sends when a heartbeat goes unacknowledged; the session stays resumable
+//
+// Discord does not define it, so it has no description and GetCloseCode does not know it.
+const CloseZombieConnection CloseCode = 4900
+
+// CloseAction - what a client should do after the gateway closes the connection
+type CloseAction int
+
+//goland:noinspection GoUnusedConst
+const (
+	CloseActionResume    CloseAction = iota // reconnect and Resume the session
+	CloseActionReconnect                    // reconnect and Identify a new session; the old one cannot be resumed
+	CloseActionStop                         // do not reconnect; the token, shard or intents need fixing first
+)
+
+var closeCodeDescriptions = map[CloseCode]string{
+	CloseNormalClosure:        "Normal Closure",
+	CloseGoingAway:            "Going Away",
+	CloseUnknownError:         "Unknown Error",
+	CloseUnknownOpcode:        "Unknown opcode",
+	CloseDecodeError:          "Decode Error",
+	CloseNotAuthenticated:     "Not Authenticated",
+	CloseAuthenticationFailed: "Authentication Failed",
+	CloseAlreadyAuthenticated: "Already Authenticated",
+	CloseInvalidSequence:      "Invalid Sequence Number",
+	CloseRateLimited:          "Rate Limited",
+	CloseSessionTimedOut:      "Session Timed Out",
+	CloseInvalidShard:         "Invalid Shard",
+	CloseShardingRequired:     "Sharding Required",
+	CloseInvalidAPIVersion:    "Invalid API Version",
+	CloseInvalidIntents:       "Invalid Intent(s)",
+	CloseDisallowedIntents:    "Disallowed Intent(s)",
+}
+
+// String - Returns the description Discord gives the close code
+func (c CloseCode) String() string {
+	if description, ok := closeCodeDescriptions[c]; ok {
+		return description
+	}
+
+	return "Unknown Close Code"
+}
+
+// Action - Returns whether to resume, start a new session or stop after the gateway closes with this code
+//
+// Codes Discord does not document, e.g. 1006 for a dropped connection, are resumed.
+func (c CloseCode) Action() CloseAction {
 	switch c {
-	case 4000:
-		return 4000, true, "Unknown Error"
-	case 4001:
-		return 4001, true, "Unknown opcode"
-	case 4002:
-		return 4002, true, "Decode Error"
-	case 4003:
-		return 4003, true, "Not Authenticated"
-	case 4004:
-		return 4004, false, "Authentication Failed"
-	case 4005:
-		return 4005, true, "Already Authenticated"
-	case 4007:
-		return 4007, true, "Invalid Sequence Number"
-	case 4008:
-		return 4008, true, "Rate Limited"
-	case 4009:
-		return 4009, true, "Session Timed Out"
-	case 4010:
-		return 4010, false, "Invalid Shard"
-	case 4011:
-		return 4011, false, "Sharding Required"
-	case 4012:
-		return 4012, false, "Invalid API Version"
-	case 4013:
-		return 4013, false, "Invalid Intent(s)"
-	case 4014:
-		return 4014, false, "Disallowed Intent(s)"
+	case CloseAuthenticationFailed, CloseInvalidShard, CloseShardingRequired, CloseInvalidAPIVersion, CloseInvalidIntents, CloseDisallowedIntents:
+		return CloseActionStop
+	case CloseNormalClosure, CloseGoingAway, CloseInvalidSequence, CloseSessionTimedOut:
+		return CloseActionReconnect
 	default:
+		return CloseActionResume
+	}
+}
+
+// IsFatal - Reports whether reconnecting with the same settings would fail again
+func (c CloseCode) IsFatal() bool {
+	return c.Action() == CloseActionStop
+}
+
+// GetCloseCode - Returns the close code, whether to reconnect after it, and its description
+//
+//goland:noinspection GoUnusedExportedFunction
+func GetCloseCode(c int) (code int, reconnect bool, description string) {
+	closeCode := CloseCode(c)
+	if _, ok := closeCodeDescriptions[closeCode]; !ok || closeCode < CloseUnknownError {
 		return 0, false, "Unknown Close Code"
 	}
+
+	return c, !closeCode.IsFatal(), closeCode.String()
 }
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gateway
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCloseCodeAction(t *testing.T) {
+	tests := []struct {
+		code CloseCode
+		want CloseAction
+	}{
+		{CloseUnknownError, CloseActionResume},
+		{CloseRateLimited, CloseActionResume},
+		{1006, CloseActionResume},
+		{CloseInvalidSequence, CloseActionReconnect},
+		{CloseSessionTimedOut, CloseActionReconnect},
+		{CloseAuthenticationFailed, CloseActionStop},
+		{CloseInvalidIntents, CloseActionStop},
+		{CloseDisallowedIntents, CloseActionStop},
+	}
+	for _, tt := range tests {
+		if got := tt.code.Action(); got != tt.want {
+			t.Errorf("CloseCode(%d).Action() = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+
+	if code, reconnect, description := GetCloseCode(4004); code != 4004 || reconnect || description != "Authentication Failed" {
+		t.Errorf("GetCloseCode(4004) = %d, %v, %q", code, reconnect, description)
+	}
+	if code, _, description := GetCloseCode(int(CloseZombieConnection)); code != 0 || description != "Unknown Close Code" {
+		t.Errorf("GetCloseCode(%d) = %d, %q, want the client-side code to be unknown to Discord's table", CloseZombieConnection, code, description)
+	}
+}
+
+func TestReconnectPolicyDecide(t *testing.T) {
+	p := ReconnectPolicy{MinDelay: time.Second, MaxDelay: 10 * time.Second, MaxAttempts: 5}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second} {
+		d := p.Decide(CloseUnknownError, attempt)
+		if d.Action != CloseActionResume || d.Delay < want/2 || d.Delay > want {
+			t.Errorf("Decide(attempt %d) = %+v, want resume within [%v, %v]", attempt, d, want/2, want)
+		}
+	}
+
+	if d := p.Decide(CloseUnknownError, 5); d.Action != CloseActionStop {
+		t.Errorf("Decide() after MaxAttempts = %+v, want stop", d)
+	}
+	if d := p.Decide(CloseDisallowedIntents, 0); d.Action != CloseActionStop {
+		t.Errorf("Decide(4014) = %+v, want stop", d)
+	}
+	if d := p.Decide(CloseRateLimited, 0); d.Delay < rateLimitedMinDelay {
+		t.Errorf("Decide(4008) delay = %v, want at least %v", d.Delay, rateLimitedMinDelay)
+	}
+	if d := (ReconnectPolicy{MinDelay: time.Second, Jitter: -1}).Decide(CloseSessionTimedOut, 1); d.Action != CloseActionReconnect || d.Delay != 2*time.Second {
+		t.Errorf("Decide() without jitter = %+v, want reconnect after 2s", d)
+	}
+}
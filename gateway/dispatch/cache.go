@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+)
+
+// InvalidateResponseCache - Removes the cached REST responses for guilds, channels and the current user as gateway events report changes to them
+func (d *Dispatcher) InvalidateResponseCache(cache *api.ResponseCache) (remove func()) {
+	return d.AddRawHandler(func(eventName string, data json.RawMessage) {
+		var invalidate func(id api.Snowflake)
+		switch events.RawType(eventName) {
+		case events.GuildUpdate, events.GuildDelete:
+			invalidate = cache.InvalidateGuild
+		case events.ChannelUpdate, events.ChannelDelete, events.ThreadUpdate, events.ThreadDelete:
+			invalidate = cache.InvalidateChannel
+		case events.UserUpdate:
+			invalidate = func(id api.Snowflake) {
+				cache.InvalidateUser(id)
+				cache.InvalidateUser("@me")
+			}
+		default:
+			return
+		}
+
+		var payload struct {
+			ID api.Snowflake `json:"id"`
+		}
+		if err := api.JSONUnmarshal(data, &payload); err == nil && payload.ID != "" {
+			invalidate(payload.ID)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package dispatch decodes gateway Dispatch (opcode 0) events and fans them out to registered handlers.
+package dispatch
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// Handler - receives the decoded payload of an event, e.g. *messages.MessageCreate for events.MessageCreate
+//
+// Events this package has no type for are passed as json.RawMessage.
+type Handler func(event any)
+
+// RawHandler - receives every Dispatch event before it is decoded, including events this package has no type for
+type RawHandler func(eventName string, data json.RawMessage)
+
+type registration struct {
+	id      uint64
+	handler func(event any, data json.RawMessage)
+}
+
+type rawRegistration struct {
+	id      uint64
+	handler RawHandler
+}
+
+// Dispatcher - routes decoded gateway events to the handlers registered for them
+//
+// The zero value is not usable; create one with New.
+type Dispatcher struct {
+	// ErrorHandler receives the panics recovered from handlers as *PanicError, and the decode errors of events handled by a Pool; panics are logged with their stack when it is nil
+	ErrorHandler func(err error)
+	// DisableRecovery lets handler panics crash the calling goroutine, e.g. during development; set it before dispatching events
+	DisableRecovery bool
+
+	mu       sync.RWMutex
+	handlers map[events.RawType][]*registration
+	raw      []*rawRegistration
+	nextID   uint64
+	replay   *replayBuffer
+}
+
+// New - Creates an empty Dispatcher
+func New() *Dispatcher {
+	return &Dispatcher{handlers: make(map[events.RawType][]*registration)}
+}
+
+// AddHandler - Registers a handler for the event and returns a function that removes it again
+func (d *Dispatcher) AddHandler(event events.RawType, handler Handler) (remove func()) {
+	return d.add(event, func(decoded any, _ json.RawMessage) {
+		handler(decoded)
+	})
+}
+
+// On - Registers a handler for the event that receives its payload as *T, e.g.
+//
+//	dispatch.On(d, events.MessageCreate, func(m *messages.MessageCreate) { ... })
+//
+// When T is not the type this package decodes the event into, the raw payload is decoded into a new T instead.
+func On[T any](d *Dispatcher, event events.RawType, handler func(*T)) (remove func()) {
+	return d.add(event, func(decoded any, data json.RawMessage) {
+		if v, ok := decoded.(*T); ok {
+			handler(v)
+			return
+		}
+
+		v := new(T)
+		if err := api.Unmarshal(string(event), data, v); err != nil {
+			log.Errorln(log.Discord, log.FuncName(), event, err)
+			return
+		}
+		handler(v)
+	})
+}
+
+// AddRawHandler - Registers a handler that receives every event undecoded and returns a function that removes it again
+//
+// Raw handlers run before the typed handlers of the event. The data is only valid for the duration of the call; copy it to keep it.
+func (d *Dispatcher) AddRawHandler(handler RawHandler) (remove func()) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID++
+	id := d.nextID
+	d.raw = append(d.raw, &rawRegistration{id: id, handler: handler})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { d.removeRaw(id) })
+	}
+}
+
+func (d *Dispatcher) removeRaw(id uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, r := range d.raw {
+		if r.id == id {
+			updated := make([]*rawRegistration, 0, len(d.raw)-1)
+			updated = append(updated, d.raw[:i]...)
+			d.raw = append(updated, d.raw[i+1:]...)
+			break
+		}
+	}
+}
+
+func (d *Dispatcher) add(event events.RawType, handler func(event any, data json.RawMessage)) func() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID++
+	id := d.nextID
+	d.handlers[event] = append(d.handlers[event], &registration{id: id, handler: handler})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { d.remove(event, id) })
+	}
+}
+
+func (d *Dispatcher) remove(event events.RawType, id uint64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	registrations := d.handlers[event]
+	for i, r := range registrations {
+		if r.id == id {
+			// Copy rather than shift in place, as Dispatch may be iterating the old slice
+			updated := make([]*registration, 0, len(registrations)-1)
+			updated = append(updated, registrations[:i]...)
+			d.handlers[event] = append(updated, registrations[i+1:]...)
+			break
+		}
+	}
+
+	if len(d.handlers[event]) == 0 {
+		delete(d.handlers, event)
+	}
+}
+
+// Dispatch - Decodes the `d` payload of a Dispatch event named by `t` and calls its handlers in the order they were added
+//
+// Handlers run on the calling goroutine, so a slow handler delays the events behind it.
+// A handler that panics is recovered and reported to the ErrorHandler unless DisableRecovery is set.
+func (d *Dispatcher) Dispatch(eventName string, data json.RawMessage) error {
+	event := events.RawType(eventName)
+
+	d.mu.RLock()
+	registrations := d.handlers[event]
+	raw := d.raw
+	replay := d.replay
+	d.mu.RUnlock()
+
+	if replay != nil {
+		replay.add(eventName, data)
+	}
+	for _, r := range raw {
+		d.call(eventName, func() { r.handler(eventName, data) })
+	}
+
+	if len(registrations) == 0 {
+		return nil
+	}
+
+	decoded, err := decode(event, data)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), event, err)
+		return &DecodeError{Event: eventName, Data: data, Err: err}
+	}
+
+	for _, r := range registrations {
+		d.call(eventName, func() { r.handler(decoded, data) })
+	}
+
+	return nil
+}
+
+// HandlerCount - Returns the number of handlers registered for the event
+func (d *Dispatcher) HandlerCount(event events.RawType) int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return len(d.handlers[event])
+}
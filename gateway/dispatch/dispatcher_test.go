@@ -0,0 +1,234 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/guilds"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/messages"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/presence"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/voice"
+)
+
+func TestDispatcherOn(t *testing.T) {
+	d := New()
+
+	var got []string
+	remove := On(d, events.MessageCreate, func(m *messages.MessageCreate) {
+		got = append(got, m.Content)
+	})
+	d.AddHandler(events.MessageCreate, func(event any) {
+		if _, ok := event.(*messages.MessageCreate); !ok {
+			t.Errorf("AddHandler() event = %T, want *messages.MessageCreate", event)
+		}
+	})
+
+	if err := d.Dispatch("MESSAGE_CREATE", json.RawMessage(`{"content":"first"}`)); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	remove()
+	remove()
+	if err := d.Dispatch("MESSAGE_CREATE", json.RawMessage(`{"content":"second"}`)); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	if len(got) != 1 || got[0] != "first" {
+		t.Errorf("On() handled %v, want [first]", got)
+	}
+	if count := d.HandlerCount(events.MessageCreate); count != 1 {
+		t.Errorf("HandlerCount() = %d, want 1", count)
+	}
+}
+
+func TestDispatcherRecoversPanics(t *testing.T) {
+	d := New()
+
+	var reported error
+	d.ErrorHandler = func(err error) { reported = err }
+
+	ran := false
+	d.AddHandler(events.MessageCreate, func(any) { panic("boom") })
+	d.AddHandler(events.MessageCreate, func(any) { ran = true })
+
+	if err := d.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`)); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(reported, &panicErr) || panicErr.Value != "boom" || panicErr.Event != "MESSAGE_CREATE" || len(panicErr.Stack) == 0 {
+		t.Errorf("ErrorHandler() got %#v, want a *PanicError for boom", reported)
+	}
+	if !ran {
+		t.Error("Dispatch() skipped the handlers after the one that panicked")
+	}
+
+	d.DisableRecovery = true
+	defer func() {
+		if recover() == nil {
+			t.Error("Dispatch() recovered a panic with DisableRecovery set")
+		}
+	}()
+	_ = d.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`))
+}
+
+func TestDispatcherUnknownEvent(t *testing.T) {
+	d := New()
+
+	var got any
+	d.AddHandler("SOMETHING_NEW", func(event any) {
+		got = event
+	})
+
+	if err := d.Dispatch("SOMETHING_NEW", json.RawMessage(`{"id":"1"}`)); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+	if raw, ok := got.(json.RawMessage); !ok || string(raw) != `{"id":"1"}` {
+		t.Errorf("Dispatch() event = %v, want the raw payload", got)
+	}
+}
+
+func TestDispatcherGuildCreate(t *testing.T) {
+	d := New()
+
+	var got *guilds.GuildCreate
+	On(d, events.GuildCreate, func(g *guilds.GuildCreate) {
+		got = g
+	})
+
+	data := json.RawMessage(`{"id":"1","name":"guild","joined_at":"2024-01-02T03:04:05.000000+00:00","large":true,"member_count":250,` +
+		`"soundboard_sounds":[{"name":"quack","sound_id":"2","volume":1,"emoji_id":null,"emoji_name":"🦆","guild_id":"1","available":true}]}`)
+	if err := d.Dispatch("GUILD_CREATE", data); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	if got == nil || got.ID != "1" || !got.Large || got.MemberCount != 250 {
+		t.Fatalf("Dispatch() guild = %+v, want the decoded guild", got)
+	}
+	if got.JoinedAt == nil || got.JoinedAt.Year() != 2024 {
+		t.Errorf("GuildCreate.JoinedAt = %v, want 2024-01-02", got.JoinedAt)
+	}
+	if len(got.SoundboardSounds) != 1 || got.SoundboardSounds[0].Name != "quack" {
+		t.Errorf("GuildCreate.SoundboardSounds = %+v, want [quack]", got.SoundboardSounds)
+	}
+}
+
+func TestDispatcherGuildDelete(t *testing.T) {
+	d := New()
+
+	var unavailable, removed []string
+	d.OnGuildUnavailable(func(g *guilds.GuildDelete) {
+		unavailable = append(unavailable, g.ID.String())
+	})
+	d.OnGuildRemoved(func(g *guilds.GuildDelete) {
+		removed = append(removed, g.ID.String())
+	})
+
+	for _, data := range []string{`{"id":"1","unavailable":true}`, `{"id":"2"}`, `{"id":"3","unavailable":false}`} {
+		if err := d.Dispatch("GUILD_DELETE", json.RawMessage(data)); err != nil {
+			t.Fatalf("Dispatch() error = %v", err)
+		}
+	}
+
+	if len(unavailable) != 1 || unavailable[0] != "1" {
+		t.Errorf("OnGuildUnavailable() handled %v, want [1]", unavailable)
+	}
+	if len(removed) != 2 || removed[0] != "2" || removed[1] != "3" {
+		t.Errorf("OnGuildRemoved() handled %v, want [2 3]", removed)
+	}
+}
+
+func TestDispatcherPresenceUpdate(t *testing.T) {
+	d := New()
+
+	var got *presence.Update
+	On(d, events.PresenceUpdate, func(u *presence.Update) {
+		got = u
+	})
+
+	data := json.RawMessage(`{"user":{"id":"1"},"guild_id":"2","status":"dnd","client_status":{"desktop":"dnd","mobile":"idle"},"activities":[` +
+		`{"name":"Custom Status","type":4,"state":"busy","emoji":{"name":"🔥"},"created_at":1700000000000},` +
+		`{"name":"Game","type":0,"timestamps":{"start":1700000000000},"party":{"id":"p","size":[1,4]},"buttons":["Join","Watch"]}]}`)
+	if err := d.Dispatch("PRESENCE_UPDATE", data); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	if got == nil || got.Status != presence.Dnd || got.ClientStatus.Mobile != presence.Idle {
+		t.Fatalf("Dispatch() presence = %+v, want the decoded presence", got)
+	}
+	if custom := got.CustomStatus(); custom == nil || *custom.State != "busy" || custom.Emoji.Name != "🔥" {
+		t.Errorf("CustomStatus() = %+v, want busy 🔥", custom)
+	}
+	game := got.Activities[1]
+	if game.Party.Size != [2]uint16{1, 4} || len(game.Buttons) != 2 || game.Buttons[1].Label != "Watch" {
+		t.Errorf("Activities[1] = %+v, want party size [1 4] and buttons [Join Watch]", game)
+	}
+}
+
+func TestDispatcherVoiceChannelStatusUpdate(t *testing.T) {
+	d := New()
+
+	var got *voice.ChannelStatusUpdate
+	On(d, events.VoiceChannelStatusUpdate, func(u *voice.ChannelStatusUpdate) {
+		got = u
+	})
+
+	if err := d.Dispatch("VOICE_CHANNEL_STATUS_UPDATE", json.RawMessage(`{"id":"1","guild_id":"2","status":"now playing"}`)); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+	if got == nil || got.ID != "1" || got.Status == nil || *got.Status != "now playing" {
+		t.Errorf("Dispatch() status update = %+v, want now playing", got)
+	}
+}
+
+func TestDispatcherGuildMemberUpdateBoost(t *testing.T) {
+	d := New()
+
+	var got *guilds.GuildMemberUpdate
+	On(d, events.GuildMemberUpdate, func(u *guilds.GuildMemberUpdate) {
+		got = u
+	})
+
+	data := json.RawMessage(`{"guild_id":"1","roles":[],"user":{"id":"2"},"premium_since":"2024-01-01T00:00:00.000000+00:00"}`)
+	if err := d.Dispatch("GUILD_MEMBER_UPDATE", data); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	boosting := &api.GuildMember{PremiumSince: got.PremiumSince}
+	tests := []struct {
+		name   string
+		before *api.GuildMember
+		want   guilds.BoostChange
+	}{
+		{"unknown", nil, guilds.BoostUnchanged},
+		{"started", &api.GuildMember{}, guilds.BoostStarted},
+		{"still boosting", boosting, guilds.BoostUnchanged},
+	}
+	for _, tt := range tests {
+		if change := got.BoostChange(tt.before); change != tt.want {
+			t.Errorf("BoostChange(%s) = %v, want %v", tt.name, change, tt.want)
+		}
+	}
+
+	got.PremiumSince = nil
+	if change := got.BoostChange(boosting); change != guilds.BoostEnded {
+		t.Errorf("BoostChange(boosting) after the boost ended = %v, want %v", change, guilds.BoostEnded)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/application_commands"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/automod"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/channels"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/guild_scheduled_events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/guilds"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/integrations"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/interactions"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/invites"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/messages"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/presence"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/stage_instance"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/voice"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/webhooks"
+)
+
+// eventTypes - the receive type each event is decoded into
+var eventTypes = map[events.RawType]func() any{
+	events.Ready: func() any { return &receive.Ready{} },
+
+	events.ApplicationCommandPermissionsUpdate: func() any { return &application_commands.ApplicationCommandPermissionsUpdate{} },
+
+	events.AutoModerationRuleCreate:      func() any { return &automod.AutoModerationRuleCreate{} },
+	events.AutoModerationRuleUpdate:      func() any { return &automod.AutoModerationRuleUpdate{} },
+	events.AutoModerationRuleDelete:      func() any { return &automod.AutoModerationRuleDelete{} },
+	events.AutoModerationActionExecution: func() any { return &automod.AutoModerationRuleExecution{} },
+
+	events.ChannelCreate:       func() any { return &channels.ChannelCreate{} },
+	events.ChannelUpdate:       func() any { return &channels.ChannelUpdate{} },
+	events.ChannelDelete:       func() any { return &channels.ChannelDelete{} },
+	events.ChannelPinsUpdate:   func() any { return &channels.ChannelPinsUpdate{} },
+	events.ThreadCreate:        func() any { return &channels.ThreadCreate{} },
+	events.ThreadUpdate:        func() any { return &channels.ThreadUpdate{} },
+	events.ThreadDelete:        func() any { return &channels.ThreadDelete{} },
+	events.ThreadListSync:      func() any { return &channels.ThreadListSync{} },
+	events.ThreadMemberUpdate:  func() any { return &channels.ThreadMemberUpdate{} },
+	events.ThreadMembersUpdate: func() any { return &channels.ThreadMembersUpdate{} },
+
+	events.GuildCreate:              func() any { return &guilds.GuildCreate{} },
+	events.GuildUpdate:              func() any { return &guilds.GuildUpdate{} },
+	events.GuildDelete:              func() any { return &guilds.GuildDelete{} },
+	events.GuildAuditLogEntryCreate: func() any { return &guilds.GuildAuditLogEntryCreate{} },
+	events.GuildBanAdd:              func() any { return &guilds.GuildBanAdd{} },
+	events.GuildBanRemove:           func() any { return &guilds.GuildBanRemove{} },
+	events.GuildEmojisUpdate:        func() any { return &guilds.GuildEmojisUpdate{} },
+	events.GuildStickersUpdate:      func() any { return &guilds.GuildStickersUpdate{} },
+	events.GuildIntegrationsUpdate:  func() any { return &guilds.GuildIntegrationsUpdate{} },
+	events.GuildMemberAdd:           func() any { return &guilds.GuildMemberAdd{} },
+	events.GuildMemberRemove:        func() any { return &guilds.GuildMemberRemove{} },
+	events.GuildMemberUpdate:        func() any { return &guilds.GuildMemberUpdate{} },
+	events.GuildMembersChunk:        func() any { return &guilds.GuildMemberChunk{} },
+	events.GuildRoleCreate:          func() any { return &guilds.GuildRoleCreate{} },
+	events.GuildRoleUpdate:          func() any { return &guilds.GuildRoleUpdate{} },
+	events.GuildRoleDelete:          func() any { return &guilds.GuildRoleDelete{} },
+
+	events.GuildScheduledEventCreate:     func() any { return &guild_scheduled_events.GuildScheduledEventCreate{} },
+	events.GuildScheduledEventUpdate:     func() any { return &guild_scheduled_events.GuildScheduledEventUpdate{} },
+	events.GuildScheduledEventDelete:     func() any { return &guild_scheduled_events.GuildScheduledEventDelete{} },
+	events.GuildScheduledEventUserAdd:    func() any { return &guild_scheduled_events.GuildScheduledEventUserAdd{} },
+	events.GuildScheduledEventUserRemove: func() any { return &guild_scheduled_events.GuildScheduledEventUserRemove{} },
+
+	events.IntegrationCreate: func() any { return &integrations.IntegrationCreate{} },
+	events.IntegrationUpdate: func() any { return &integrations.IntegrationUpdate{} },
+	events.IntegrationDelete: func() any { return &integrations.IntegrationDelete{} },
+
+	events.InteractionCreate: func() any { return &interactions.Create{} },
+
+	events.InviteCreate: func() any { return &invites.InviteCreate{} },
+	events.InviteDelete: func() any { return &invites.InviteDelete{} },
+
+	events.MessageCreate:              func() any { return &messages.MessageCreate{} },
+	events.MessageUpdate:              func() any { return &messages.MessageUpdate{} },
+	events.MessageDelete:              func() any { return &messages.MessageDelete{} },
+	events.MessageDeleteBulk:          func() any { return &messages.MessageDeleteBulk{} },
+	events.MessageReactionAdd:         func() any { return &messages.MessageReactionAdd{} },
+	events.MessageReactionRemove:      func() any { return &messages.MessageReactionRemove{} },
+	events.MessageReactionRemoveAll:   func() any { return &messages.MessageReactionRemoveAll{} },
+	events.MessageReactionRemoveEmoji: func() any { return &messages.MessageReactionRemoveEmoji{} },
+
+	events.PresenceUpdate: func() any { return &presence.Update{} },
+	events.TypingStart:    func() any { return &presence.TypingStart{} },
+	events.UserUpdate:     func() any { return &presence.UserUpdate{} },
+
+	events.StageInstanceCreate: func() any { return &stage_instance.Create{} },
+	events.StageInstanceUpdate: func() any { return &stage_instance.Update{} },
+	events.StageInstanceDelete: func() any { return &stage_instance.Delete{} },
+
+	events.VoiceStateUpdate:         func() any { return &voice.StateUpdate{} },
+	events.VoiceServerUpdate:        func() any { return &voice.ServerUpdate{} },
+	events.VoiceChannelStatusUpdate: func() any { return &voice.ChannelStatusUpdate{} },
+
+	events.WebhooksUpdate: func() any { return &webhooks.Update{} },
+}
+
+// decode - Decodes the payload into the event's receive type, or returns it as json.RawMessage when the event has none
+func decode(event events.RawType, data json.RawMessage) (any, error) {
+	newEvent, ok := eventTypes[event]
+	if !ok {
+		return data, nil
+	}
+
+	v := newEvent()
+	if err := api.Unmarshal(string(event), data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -0,0 +1,40 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/guilds"
+)
+
+// OnGuildUnavailable - Registers a handler for GuildDelete events sent because the guild is unavailable due to an outage
+//
+// The bot is still a member; a GuildCreate follows once the guild is available again.
+func (d *Dispatcher) OnGuildUnavailable(handler func(guild *guilds.GuildDelete)) (remove func()) {
+	return On(d, events.GuildDelete, func(guild *guilds.GuildDelete) {
+		if guild.Unavailable {
+			handler(guild)
+		}
+	})
+}
+
+// OnGuildRemoved - Registers a handler for GuildDelete events sent because the bot left, or was kicked or banned from, the guild
+func (d *Dispatcher) OnGuildRemoved(handler func(guild *guilds.GuildDelete)) (remove func()) {
+	return On(d, events.GuildDelete, func(guild *guilds.GuildDelete) {
+		if !guild.Unavailable {
+			handler(guild)
+		}
+	})
+}
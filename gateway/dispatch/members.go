@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/guilds"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/send"
+)
+
+// MemberChunk - one Guild Members Chunk answering a request made with StreamMembers
+type MemberChunk struct {
+	GuildID  api.Snowflake
+	Members  []api.GuildMember
+	NotFound []api.Snowflake // the requested user IDs that are not members of the guild
+	Index    int             // the chunk's index, from 0 to Count-1
+	Count    int             // the total number of chunks for the request
+}
+
+// Last - Reports whether this is the final chunk of the request
+func (c MemberChunk) Last() bool {
+	return c.Index+1 >= c.Count
+}
+
+// Progress - Returns the fraction of the request's chunks received so far, from 0 to 1
+func (c MemberChunk) Progress() float64 {
+	if c.Count == 0 {
+		return 1
+	}
+
+	return float64(c.Index+1) / float64(c.Count)
+}
+
+// StreamMembers - Sends a Request Guild Members command with a fresh nonce and returns the chunks answering it as they arrive
+//
+// Chunks are not buffered: the handler for GUILD_MEMBERS_CHUNK waits until the previous chunk has been received,
+// so a guild with millions of members is held in memory one chunk at a time.
+// The channel is closed after the last chunk, or when the context ends; check ctx.Err() to tell them apart.
+func (d *Dispatcher) StreamMembers(ctx context.Context, request send.RequestGuildMembers, sendCommand func(send.Command) error) (<-chan MemberChunk, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	request.Nonce = hex.EncodeToString(nonce)
+
+	chunks := make(chan MemberChunk)
+	done := make(chan struct{})
+
+	var (
+		mu     sync.Mutex
+		closed bool
+		remove func()
+	)
+	finish := func() {
+		if !closed {
+			closed = true
+			remove()
+			close(chunks)
+			close(done)
+		}
+	}
+
+	// The handler holds mu while it waits for the receiver, so chunks arriving on other goroutines are delivered in turn
+	remove = On(d, events.GuildMembersChunk, func(event *guilds.GuildMemberChunk) {
+		if event.Nonce != request.Nonce {
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		chunk := MemberChunk{
+			GuildID:  event.GuildID,
+			Members:  event.Members,
+			NotFound: event.NotFound,
+			Index:    int(event.ChunkIndex),
+			Count:    int(event.ChunkCount),
+		}
+
+		select {
+		case chunks <- chunk:
+			if chunk.Last() {
+				finish()
+			}
+		case <-ctx.Done():
+			finish()
+		}
+	})
+
+	if err := sendCommand(request); err != nil {
+		mu.Lock()
+		finish()
+		mu.Unlock()
+		return nil, err
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		finish()
+	}()
+
+	return chunks, nil
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/send"
+)
+
+func TestStreamMembers(t *testing.T) {
+	d := New()
+
+	var nonce string
+	chunks, err := d.StreamMembers(context.Background(), send.RequestGuildMembers{GuildID: "7"}, func(command send.Command) error {
+		nonce = command.(send.RequestGuildMembers).Nonce
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamMembers() error = %v", err)
+	}
+	if len(nonce) != 32 {
+		t.Fatalf("StreamMembers() nonce = %q, want 32 characters", nonce)
+	}
+
+	go func() {
+		_ = d.Dispatch(string(events.GuildMembersChunk), json.RawMessage(`{"guild_id":"7","nonce":"other","chunk_index":0,"chunk_count":1}`))
+		for i := 0; i < 3; i++ {
+			_ = d.Dispatch(string(events.GuildMembersChunk), json.RawMessage(fmt.Sprintf(
+				`{"guild_id":"7","nonce":%q,"chunk_index":%d,"chunk_count":3,"members":[{"user":{"id":"%d"}}],"not_found":["99"]}`, nonce, i, i)))
+		}
+	}()
+
+	var got []MemberChunk
+	for chunk := range chunks {
+		got = append(got, chunk)
+	}
+
+	if len(got) != 3 {
+		t.Fatalf("StreamMembers() streamed %d chunks, want 3", len(got))
+	}
+	if last := got[2]; !last.Last() || last.Progress() != 1 || last.Members[0].User.ID != "2" || last.NotFound[0] != "99" {
+		t.Errorf("last chunk = %+v", last)
+	}
+	if count := d.HandlerCount(events.GuildMembersChunk); count != 0 {
+		t.Errorf("HandlerCount() after the last chunk = %d, want 0", count)
+	}
+}
+
+func TestStreamMembersCancel(t *testing.T) {
+	d := New()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	chunks, err := d.StreamMembers(ctx, send.RequestGuildMembers{GuildID: "7"}, func(send.Command) error { return nil })
+	if err != nil {
+		t.Fatalf("StreamMembers() error = %v", err)
+	}
+
+	cancel()
+	if _, ok := <-chunks; ok {
+		t.Error("StreamMembers() channel still open after the context ended")
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+)
+
+// ErrPoolClosed - returned by Pool.Dispatch once the Pool has been closed
+var ErrPoolClosed = errors.New("dispatch pool is closed")
+
+// Pool - handles events on a fixed number of workers: in order for any one guild, and in parallel across guilds
+//
+// Each guild is assigned to one worker, so its events are handled in the order they were received.
+// Events without a guild_id, e.g. READY or direct messages, all go to the same worker.
+// When a worker's queue is full, Dispatch blocks until there is room, slowing the gateway read loop instead of buffering without bound.
+type Pool struct {
+	dispatcher *Dispatcher
+	queues     []chan poolEvent
+
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+
+	handled atomic.Uint64
+	blocked atomic.Uint64
+	waited  atomic.Int64
+}
+
+type poolEvent struct {
+	name string
+	data json.RawMessage
+}
+
+// PoolStats - a point-in-time view of a Pool's backpressure
+type PoolStats struct {
+	Workers  int           // the number of workers
+	Queued   int           // events waiting for a worker
+	Capacity int           // the number of events that can wait before Dispatch blocks, across all workers
+	Handled  uint64        // events handled since the Pool was created
+	Blocked  uint64        // how many times Dispatch found a full queue and had to wait
+	Waited   time.Duration // the total time Dispatch spent waiting for room
+}
+
+// NewPool - Creates a Pool that handles the Dispatcher's events on the given number of workers, each queueing up to queueSize events
+func NewPool(d *Dispatcher, workers, queueSize int) *Pool {
+	if workers < 1 {
+		workers = 1
+	}
+	if queueSize < 1 {
+		queueSize = 1
+	}
+
+	p := &Pool{dispatcher: d, queues: make([]chan poolEvent, workers)}
+
+	p.wg.Add(workers)
+	for i := range p.queues {
+		p.queues[i] = make(chan poolEvent, queueSize)
+		go p.work(p.queues[i])
+	}
+
+	return p
+}
+
+// Dispatch - Queues the event on the worker for its guild, blocking while that worker's queue is full
+//
+// Decode errors and recovered panics are passed to the Dispatcher's ErrorHandler, as the handlers run after Dispatch returns.
+func (p *Pool) Dispatch(eventName string, data json.RawMessage) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	queue := p.queues[p.worker(eventName, data)]
+	event := poolEvent{name: eventName, data: data}
+
+	select {
+	case queue <- event:
+	default:
+		p.blocked.Add(1)
+		start := time.Now()
+		queue <- event
+		p.waited.Add(int64(time.Since(start)))
+	}
+
+	return nil
+}
+
+// Stats - Returns the Pool's queue depth and backpressure counters
+func (p *Pool) Stats() PoolStats {
+	stats := PoolStats{
+		Workers: len(p.queues),
+		Handled: p.handled.Load(),
+		Blocked: p.blocked.Load(),
+		Waited:  time.Duration(p.waited.Load()),
+	}
+	for _, queue := range p.queues {
+		stats.Queued += len(queue)
+		stats.Capacity += cap(queue)
+	}
+
+	return stats
+}
+
+// Close - Stops accepting events and waits for the queued ones to be handled
+func (p *Pool) Close() {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		for _, queue := range p.queues {
+			close(queue)
+		}
+	}
+	p.mu.Unlock()
+
+	p.wg.Wait()
+}
+
+func (p *Pool) work(queue <-chan poolEvent) {
+	defer p.wg.Done()
+
+	for event := range queue {
+		if err := p.dispatcher.Dispatch(event.name, event.data); err != nil && p.dispatcher.ErrorHandler != nil {
+			p.dispatcher.ErrorHandler(err)
+		}
+		p.handled.Add(1)
+	}
+}
+
+// worker - picks the worker for the event's guild; GUILD_CREATE, GUILD_UPDATE and GUILD_DELETE carry the guild's own id
+func (p *Pool) worker(eventName string, data json.RawMessage) int {
+	if len(p.queues) == 1 {
+		return 0
+	}
+
+	var partial struct {
+		GuildID guildKey `json:"guild_id"`
+		ID      guildKey `json:"id"`
+	}
+	_ = api.JSONUnmarshal(data, &partial)
+
+	key := partial.GuildID
+	switch events.RawType(eventName) {
+	case events.GuildCreate, events.GuildUpdate, events.GuildDelete:
+		key = partial.ID
+	}
+
+	return int(uint32(key) % uint32(len(p.queues)))
+}
+
+// guildKey - the FNV-1a hash of a guild ID as it appears in the payload, taken without copying the ID into a string
+type guildKey uint32
+
+func (k *guildKey) UnmarshalJSON(data []byte) error {
+	h := uint32(2166136261)
+	for _, c := range data {
+		h ^= uint32(c)
+		h *= 16777619
+	}
+	*k = guildKey(h)
+
+	return nil
+}
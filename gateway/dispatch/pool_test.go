@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+)
+
+func TestPoolOrdersEventsPerGuild(t *testing.T) {
+	d := New()
+
+	var mu sync.Mutex
+	got := map[string][]string{}
+	d.AddRawHandler(func(_ string, data json.RawMessage) {
+		var event struct {
+			GuildID string `json:"guild_id"`
+			ID      string `json:"id"`
+			Content string `json:"content"`
+		}
+		_ = json.Unmarshal(data, &event)
+
+		guildID := event.GuildID
+		if guildID == "" {
+			guildID = event.ID
+		}
+
+		mu.Lock()
+		got[guildID] = append(got[guildID], event.Content)
+		mu.Unlock()
+	})
+
+	p := NewPool(d, 4, 2)
+	for _, guildID := range []string{"1", "2", "3"} {
+		_ = p.Dispatch(string(events.GuildUpdate), json.RawMessage(fmt.Sprintf(`{"id":%q,"content":"0"}`, guildID)))
+		for i := 1; i < 50; i++ {
+			_ = p.Dispatch("MESSAGE_CREATE", json.RawMessage(fmt.Sprintf(`{"guild_id":%q,"content":"%d"}`, guildID, i)))
+		}
+	}
+	p.Close()
+
+	for _, guildID := range []string{"1", "2", "3"} {
+		handled := got[guildID]
+		if len(handled) != 50 {
+			t.Fatalf("guild %s handled %d events, want 50", guildID, len(handled))
+		}
+		for i, content := range handled {
+			if content != fmt.Sprint(i) {
+				t.Fatalf("guild %s events out of order: %v", guildID, handled)
+			}
+		}
+	}
+
+	stats := p.Stats()
+	if stats.Handled != 150 || stats.Queued != 0 || stats.Workers != 4 || stats.Capacity != 8 {
+		t.Errorf("Stats() = %+v", stats)
+	}
+	if err := p.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`)); !errors.Is(err, ErrPoolClosed) {
+		t.Errorf("Dispatch() after Close error = %v, want ErrPoolClosed", err)
+	}
+}
+
+func TestPoolBackpressure(t *testing.T) {
+	d := New()
+
+	release := make(chan struct{})
+	d.AddRawHandler(func(string, json.RawMessage) { <-release })
+
+	p := NewPool(d, 1, 1)
+	_ = p.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`)) // taken by the worker
+	_ = p.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`)) // fills the queue
+
+	done := make(chan struct{})
+	go func() {
+		_ = p.Dispatch("MESSAGE_CREATE", json.RawMessage(`{}`))
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Dispatch() returned while the queue was full")
+	default:
+	}
+
+	close(release)
+	<-done
+	p.Close()
+
+	if stats := p.Stats(); stats.Blocked == 0 || stats.Handled != 3 {
+		t.Errorf("Stats() = %+v, want Blocked > 0 and Handled 3", stats)
+	}
+}
+
+func BenchmarkPoolWorker(b *testing.B) {
+	p := NewPool(New(), 8, 1)
+	defer p.Close()
+
+	data := json.RawMessage(`{"id":"2","channel_id":"3","guild_id":"81384788765712384","content":"hello","author":{"id":"4","username":"Nelly"}}`)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p.worker("MESSAGE_CREATE", data)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// PanicError - a panic recovered from a handler, passed to the ErrorHandler
+type PanicError struct {
+	Event string // the event the handler was running for
+	Value any    // the value passed to panic
+	Stack []byte // the stack of the panicking goroutine
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("handler for %s panicked: %v", e.Event, e.Value)
+}
+
+// Unwrap - Returns the panic value when it is an error, e.g. a runtime.Error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Recover - Runs fn, returning a *PanicError if it panics
+//
+// With disabled set the panic is not recovered, so it crashes with the full stack as it would without Recover; useful during development.
+func Recover(event string, disabled bool, fn func()) (err error) {
+	if !disabled {
+		defer func() {
+			if value := recover(); value != nil {
+				err = &PanicError{Event: event, Value: value, Stack: debug.Stack()}
+			}
+		}()
+	}
+
+	fn()
+
+	return nil
+}
+
+// call runs a handler, passing a recovered panic to the ErrorHandler so the handlers after it still run
+func (d *Dispatcher) call(event string, handler func()) {
+	err := Recover(event, d.DisableRecovery, handler)
+	if err == nil {
+		return
+	}
+
+	if d.ErrorHandler != nil {
+		d.ErrorHandler(err)
+		return
+	}
+
+	log.Errorln(log.Discord, log.FuncName(), err, string(err.(*PanicError).Stack))
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// RawEvent - an event as it was received, kept by the replay buffer
+type RawEvent struct {
+	Name       string          `json:"t"`           // the event name, e.g. MESSAGE_CREATE
+	Data       json.RawMessage `json:"d"`           // the undecoded payload
+	ReceivedAt time.Time       `json:"received_at"` // when Dispatch was called with the event
+}
+
+// DecodeError - returned by Dispatch when an event's payload does not decode into its type
+type DecodeError struct {
+	Event string          // the event name
+	Data  json.RawMessage // the payload that failed to decode
+	Err   error           // the decoding error, e.g. an *api.UnknownFieldError in api.DecodeStrict mode
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding %s: %v", e.Event, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// replayBuffer - a ring of the most recent events
+type replayBuffer struct {
+	mu     sync.Mutex
+	events []RawEvent
+	next   int
+	full   bool
+}
+
+// EnableReplayBuffer - Keeps the last size events, whether or not they have handlers, for RecentEvents and DumpRecentEvents
+//
+// Calling it again replaces the buffer, discarding the events kept so far; a size of 0 turns it off.
+func (d *Dispatcher) EnableReplayBuffer(size int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if size <= 0 {
+		d.replay = nil
+		return
+	}
+
+	d.replay = &replayBuffer{events: make([]RawEvent, size)}
+}
+
+// RecentEvents - Returns the events kept by the replay buffer, oldest first
+func (d *Dispatcher) RecentEvents() []RawEvent {
+	d.mu.RLock()
+	replay := d.replay
+	d.mu.RUnlock()
+
+	if replay == nil {
+		return nil
+	}
+
+	return replay.snapshot()
+}
+
+// DumpRecentEvents - Writes the events kept by the replay buffer as JSON lines, oldest first, e.g. to a file after a DecodeError
+func (d *Dispatcher) DumpRecentEvents(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	for _, event := range d.RecentEvents() {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *replayBuffer) add(name string, data json.RawMessage) {
+	// The caller may reuse data once Dispatch returns
+	event := RawEvent{Name: name, Data: append(json.RawMessage(nil), data...), ReceivedAt: time.Now()}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.events[b.next] = event
+	b.next = (b.next + 1) % len(b.events)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+func (b *replayBuffer) snapshot() []RawEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.full {
+		return append([]RawEvent(nil), b.events[:b.next]...)
+	}
+
+	return append(append([]RawEvent(nil), b.events[b.next:]...), b.events[:b.next]...)
+}
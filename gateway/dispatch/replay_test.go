@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package dispatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/messages"
+)
+
+func TestDispatcherRawHandlerAndReplay(t *testing.T) {
+	d := New()
+	d.EnableReplayBuffer(2)
+
+	var names []string
+	remove := d.AddRawHandler(func(eventName string, _ json.RawMessage) {
+		names = append(names, eventName)
+	})
+	On(d, events.MessageCreate, func(*messages.MessageCreate) {})
+
+	_ = d.Dispatch("TYPING_START", json.RawMessage(`{}`))
+	_ = d.Dispatch("SOMETHING_NEW", json.RawMessage(`{"id":"1"}`))
+	remove()
+	err := d.Dispatch("MESSAGE_CREATE", json.RawMessage(`{"content":1}`))
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) || decodeErr.Event != "MESSAGE_CREATE" {
+		t.Errorf("Dispatch() error = %v, want a DecodeError for MESSAGE_CREATE", err)
+	}
+	if strings.Join(names, ",") != "TYPING_START,SOMETHING_NEW" {
+		t.Errorf("AddRawHandler() saw %v", names)
+	}
+
+	var dump bytes.Buffer
+	if err = d.DumpRecentEvents(&dump); err != nil {
+		t.Fatalf("DumpRecentEvents() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(dump.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[0], `"SOMETHING_NEW"`) || !strings.Contains(lines[1], `"MESSAGE_CREATE"`) {
+		t.Errorf("DumpRecentEvents() = %s", dump.String())
+	}
+}
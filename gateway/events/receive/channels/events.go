@@ -15,8 +15,6 @@
 package channels
 
 import (
-	"time"
-
 	"github.com/veteran-software/discord-api-wrapper/v10/api"
 )
 
@@ -91,8 +89,8 @@ type (
 	// ChannelPinsUpdate - Sent when a message is pinned or unpinned in a text channel.
 	// This is not sent when a pinned message is deleted.
 	ChannelPinsUpdate struct {
-		GuildID          api.Snowflake `json:"guild_id,omitempty"`
-		ChannelID        api.Snowflake `json:"channel_id"`
-		LastPinTimestamp *time.Time    `json:"last_pin_timestamp,omitempty"`
+		GuildID          api.Snowflake  `json:"guild_id,omitempty"`           // the guild of the channel; empty for DMs
+		ChannelID        api.Snowflake  `json:"channel_id"`                   // the channel the pins changed in
+		LastPinTimestamp *api.Timestamp `json:"last_pin_timestamp,omitempty"` // when the most recent pinned message was pinned; nil when none remain
 	}
 )
@@ -15,8 +15,6 @@
 package guilds
 
 import (
-	"time"
-
 	"github.com/veteran-software/discord-api-wrapper/v10/api"
 	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/presence"
 	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/send"
@@ -40,7 +38,7 @@ type (
 	//    An unavailable Guild: an unavailable guild object.
 	GuildCreate struct {
 		api.Guild
-		JoinedAt             time.Time                  `json:"joined_at,omitempty"`              // when this guild was joined at
+		JoinedAt             *api.Timestamp             `json:"joined_at,omitempty"`              // when this guild was joined at
 		Large                bool                       `json:"large,omitempty"`                  // true if this is considered a large guild
 		Unavailable          bool                       `json:"unavailable,omitempty"`            // true if this guild is unavailable due to an outage
 		MemberCount          int64                      `json:"member_count,omitempty"`           // total number of members in this guild
@@ -51,6 +49,7 @@ type (
 		Presences            []*presence.Update         `json:"presences,omitempty"`              // presences of the members in the guild, will only include non-offline members if the size is greater than large threshold
 		StageInstances       []*api.StageInstance       `json:"stage_instances,omitempty"`        // Stage instances in the guild
 		GuildScheduledEvents []*api.GuildScheduledEvent `json:"guild_scheduled_events,omitempty"` // the scheduled events in the guild
+		SoundboardSounds     []*api.SoundboardSound     `json:"soundboard_sounds,omitempty"`      // soundboard sounds in the guild
 	}
 
 	// GuildUpdate - Sent when a guild is updated. The inner payload is a guild object.
@@ -63,10 +62,13 @@ type (
 	GuildDelete api.UnavailableGuild
 
 	// GuildAuditLogEntryCreate - Sent when a guild audit log entry is created.
-	// The inner payload is an Audit Log Entry object.
+	// The inner payload is an Audit Log Entry object with an extra guild_id key.
 	//
-	// This event is only sent to bots with the VIEW_AUDIT_LOG permission.
-	GuildAuditLogEntryCreate api.AuditLogEntry
+	// This event is only sent to bots with the VIEW_AUDIT_LOG permission and the GuildModeration intent.
+	GuildAuditLogEntryCreate struct {
+		api.AuditLogEntry
+		GuildID api.Snowflake `json:"guild_id"`
+	}
 
 	// GuildBanAdd - Sent when a user is banned from a guild.
 	GuildBanAdd struct {
@@ -116,12 +118,12 @@ type (
 		User                       api.User        `json:"user"`
 		Nick                       *string         `json:"nick,omitempty"`
 		Avatar                     *string         `json:"avatar"`
-		JoinedAt                   *time.Time      `json:"joined_at"`
-		PremiumSince               *time.Time      `json:"premium_since,omitempty"`
+		JoinedAt                   *api.Timestamp  `json:"joined_at"`
+		PremiumSince               *api.Timestamp  `json:"premium_since,omitempty"`
 		Deaf                       bool            `json:"deaf,omitempty"`
 		Mute                       bool            `json:"mute,omitempty"`
 		Pending                    bool            `json:"pending,omitempty"`
-		CommunicationDisabledUntil *time.Time      `json:"communication_disabled_until,omitempty"`
+		CommunicationDisabledUntil *api.Timestamp  `json:"communication_disabled_until,omitempty"`
 	}
 
 	// GuildMemberChunk - Sent in response to RequestGuildMembers. You can use the `chunk_index` and `chunk_count` to calculate how many chunks are left for your request.
@@ -153,3 +155,36 @@ type (
 		RoleID  api.Snowflake `json:"role_id"`
 	}
 )
+
+// BoostChange - how a GuildMemberUpdate changed whether the member boosts the guild
+type BoostChange int
+
+//goland:noinspection GoUnusedConst
+const (
+	BoostUnchanged BoostChange = iota // the member's boost did not start or end, or their previous state is unknown
+	BoostStarted                      // the member started boosting the guild
+	BoostEnded                        // the member stopped boosting the guild
+)
+
+// BoostChange - Compares the update with the member as it was before, e.g. from a member cache, to detect a boost starting or ending
+//
+// GUILD_MEMBER_UPDATE carries only the new state, so a nil before reports BoostUnchanged.
+func (u *GuildMemberUpdate) BoostChange(before *api.GuildMember) BoostChange {
+	if before == nil {
+		return BoostUnchanged
+	}
+
+	switch boosting := u.PremiumSince != nil; {
+	case boosting && !before.IsBoosting():
+		return BoostStarted
+	case !boosting && before.IsBoosting():
+		return BoostEnded
+	default:
+		return BoostUnchanged
+	}
+}
+
+// IsBoosting - Checks whether the member boosts the guild after the update
+func (u *GuildMemberUpdate) IsBoosting() bool {
+	return u.PremiumSince != nil
+}
@@ -35,8 +35,8 @@ type (
 
 	// IntegrationDelete - Sent when an integration is deleted.
 	IntegrationDelete struct {
-		ID            api.Snowflake `json:"id"`
-		GuildID       api.Snowflake `json:"guild_id"`
-		ApplicationID api.Snowflake `json:"application_id"`
+		ID            api.Snowflake  `json:"id"`                       // integration id
+		GuildID       api.Snowflake  `json:"guild_id"`                 // id of the guild
+		ApplicationID *api.Snowflake `json:"application_id,omitempty"` // id of the bot/OAuth2 application for this discord integration
 	}
 )
@@ -24,25 +24,36 @@ import (
 
 type (
 	// InviteCreate - Sent when a new invite to a channel is created.
+	//
+	// Unlike the REST Invite, the guild and channel are only given by id, and the invite metadata is included.
 	InviteCreate struct {
-		ChannelID         api.Snowflake        `json:"channel_id"`
-		Code              string               `json:"code"`
-		CreatedAt         time.Time            `json:"created_at"`
-		GuildID           api.Snowflake        `json:"guild_id,omitempty"`
-		Inviter           api.User             `json:"inviter"`
-		MaxAge            int64                `json:"max_age"`
-		MaxUses           int64                `json:"max_uses"`
-		TargetType        api.InviteTargetType `json:"target_type,omitempty"`
-		TargetUser        api.User             `json:"target_user,omitempty"`
-		TargetApplication api.Application      `json:"target_application,omitempty"`
-		Temporary         bool                 `json:"temporary"`
-		Uses              int                  `json:"uses"`
+		ChannelID         api.Snowflake        `json:"channel_id"`                   // the channel the invite is for
+		Code              string               `json:"code"`                         // the unique invite code
+		CreatedAt         api.Timestamp        `json:"created_at"`                   // the time at which the invite was created
+		GuildID           api.Snowflake        `json:"guild_id,omitempty"`           // the guild of the invite
+		Inviter           *api.User            `json:"inviter,omitempty"`            // the user that created the invite
+		MaxAge            int64                `json:"max_age"`                      // how long the invite is valid for, in seconds; 0 never expires
+		MaxUses           int64                `json:"max_uses"`                     // the maximum number of times the invite can be used; 0 is unlimited
+		TargetType        api.InviteTargetType `json:"target_type,omitempty"`        // the type of target for this voice channel invite
+		TargetUser        *api.User            `json:"target_user,omitempty"`        // the user whose stream to display for this voice channel stream invite
+		TargetApplication *api.Application     `json:"target_application,omitempty"` // the embedded application to open for this voice channel embedded application invite
+		Temporary         bool                 `json:"temporary"`                    // whether the invite only grants temporary membership
+		Uses              int                  `json:"uses"`                         // how many times the invite has been used; always 0
 	}
 
 	// InviteDelete - Sent when an invite is deleted.
 	InviteDelete struct {
-		ChannelID api.Snowflake `json:"channel_id"`
-		GuildID   api.Snowflake `json:"guild_id,omitempty"`
-		Code      string        `json:"code"`
+		ChannelID api.Snowflake `json:"channel_id"`         // the channel of the invite
+		GuildID   api.Snowflake `json:"guild_id,omitempty"` // the guild of the invite
+		Code      string        `json:"code"`               // the unique invite code
 	}
 )
+
+// ExpiresAt - Returns when the invite expires, or the zero time when it never does
+func (i *InviteCreate) ExpiresAt() time.Time {
+	if i.MaxAge == 0 {
+		return time.Time{}
+	}
+
+	return i.CreatedAt.Add(time.Duration(i.MaxAge) * time.Second)
+}
@@ -24,7 +24,6 @@ type (
 	// MessageCreate - Sent when a message is created. The inner payload is a message object with the following extra fields
 	MessageCreate struct {
 		api.Message
-		GuildID  api.Snowflake   `json:"guild_id,omitempty"`
 		Member   api.GuildMember `json:"member,omitempty"`
 		Mentions []api.User      `json:"mentions"`
 	}
@@ -15,17 +15,22 @@
 package presence
 
 import (
+	"encoding/json"
+
 	"github.com/veteran-software/discord-api-wrapper/v10/api"
 )
 
 /* PRESENCE */
 
 type (
-	Status       string
+	// Status - a user's online status
+	Status string
+
+	// ClientStatus - the user's status per platform; a platform is absent when the user has no session on it
 	ClientStatus struct {
-		Desktop string `json:"desktop,omitempty"` // the user's status set for an active desktop (Windows, Linux, Mac) application session
-		Mobile  string `json:"mobile,omitempty"`  // the user's status set for an active mobile (iOS, Android) application session
-		Web     string `json:"web,omitempty"`     // the user's status set for an active web (browser, bot account) application session
+		Desktop Status `json:"desktop,omitempty"` // the user's status set for an active desktop (Windows, Linux, Mac) application session
+		Mobile  Status `json:"mobile,omitempty"`  // the user's status set for an active mobile (iOS, Android) application session
+		Web     Status `json:"web,omitempty"`     // the user's status set for an active web (browser, bot account) application session
 	}
 
 	// ActivityFlag - describes what the payload includes
@@ -53,7 +58,10 @@ const (
 	ActivityFlagPartyPrivacyFriends      ActivityFlag = 1 << 6
 	ActivityFlagPartyPrivacyVoiceChannel ActivityFlag = 1 << 7
 	ActivityFlagEmbedded                 ActivityFlag = 1 << 8
+)
 
+//goland:noinspection GoUnusedConst
+const (
 	Game      ActivityType = iota // Playing {name}
 	Streaming                     // Streaming {details}
 	Listening                     // Listening to {name}
@@ -73,22 +81,24 @@ type (
 	}
 
 	// Activity - represents a user activity
+	//
+	// Bots can only send Name, Type, URL and, for a Custom status, State; the other fields are only received.
 	Activity struct {
-		Name          string             `json:"name"`                     // the activity's name
-		Type          ActivityType       `json:"type"`                     // activity type
-		URL           *string            `json:"url,omitempty"`            // stream url, is validated when type is 1
-		CreatedAt     int64              `json:"created_at"`               // unix timestamp (in milliseconds) of when the activity was added to the user's session
-		Timestamps    ActivityTimestamps `json:"timestamps,omitempty"`     // unix timestamps for start and/or end of the game
-		ApplicationID api.Snowflake      `json:"application_id,omitempty"` // application id for the game
-		Details       *string            `json:"details,omitempty"`        // what the player is currently doing
-		State         *string            `json:"state,omitempty"`          // the user's current party status
-		Emoji         *api.Emoji         `json:"emoji,omitempty"`          // the emoji used for a custom status
-		Party         ActivityParty      `json:"party,omitempty"`          // information for the current party of the player
-		Assets        ActivityAssets     `json:"assets,omitempty"`         // images for the presence and their hover texts
-		Secrets       ActivitySecrets    `json:"secrets,omitempty"`        // secrets for Rich Presence joining and spectating
-		Instance      bool               `json:"instance,omitempty"`       // whether the activity is an instanced game session
-		Flags         ActivityFlag       `json:"flags,omitempty"`          // activity flags ORd together, describes what the payload includes
-		Buttons       []*ActivityButtons `json:"buttons,omitempty"`        // the custom buttons shown in the Rich Presence (max 2)
+		Name          string              `json:"name"`                     // the activity's name
+		Type          ActivityType        `json:"type"`                     // activity type
+		URL           *string             `json:"url,omitempty"`            // stream url, is validated when type is 1
+		CreatedAt     int64               `json:"created_at,omitempty"`     // unix timestamp (in milliseconds) of when the activity was added to the user's session
+		Timestamps    *ActivityTimestamps `json:"timestamps,omitempty"`     // unix timestamps for start and/or end of the game
+		ApplicationID api.Snowflake       `json:"application_id,omitempty"` // application id for the game
+		Details       *string             `json:"details,omitempty"`        // what the player is currently doing
+		State         *string             `json:"state,omitempty"`          // the user's current party status, or the text of a Custom status
+		Emoji         *ActivityEmoji      `json:"emoji,omitempty"`          // the emoji used for a custom status
+		Party         *ActivityParty      `json:"party,omitempty"`          // information for the current party of the player
+		Assets        *ActivityAssets     `json:"assets,omitempty"`         // images for the presence and their hover texts
+		Secrets       *ActivitySecrets    `json:"secrets,omitempty"`        // secrets for Rich Presence joining and spectating
+		Instance      bool                `json:"instance,omitempty"`       // whether the activity is an instanced game session
+		Flags         ActivityFlag        `json:"flags,omitempty"`          // activity flags ORd together, describes what the payload includes
+		Buttons       []*ActivityButtons  `json:"buttons,omitempty"`        // the custom buttons shown in the Rich Presence (max 2)
 	}
 
 	// ActivityTimestamps - start and stop timestamps for an activity
@@ -107,7 +117,7 @@ type (
 	// ActivityParty - information for the current party of the player
 	ActivityParty struct {
 		ID   string    `json:"id,omitempty"`   // the id of the party
-		Size [2]uint16 `json:"size,omitempty"` // the id of the party; used to show the party's current and maximum size
+		Size [2]uint16 `json:"size,omitempty"` // the party's current and maximum size
 	}
 
 	// ActivityAssets - images for the presence and their hover texts
@@ -147,3 +157,26 @@ type (
 	// UserUpdate - Sent when properties about the current bot's user change. Inner payload is a user object.
 	UserUpdate api.User
 )
+
+// UnmarshalJSON - Accepts both the button labels sent over the gateway and full button objects
+func (b *ActivityButtons) UnmarshalJSON(data []byte) error {
+	var label string
+	if err := json.Unmarshal(data, &label); err == nil {
+		*b = ActivityButtons{Label: label}
+		return nil
+	}
+
+	type button ActivityButtons
+	return json.Unmarshal(data, (*button)(b))
+}
+
+// CustomStatus - Returns the user's Custom status activity, or nil when they have none
+func (u *Update) CustomStatus() *Activity {
+	for _, activity := range u.Activities {
+		if activity.Type == Custom {
+			return activity
+		}
+	}
+
+	return nil
+}
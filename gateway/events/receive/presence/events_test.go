@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package presence
+
+import "testing"
+
+// The activity types used to share a const block with the flags, which shifted Game to 13
+func TestActivityTypeValues(t *testing.T) {
+	tests := []struct {
+		activityType ActivityType
+		want         uint8
+	}{
+		{Game, 0},
+		{Streaming, 1},
+		{Listening, 2},
+		{Watching, 3},
+		{Custom, 4},
+		{Competing, 5},
+	}
+	for _, tt := range tests {
+		if uint8(tt.activityType) != tt.want {
+			t.Errorf("ActivityType = %d, want %d", tt.activityType, tt.want)
+		}
+	}
+}
@@ -34,4 +34,11 @@ type (
 		GuildID  api.Snowflake `json:"guild_id"`
 		Endpoint *string       `json:"endpoint"`
 	}
+
+	// ChannelStatusUpdate - Sent when the status of a voice channel is set or cleared
+	ChannelStatusUpdate struct {
+		ID      api.Snowflake `json:"id"`       // the id of the voice channel
+		GuildID api.Snowflake `json:"guild_id"` // the id of the guild
+		Status  *string       `json:"status"`   // the new status, or null when it was cleared
+	}
 )
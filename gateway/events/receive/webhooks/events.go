@@ -22,8 +22,10 @@ import (
 
 type (
 	// Update - Sent when a guild channel's webhook is created, updated, or deleted.
+	//
+	// The payload does not say which webhook changed; fetch the channel's webhooks to find out.
 	Update struct {
-		GuildID   api.Snowflake `json:"guild_id"`
-		ChannelID api.Snowflake `json:"channel_id"`
+		GuildID   api.Snowflake `json:"guild_id"`   // the guild of the channel
+		ChannelID api.Snowflake `json:"channel_id"` // the channel whose webhooks changed
 	}
 )
@@ -15,6 +15,8 @@
 package send
 
 import (
+	"encoding/json"
+
 	"github.com/veteran-software/discord-api-wrapper/v10/api"
 	"github.com/veteran-software/discord-api-wrapper/v10/gateway"
 	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/presence"
@@ -26,8 +28,8 @@ type Identify struct {
 	Properties     ConnectionProperties `json:"properties"`                // ConnectionProperties properties
 	Compress       bool                 `json:"compress,omitempty"`        // whether this connection supports compression of packets
 	LargeThreshold int                  `json:"large_threshold,omitempty"` // value between 50 and 250, total number of members where the gateway will stop sending offline members in the guild member list
-	Shard          [2]int               `json:"shard,omitempty"`           // used for Guild Sharding
-	Presence       PresenceUpdate       `json:"presence,omitempty"`        // presence structure for initial presence information
+	Shard          *[2]int              `json:"shard,omitempty"`           // [shard_id, num_shards], used for Guild Sharding
+	Presence       *PresenceUpdate      `json:"presence,omitempty"`        // presence structure for initial presence information
 	Intents        gateway.Intents      `json:"intents"`                   // the Gateway Intents you wish to receive
 }
 
@@ -51,10 +53,19 @@ type Resume struct {
 //
 // The inner `d` key is the last sequence number — s — received by the client.
 //
-// If you have not yet received one, send `null`.
+// If you have not yet received one, leave Sequence nil to send `null`.
 type Heartbeat struct {
-	Op int `json:"op"`
-	D  int `json:"d"`
+	Sequence *int // last sequence number received
+}
+
+// MarshalJSON - A heartbeat's data is the bare sequence number
+func (h Heartbeat) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.Sequence)
+}
+
+// UnmarshalJSON - A heartbeat's data is the bare sequence number
+func (h *Heartbeat) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &h.Sequence)
 }
 
 // RequestGuildMembers - Used to request all members for a guild or a list of guilds.
@@ -99,6 +110,13 @@ type PresenceUpdate struct {
 	Afk        bool                 `json:"afk"`        // Whether or not the client is afk
 }
 
+// RequestSoundboardSounds - Used to request soundboard sounds for a list of guilds.
+//
+// The gateway responds with a Soundboard Sounds event for each guild.
+type RequestSoundboardSounds struct {
+	GuildIDs []api.Snowflake `json:"guild_ids"` // ids of the guilds to get soundboard sounds for
+}
+
 // StatusType - a user's current activity status
 type StatusType string
 
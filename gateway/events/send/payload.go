@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package send
+
+import (
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway"
+)
+
+// Command - a payload the client sends to the gateway
+type Command interface {
+	OpCode() gateway.OpCode // the opcode the payload is sent with
+}
+
+// Payload - the envelope every gateway command is sent in
+type Payload struct {
+	Op gateway.OpCode `json:"op"` // the opcode of the command
+	D  Command        `json:"d"`  // the command's data
+}
+
+// NewPayload - Wraps a command in its envelope
+func NewPayload(command Command) Payload {
+	return Payload{Op: command.OpCode(), D: command}
+}
+
+// Marshal - Encodes a command as the {"op": n, "d": …} message to write to the websocket
+//
+//goland:noinspection GoUnusedExportedFunction
+func Marshal(command Command) ([]byte, error) {
+	return api.JSONMarshal(NewPayload(command))
+}
+
+// OpCode - Identify is sent with opcode 2
+func (Identify) OpCode() gateway.OpCode { return gateway.Identify }
+
+// OpCode - Resume is sent with opcode 6
+func (Resume) OpCode() gateway.OpCode { return gateway.Resume }
+
+// OpCode - Heartbeat is sent with opcode 1
+func (Heartbeat) OpCode() gateway.OpCode { return gateway.Heartbeat }
+
+// OpCode - RequestGuildMembers is sent with opcode 8
+func (RequestGuildMembers) OpCode() gateway.OpCode { return gateway.RequestGuildMembers }
+
+// OpCode - VoiceStateUpdate is sent with opcode 4
+func (VoiceStateUpdate) OpCode() gateway.OpCode { return gateway.VoiceStateUpdate }
+
+// OpCode - PresenceUpdate is sent with opcode 3
+func (PresenceUpdate) OpCode() gateway.OpCode { return gateway.PresenceUpdate }
+
+// OpCode - RequestSoundboardSounds is sent with opcode 31
+func (RequestSoundboardSounds) OpCode() gateway.OpCode { return gateway.RequestSoundboardSounds }
@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package send
+
+import (
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestMarshal(t *testing.T) {
+	seq := 42
+
+	tests := []struct {
+		name    string
+		command Command
+		want    string
+	}{
+		{
+			name:    "Heartbeat",
+			command: Heartbeat{Sequence: &seq},
+			want:    `{"op":1,"d":42}`,
+		},
+		{
+			name:    "First heartbeat",
+			command: Heartbeat{},
+			want:    `{"op":1,"d":null}`,
+		},
+		{
+			name:    "Resume",
+			command: Resume{Token: "t", SessionID: "s", Seq: 7},
+			want:    `{"op":6,"d":{"token":"t","session_id":"s","seq":7}}`,
+		},
+		{
+			name:    "Identify without shard or presence",
+			command: Identify{Token: "t", Properties: ConnectionProperties{OS: "linux", Browser: "lib", Device: "lib"}, Intents: 513},
+			want:    `{"op":2,"d":{"token":"t","properties":{"os":"linux","browser":"lib","device":"lib"},"intents":513}}`,
+		},
+		{
+			name:    "Voice disconnect",
+			command: VoiceStateUpdate{GuildID: "1"},
+			want:    `{"op":4,"d":{"guild_id":"1","channel_id":null,"self_mute":false,"self_deaf":false}}`,
+		},
+		{
+			name:    "Soundboard sounds",
+			command: RequestSoundboardSounds{GuildIDs: []api.Snowflake{"1", "2"}},
+			want:    `{"op":31,"d":{"guild_ids":["1","2"]}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal(tt.command)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
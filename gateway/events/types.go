@@ -77,6 +77,7 @@ const (
 	StageInstanceUpdate                 RawType = "STAGE_INSTANCE_UPDATE"
 	TypingStart                         RawType = "TYPING_START"
 	UserUpdate                          RawType = "USER_UPDATE"
+	VoiceChannelStatusUpdate            RawType = "VOICE_CHANNEL_STATUS_UPDATE"
 	VoiceStateUpdate                    RawType = "VOICE_STATE_UPDATE"
 	VoiceServerUpdate                   RawType = "VOICE_SERVER_UPDATE"
 	WebhooksUpdate                      RawType = "WEBHOOKS_UPDATE"
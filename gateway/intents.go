@@ -28,6 +28,7 @@ const (
 	Guilds                      Intents = 1 << 0
 	GuildMembers                Intents = 1 << 1
 	GuildBans                   Intents = 1 << 2
+	GuildModeration             Intents = GuildBans // GuildBans was renamed; also delivers GuildAuditLogEntryCreate
 	GuildEmojisAndStickers      Intents = 1 << 3
 	GuildIntegrations           Intents = 1 << 4
 	GuildWebhooks               Intents = 1 << 5
@@ -34,4 +34,5 @@ const (
 	InvalidSession
 	Hello
 	HeartbeatAck
+	RequestSoundboardSounds OpCode = 31
 )
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gateway
+
+import (
+	"math/rand"
+	"time"
+)
+
+//goland:noinspection GoUnusedConst
+const (
+	DefaultReconnectMinDelay = time.Second     // the first delay of a ReconnectPolicy when MinDelay is zero
+	DefaultReconnectMaxDelay = 2 * time.Minute // the longest delay of a ReconnectPolicy when MaxDelay is zero
+	rateLimitedMinDelay      = 5 * time.Second // the least a client waits after being closed with CloseRateLimited
+)
+
+// ReconnectPolicy - decides whether and when a gateway client reconnects after its connection closes
+//
+// Delays double with each consecutive failed attempt, from MinDelay up to MaxDelay, and up to Jitter of each delay is randomised
+// so many shards do not reconnect in lockstep. The zero value is ready to use.
+type ReconnectPolicy struct {
+	MinDelay    time.Duration // the delay before the first attempt; DefaultReconnectMinDelay when zero
+	MaxDelay    time.Duration // the longest delay between attempts; DefaultReconnectMaxDelay when zero
+	Jitter      float64       // the fraction of each delay that is random, from 0 to 1; 0.5 when zero, so delays fall between half and all of the backoff; negative for none
+	MaxAttempts int           // consecutive failed attempts before giving up; unlimited when zero
+}
+
+// ReconnectDecision - what a gateway client should do after its connection closes
+type ReconnectDecision struct {
+	Action CloseAction   // whether to resume, start a new session or stop
+	Delay  time.Duration // how long to wait before connecting again
+}
+
+// Decide - Returns what to do after the connection closed with the code, following attempt consecutive failed attempts (0 for the first reconnect)
+func (p ReconnectPolicy) Decide(code CloseCode, attempt int) ReconnectDecision {
+	action := code.Action()
+	if action == CloseActionStop || (p.MaxAttempts > 0 && attempt >= p.MaxAttempts) {
+		return ReconnectDecision{Action: CloseActionStop}
+	}
+
+	delay := p.Backoff(attempt)
+	if code == CloseRateLimited && delay < rateLimitedMinDelay {
+		delay = rateLimitedMinDelay
+	}
+
+	return ReconnectDecision{Action: action, Delay: delay}
+}
+
+// Backoff - Returns the delay before the attempt, with jitter applied
+func (p ReconnectPolicy) Backoff(attempt int) time.Duration {
+	minDelay := p.MinDelay
+	if minDelay <= 0 {
+		minDelay = DefaultReconnectMinDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultReconnectMaxDelay
+	}
+	jitter := p.Jitter
+	switch {
+	case jitter == 0:
+		jitter = 0.5
+	case jitter < 0:
+		jitter = 0
+	case jitter > 1:
+		jitter = 1
+	}
+
+	delay := minDelay
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	random := time.Duration(float64(delay) * jitter)
+	if random <= 0 {
+		return delay
+	}
+
+	return delay - random + time.Duration(rand.Int63n(int64(random)+1))
+}
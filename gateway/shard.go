@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// EventRateWindow - the period ShardHealth.EventsPerSecond is averaged over
+const EventRateWindow = 10 * time.Second
+
+// ErrZombieConnection - returned by Shard.RunHeartbeat when the previous heartbeat was never acknowledged
+//
+// Close the connection with CloseZombieConnection, which keeps the session resumable, then reconnect and Resume.
+var ErrZombieConnection = errors.New("no heartbeat ACK received since the last heartbeat; the connection is a zombie")
+
+// Shard - tracks the health of one gateway connection
+//
+// The connection reports what happens on it by calling HeartbeatSent, HeartbeatAcked, EventReceived and Resumed;
+// Health returns a snapshot that operators can alert on, e.g. a growing SinceLastEvent on a busy shard is a sign of a zombie connection.
+type Shard struct {
+	ID    int // the shard ID, as sent in Identify
+	Count int // the total number of shards
+
+	// OnHealth is called, if set, with a snapshot after every heartbeat ACK.
+	//
+	// It runs on the connection's goroutine, so it should return quickly. Set it before connecting.
+	OnHealth func(health ShardHealth)
+
+	mu            sync.Mutex
+	heartbeatSent time.Time
+	lastAck       time.Time
+	latency       time.Duration
+	awaitingAck   bool
+	lastEvent     time.Time
+	resumes       int
+	zombies       int
+	slots         [10]int
+	times         [10]int64
+}
+
+// ShardHealth - a point-in-time view of a Shard
+type ShardHealth struct {
+	ID               int
+	Count            int
+	Latency          time.Duration // the round trip of the last acknowledged heartbeat
+	LastHeartbeatAck time.Time     // when the last heartbeat ACK arrived; the zero value means none has
+	AwaitingAck      bool          // whether a heartbeat has been sent and not yet acknowledged
+	EventsPerSecond  float64       // Dispatch events received per second, averaged over EventRateWindow
+	LastEvent        time.Time     // when the last Dispatch event arrived; the zero value means none has
+	SinceLastEvent   time.Duration // time since LastEvent; zero when no event has arrived
+	Resumes          int           // sessions resumed since the Shard was created
+	Zombies          int           // connections found to be zombies since the Shard was created
+}
+
+// HeartbeatSent - Records that a heartbeat was sent
+func (s *Shard) HeartbeatSent() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.heartbeatSent = time.Now()
+	s.awaitingAck = true
+}
+
+// HeartbeatAcked - Records a heartbeat ACK, updating the latency, and calls OnHealth
+func (s *Shard) HeartbeatAcked() {
+	s.mu.Lock()
+	now := time.Now()
+	if s.awaitingAck {
+		s.latency = now.Sub(s.heartbeatSent)
+	}
+	s.awaitingAck = false
+	s.lastAck = now
+	health := s.health(now)
+	s.mu.Unlock()
+
+	if s.OnHealth != nil {
+		s.OnHealth(health)
+	}
+}
+
+// EventReceived - Records a Dispatch event
+func (s *Shard) EventReceived() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.lastEvent = now
+
+	second := now.Unix()
+	slot := second % int64(len(s.slots))
+	if s.times[slot] != second {
+		s.times[slot] = second
+		s.slots[slot] = 0
+	}
+	s.slots[slot]++
+}
+
+// Resumed - Records a resumed session
+func (s *Shard) Resumed() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resumes++
+}
+
+// RunHeartbeat - Sends a heartbeat every interval, as given in Hello, until the context ends or the connection turns out to be a zombie
+//
+// The first heartbeat is sent after a random fraction of the interval, as Discord asks. If the heartbeat before has not been acknowledged
+// by the time the next is due, RunHeartbeat returns ErrZombieConnection instead of sending it. Errors from send are returned as is.
+// Call HeartbeatAcked when a Heartbeat ACK arrives, and send a heartbeat straight away when Discord asks for one.
+func (s *Shard) RunHeartbeat(ctx context.Context, interval time.Duration, send func() error) error {
+	s.mu.Lock()
+	s.awaitingAck = false
+	s.mu.Unlock()
+
+	timer := time.NewTimer(time.Duration(rand.Float64() * float64(interval)))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		s.mu.Lock()
+		zombie := s.awaitingAck
+		if zombie {
+			s.zombies++
+		}
+		s.mu.Unlock()
+		if zombie {
+			return ErrZombieConnection
+		}
+
+		// Recorded first, so an ACK that arrives before send returns is not taken for the next heartbeat's
+		s.HeartbeatSent()
+		if err := send(); err != nil {
+			return err
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// Health - Returns a snapshot of the Shard's heartbeat latency and event activity
+func (s *Shard) Health() ShardHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.health(time.Now())
+}
+
+func (s *Shard) health(now time.Time) ShardHealth {
+	health := ShardHealth{
+		ID:               s.ID,
+		Count:            s.Count,
+		Latency:          s.latency,
+		LastHeartbeatAck: s.lastAck,
+		AwaitingAck:      s.awaitingAck,
+		LastEvent:        s.lastEvent,
+		Resumes:          s.resumes,
+		Zombies:          s.zombies,
+	}
+	if !s.lastEvent.IsZero() {
+		health.SinceLastEvent = now.Sub(s.lastEvent)
+	}
+
+	second := now.Unix()
+	total := 0
+	for i, t := range s.times {
+		if second-t < int64(len(s.slots)) {
+			total += s.slots[i]
+		}
+	}
+	health.EventsPerSecond = float64(total) / EventRateWindow.Seconds()
+
+	return health
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShardHealth(t *testing.T) {
+	var reported []ShardHealth
+	s := &Shard{ID: 1, Count: 2, OnHealth: func(h ShardHealth) { reported = append(reported, h) }}
+
+	if h := s.Health(); h.SinceLastEvent != 0 || h.EventsPerSecond != 0 || !h.LastHeartbeatAck.IsZero() {
+		t.Errorf("Health() before any activity = %+v", h)
+	}
+
+	s.HeartbeatSent()
+	if !s.Health().AwaitingAck {
+		t.Error("Health().AwaitingAck = false after HeartbeatSent()")
+	}
+	time.Sleep(5 * time.Millisecond)
+	s.HeartbeatAcked()
+
+	for i := 0; i < 20; i++ {
+		s.EventReceived()
+	}
+	s.Resumed()
+
+	h := s.Health()
+	if h.ID != 1 || h.Count != 2 || h.AwaitingAck || h.Latency < 5*time.Millisecond || h.Resumes != 1 {
+		t.Errorf("Health() = %+v", h)
+	}
+	if h.EventsPerSecond != 2 {
+		t.Errorf("Health().EventsPerSecond = %v, want 2", h.EventsPerSecond)
+	}
+	if h.LastEvent.IsZero() || h.SinceLastEvent < 0 {
+		t.Errorf("Health() LastEvent = %v, SinceLastEvent = %v", h.LastEvent, h.SinceLastEvent)
+	}
+	if len(reported) != 1 || reported[0].Latency != h.Latency {
+		t.Errorf("OnHealth() called with %+v, want one snapshot after the ACK", reported)
+	}
+}
+
+func TestShardRunHeartbeatZombie(t *testing.T) {
+	s := &Shard{}
+
+	sent := 0
+	err := s.RunHeartbeat(context.Background(), 5*time.Millisecond, func() error {
+		sent++
+		return nil
+	})
+	if !errors.Is(err, ErrZombieConnection) || sent != 1 {
+		t.Errorf("RunHeartbeat() = %v after %d heartbeats, want ErrZombieConnection after 1", err, sent)
+	}
+	if h := s.Health(); h.Zombies != 1 {
+		t.Errorf("Health().Zombies = %d, want 1", h.Zombies)
+	}
+	if action := CloseZombieConnection.Action(); action != CloseActionResume {
+		t.Errorf("CloseZombieConnection.Action() = %v, want CloseActionResume", action)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	sent = 0
+	err = s.RunHeartbeat(ctx, 5*time.Millisecond, func() error {
+		sent++
+		s.HeartbeatAcked()
+		return nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) || sent < 2 {
+		t.Errorf("RunHeartbeat() with ACKs = %v after %d heartbeats, want the context error after several", err, sent)
+	}
+}
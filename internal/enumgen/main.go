@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Command enumgen writes String methods for the integer enums of a package, so logs show constant names rather than numbers.
+//
+// Value enums return the name of the matching constant; bitfield enums listed in -flags return the names of every set bit joined with "|".
+//
+// Usage, from the package directory:
+//
+//	go run ../internal/enumgen -output enums_string.go -flags MessageFlags,Permission
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type enumValue struct {
+	name  string
+	value uint64
+}
+
+func main() {
+	output := flag.String("output", "enums_string.go", "file to write, relative to the package directory")
+	flagTypes := flag.String("flags", "", "comma separated list of bitfield types")
+	skipTypes := flag.String("skip", "", "comma separated list of types to leave alone")
+	flag.Parse()
+
+	bitfields := toSet(*flagTypes)
+	skipped := toSet(*skipTypes)
+
+	fset := token.NewFileSet()
+	files, pkgName := parsePackage(fset, *output)
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check(pkgName, fset, files, nil)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	enums := map[string][]enumValue{}
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !c.Exported() {
+			continue
+		}
+
+		named, ok := c.Type().(*types.Named)
+		if !ok || named.Obj().Pkg() != pkg || skipped[named.Obj().Name()] {
+			continue
+		}
+		if basic, ok := named.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+			continue
+		}
+		if hasStringMethod(named) {
+			continue
+		}
+
+		value, exact := constant.Uint64Val(constant.ToInt(c.Val()))
+		if !exact {
+			continue
+		}
+
+		typeName := named.Obj().Name()
+		enums[typeName] = append(enums[typeName], enumValue{name: name, value: value})
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"go run ../internal/enumgen %s\"; DO NOT EDIT.\n\n", strings.Join(os.Args[1:], " "))
+	fmt.Fprintf(&buf, "package %s\n\nimport \"strconv\"\n", pkgName)
+
+	typeNames := make([]string, 0, len(enums))
+	for typeName := range enums {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	for _, typeName := range typeNames {
+		values := dedupe(enums[typeName])
+		if bitfields[typeName] {
+			writeFlags(&buf, typeName, values)
+		} else {
+			writeValues(&buf, typeName, values)
+		}
+	}
+
+	if len(bitfields) > 0 {
+		buf.WriteString(flagHelper)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if err = os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// parsePackage parses every non-test file in the working directory except the previous output
+func parsePackage(fset *token.FileSet, output string) ([]*ast.File, string) {
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	var (
+		files   []*ast.File
+		pkgName string
+	)
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") || path == output {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		pkgName = file.Name.Name
+		files = append(files, file)
+	}
+
+	return files, pkgName
+}
+
+func hasStringMethod(named *types.Named) bool {
+	for i := 0; i < named.NumMethods(); i++ {
+		if named.Method(i).Name() == "String" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dedupe keeps the first constant declared for each value, ordered by value
+func dedupe(values []enumValue) []enumValue {
+	sort.SliceStable(values, func(i, j int) bool { return values[i].value < values[j].value })
+
+	out := values[:0]
+	for i, v := range values {
+		if i > 0 && v.value == values[i-1].value {
+			continue
+		}
+		out = append(out, v)
+	}
+
+	return out
+}
+
+func writeValues(buf *bytes.Buffer, typeName string, values []enumValue) {
+	fmt.Fprintf(buf, "\n// String - Returns the name of the %[1]s constant, or %[1]s(n) for values this package does not know\n", typeName)
+	fmt.Fprintf(buf, "func (i %s) String() string {\n\tswitch i {\n", typeName)
+	for _, v := range values {
+		fmt.Fprintf(buf, "\tcase %s:\n\t\treturn %q\n", v.name, v.name)
+	}
+	fmt.Fprintf(buf, "\tdefault:\n\t\treturn \"%s(\" + strconv.FormatInt(int64(i), 10) + \")\"\n\t}\n}\n", typeName)
+}
+
+func writeFlags(buf *bytes.Buffer, typeName string, values []enumValue) {
+	varName := strings.ToLower(typeName[:1]) + typeName[1:] + "Names"
+
+	fmt.Fprintf(buf, "\nvar %s = []flagName{\n", varName)
+	zero := ""
+	for _, v := range values {
+		if v.value == 0 {
+			zero = v.name
+			continue
+		}
+		fmt.Fprintf(buf, "\t{uint64(%s), %q},\n", v.name, v.name)
+	}
+	buf.WriteString("}\n")
+
+	fmt.Fprintf(buf, "\n// String - Returns the names of the %[1]s bits that are set joined with \"|\", with unknown bits as %[1]s(n)\n", typeName)
+	fmt.Fprintf(buf, "func (i %s) String() string {\n\treturn flagString(%q, %q, uint64(i), %s)\n}\n", typeName, typeName, zero, varName)
+}
+
+const flagHelper = `
+// flagName - the name of a single bit of a bitfield enum
+type flagName struct {
+	bit  uint64
+	name string
+}
+
+func flagString(typeName, zero string, value uint64, names []flagName) string {
+	if value == 0 {
+		if zero != "" {
+			return zero
+		}
+		return typeName + "(0)"
+	}
+
+	var parts []string
+	for _, f := range names {
+		if value&f.bit == f.bit {
+			parts = append(parts, f.name)
+			value &^= f.bit
+		}
+	}
+	if value != 0 {
+		parts = append(parts, typeName+"("+strconv.FormatUint(value, 10)+")")
+	}
+
+	out := parts[0]
+	for _, part := range parts[1:] {
+		out += "|" + part
+	}
+
+	return out
+}
+`
+
+func toSet(list string) map[string]bool {
+	set := map[string]bool{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			set[item] = true
+		}
+	}
+
+	return set
+}
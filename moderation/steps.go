@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package moderation
+
+import (
+	"context"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// AddRole - A Step that gives the member the role, and takes it away again on rollback if they did not have it
+func AddRole(member *api.GuildMember, roleID api.Snowflake, reason *string) Step {
+	had := member.HasRole(roleID)
+
+	return Step{
+		Name: "add role " + roleID.String(),
+		Do:   func() error { return member.AddRole(roleID, reason) },
+		Undo: func() error {
+			if had {
+				return nil
+			}
+			return member.RemoveRole(roleID, reason)
+		},
+	}
+}
+
+// RemoveRole - A Step that takes the role from the member, and gives it back on rollback if they had it
+func RemoveRole(member *api.GuildMember, roleID api.Snowflake, reason *string) Step {
+	had := member.HasRole(roleID)
+
+	return Step{
+		Name: "remove role " + roleID.String(),
+		Do:   func() error { return member.RemoveRole(roleID, reason) },
+		Undo: func() error {
+			if !had {
+				return nil
+			}
+			return member.AddRole(roleID, reason)
+		},
+	}
+}
+
+// Timeout - A Step that times the member out until the given time, and restores their previous timeout, if any, on rollback
+func Timeout(member *api.GuildMember, until time.Time, reason *string) Step {
+	previous := member.CommunicationDisabledUntil
+
+	return Step{
+		Name: "timeout until " + until.UTC().Format(time.RFC3339),
+		Do: func() error {
+			_, err := member.Timeout(until, reason)
+			return err
+		},
+		Undo: func() error {
+			var err error
+			if previous != nil && previous.After(time.Now()) {
+				_, err = member.Timeout(previous.Time, reason)
+			} else {
+				_, err = member.RemoveTimeout(reason)
+			}
+			return err
+		},
+	}
+}
+
+// SendMessage - A Step that posts the message in the channel, and deletes it on rollback
+func SendMessage(channel *api.Channel, payload api.CreateMessageJSON) Step {
+	var sent *api.Message
+
+	return Step{
+		Name: "send message to " + channel.ID.String(),
+		Do: func() error {
+			var err error
+			sent, err = channel.CreateMessage(payload)
+			return err
+		},
+		Undo: func() error {
+			if sent == nil {
+				return nil
+			}
+			return channel.DeleteMessage(sent.ID.String(), nil)
+		},
+	}
+}
+
+// DirectMessage - A Step that sends the message to the user in a DM, and deletes it on rollback
+//
+// Users who do not accept DMs from the guild make this step fail, so put it last when a welcome DM is optional.
+func DirectMessage(user *api.User, payload api.CreateMessageJSON) Step {
+	var dm *api.Channel
+	var sent *api.Message
+
+	return Step{
+		Name: "direct message " + user.ID.String(),
+		Do: func() error {
+			var err error
+			if dm, err = user.CreateDM(); err != nil {
+				return err
+			}
+			sent, err = dm.CreateMessage(payload)
+			return err
+		},
+		Undo: func() error {
+			if sent == nil {
+				return nil
+			}
+			return dm.DeleteMessage(sent.ID.String(), nil)
+		},
+	}
+}
+
+// Verification - what VerifyMember does to let a member through a verification gate
+type Verification struct {
+	VerifiedRoleID   api.Snowflake         // the role granted to verified members
+	UnverifiedRoleID api.Snowflake         // the role held by members awaiting verification, removed when they pass; optional
+	WelcomeChannel   *api.Channel          // the channel Welcome is posted in; optional
+	Welcome          api.CreateMessageJSON // the welcome message
+	Reason           *string               // the audit log reason for the role changes
+}
+
+// VerifyMember - Grants the verified role, removes the unverified role and posts the welcome message, rolling back the roles if the welcome fails
+//
+// The member must have its GuildID set; see api.Interaction.GuildMember.
+func VerifyMember(ctx context.Context, member *api.GuildMember, verification Verification) error {
+	workflow := NewWorkflow(AddRole(member, verification.VerifiedRoleID, verification.Reason))
+	if verification.UnverifiedRoleID != "" && member.HasRole(verification.UnverifiedRoleID) {
+		workflow.Add(RemoveRole(member, verification.UnverifiedRoleID, verification.Reason))
+	}
+	if verification.WelcomeChannel != nil {
+		workflow.Add(SendMessage(verification.WelcomeChannel, verification.Welcome))
+	}
+
+	return workflow.Run(ctx)
+}
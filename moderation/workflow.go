@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package moderation combines REST calls into member workflows, such as verification gates, that undo their completed steps when a later one fails.
+package moderation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// Step - one action of a Workflow and how to reverse it
+type Step struct {
+	Name string       // describes the step in errors, e.g. "add role 123"
+	Do   func() error // performs the step
+	Undo func() error // reverses the step once Do has succeeded; nil when there is nothing to reverse
+}
+
+// StepError - returned by Workflow.Run when a step fails
+type StepError struct {
+	Step     string  // the name of the step that failed
+	Err      error   // the error the step returned, or the context's error
+	Rollback []error // errors from undoing the completed steps; empty when every step was reversed
+}
+
+func (e *StepError) Error() string {
+	if len(e.Rollback) > 0 {
+		return fmt.Sprintf("%s: %v (rollback incomplete: %v)", e.Step, e.Err, errors.Join(e.Rollback...))
+	}
+
+	return fmt.Sprintf("%s: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// Workflow - an ordered list of steps that either all complete or are rolled back as far as Discord allows
+//
+// Rollback is best effort: a welcome message can be deleted, but the member will already have seen any ping it sent.
+type Workflow struct {
+	steps []Step
+}
+
+// NewWorkflow - Creates a Workflow running the steps in order
+func NewWorkflow(steps ...Step) *Workflow {
+	return &Workflow{steps: steps}
+}
+
+// Add - Appends steps to the Workflow
+func (w *Workflow) Add(steps ...Step) *Workflow {
+	w.steps = append(w.steps, steps...)
+
+	return w
+}
+
+// Run - Runs each step in order; when one fails, or the context is cancelled, the completed steps are undone newest first and a *StepError is returned
+func (w *Workflow) Run(ctx context.Context) error {
+	for i, step := range w.steps {
+		err := ctx.Err()
+		if err == nil {
+			err = step.Do()
+		}
+		if err != nil {
+			return &StepError{Step: step.Name, Err: err, Rollback: rollback(w.steps[:i])}
+		}
+	}
+
+	return nil
+}
+
+// rollback undoes the completed steps, newest first, carrying on past failures so as much as possible is reversed
+func rollback(completed []Step) []error {
+	var errs []error
+	for i := len(completed) - 1; i >= 0; i-- {
+		step := completed[i]
+		if step.Undo == nil {
+			continue
+		}
+
+		if err := step.Undo(); err != nil {
+			log.Errorln(log.Discord, log.FuncName(), step.Name, err)
+			errs = append(errs, fmt.Errorf("undo %s: %w", step.Name, err))
+		}
+	}
+
+	return errs
+}
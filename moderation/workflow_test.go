@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package moderation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/discordtest"
+)
+
+func TestWorkflowRollback(t *testing.T) {
+	var log []string
+	step := func(name string, fail, failUndo bool) Step {
+		return Step{
+			Name: name,
+			Do: func() error {
+				log = append(log, "do "+name)
+				if fail {
+					return errors.New("boom")
+				}
+				return nil
+			},
+			Undo: func() error {
+				log = append(log, "undo "+name)
+				if failUndo {
+					return errors.New("stuck")
+				}
+				return nil
+			},
+		}
+	}
+
+	err := NewWorkflow(step("a", false, true), step("b", false, false)).Add(step("c", true, false), step("d", false, false)).Run(context.Background())
+
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Step != "c" || len(stepErr.Rollback) != 1 {
+		t.Fatalf("Run() error = %v, want step c to fail with one rollback error", err)
+	}
+	if got := strings.Join(log, ","); got != "do a,do b,do c,undo b,undo a" {
+		t.Errorf("Run() order = %s, want do a,do b,do c,undo b,undo a", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	log = nil
+	if err = NewWorkflow(step("a", false, false)).Run(ctx); !errors.Is(err, context.Canceled) || len(log) != 0 {
+		t.Errorf("Run() cancelled = %v after %v, want context.Canceled before any step", err, log)
+	}
+}
+
+func TestAddRoleRollbackKeepsExistingRole(t *testing.T) {
+	s := discordtest.Start(t)
+	s.Reply(http.MethodPut, "/guilds/{guild.id}/members/{user.id}/roles/{role.id}", http.StatusNoContent, nil)
+	s.Reply(http.MethodDelete, "/guilds/{guild.id}/members/{user.id}/roles/{role.id}", http.StatusNoContent, nil)
+
+	held := api.Snowflake("9")
+	tests := []struct {
+		name    string
+		roles   []*api.Snowflake
+		removed int
+	}{
+		{name: "new role", removed: 1},
+		{name: "role already held", roles: []*api.Snowflake{&held}, removed: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := len(s.RequestsTo(http.MethodDelete, "/guilds/*/members/*/roles/*"))
+
+			member := &api.GuildMember{GuildID: "1", User: api.User{ID: "2"}, Roles: tt.roles}
+			fail := Step{Name: "welcome", Do: func() error { return errors.New("boom") }}
+			if err := NewWorkflow(AddRole(member, "9", nil), fail).Run(context.Background()); err == nil {
+				t.Fatal("Run() error = nil, want the welcome step to fail")
+			}
+
+			if removed := len(s.RequestsTo(http.MethodDelete, "/guilds/*/members/*/roles/*")) - before; removed != tt.removed {
+				t.Errorf("rollback removed the role %d times, want %d", removed, tt.removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,357 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package paginator pages through a set of embeds with buttons, handling the component interactions for you.
+package paginator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/collectors"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/interactions"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// DefaultTimeout - how long a Paginator waits for a page turn before disabling its buttons
+const DefaultTimeout = 5 * time.Minute
+
+// ErrNoPages - returned when a Paginator has neither Pages nor a Render function with a PageCount
+var ErrNoPages = errors.New("paginator has no pages")
+
+// Custom ID actions, appended to the Paginator's prefix
+const (
+	actionFirst    = "first"
+	actionPrevious = "prev"
+	actionJump     = "jump"
+	actionNext     = "next"
+	actionLast     = "last"
+	actionJumpForm = "jump-form"
+	jumpInputID    = "page"
+)
+
+// Paginator - shows one page at a time with first, previous, next and last buttons, and a page counter that asks for a page to jump to.
+//
+// Set either Pages, or Render and PageCount to build pages on demand.
+type Paginator struct {
+	Pages     []*api.Embed                       // the pages to show, in order
+	Render    func(page int) (*api.Embed, error) // builds the zero-based page on demand; used when Pages is empty
+	PageCount int                                // the number of pages Render can build
+	OwnerID   api.Snowflake                      // the only user allowed to turn pages; defaults to the invoking user for Respond, anyone when empty
+	Timeout   time.Duration                      // how long to wait after the last page turn before disabling the buttons; DefaultTimeout when zero
+	Ephemeral bool                               // whether Respond sends the pages as an ephemeral message
+
+	mu     sync.Mutex // held while a page turn is handled, so concurrent clicks are applied one at a time
+	page   int
+	prefix string
+}
+
+// Send - Posts the first page to the channel and handles page turns until the Paginator times out or the context ends.
+//
+// The buttons are disabled before Send returns.
+func (p *Paginator) Send(ctx context.Context, d *dispatch.Dispatcher, channel *api.Channel) error {
+	if err := p.init(); err != nil {
+		return err
+	}
+
+	embed, err := p.render()
+	if err != nil {
+		return err
+	}
+
+	message, err := channel.CreateMessage(api.CreateMessageJSON{Embeds: []*api.Embed{embed}, Components: p.components()})
+	if err != nil {
+		return err
+	}
+
+	p.run(ctx, d)
+
+	if _, err = collectors.DisableMessageComponents(channel, message); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+	}
+
+	return nil
+}
+
+// Respond - Responds to the interaction with the first page and handles page turns until the Paginator times out or the context ends.
+//
+// Only the invoking user may turn pages unless OwnerID is set. The buttons are disabled before Respond returns.
+func (p *Paginator) Respond(ctx context.Context, d *dispatch.Dispatcher, interaction *api.Interaction) error {
+	if err := p.init(); err != nil {
+		return err
+	}
+	if p.OwnerID == "" {
+		p.OwnerID = interaction.InvokingUser().ID
+	}
+
+	embed, err := p.render()
+	if err != nil {
+		return err
+	}
+
+	data := &api.InteractionCallbackDataMessages{Embeds: []*api.Embed{embed}, Components: p.components()}
+	if p.Ephemeral {
+		data.Flags = api.Ephemeral
+	}
+
+	err = interaction.CreateInteractionResponse(&api.InteractionResponseMessages{Type: api.ChannelMessageWithSource, Data: data})
+	if err != nil {
+		return err
+	}
+
+	p.run(ctx, d)
+
+	// The original response has no channel message ID to edit by, so it is edited through the interaction webhook
+	method, route := interaction.EditOriginalInteractionResponse()
+	resp, err := api.Rest.Request(method, route, api.EditMessageJSON{Components: collectors.DisableComponents(p.components())}, nil)
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		return nil
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Errorln(log.Discord, log.FuncName(), "disabling paginator buttons failed:", resp.Status)
+	}
+
+	return nil
+}
+
+// Page - Returns the zero-based page currently shown
+func (p *Paginator) Page() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.page
+}
+
+func (p *Paginator) init() error {
+	if p.count() == 0 {
+		return ErrNoPages
+	}
+	if p.Timeout <= 0 {
+		p.Timeout = DefaultTimeout
+	}
+
+	nonce := make([]byte, 8)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	p.prefix = "paginator:" + hex.EncodeToString(nonce) + ":"
+	p.page = 0
+
+	return nil
+}
+
+func (p *Paginator) count() int {
+	if len(p.Pages) > 0 {
+		return len(p.Pages)
+	}
+	if p.Render != nil {
+		return p.PageCount
+	}
+
+	return 0
+}
+
+func (p *Paginator) render() (*api.Embed, error) {
+	if len(p.Pages) > 0 {
+		return p.Pages[p.page], nil
+	}
+
+	return p.Render(p.page)
+}
+
+// run - Handles page turns until no interaction arrives within the timeout or the context ends
+func (p *Paginator) run(ctx context.Context, d *dispatch.Dispatcher) {
+	turns := make(chan *api.Interaction, 1)
+	done := make(chan struct{})
+	defer close(done)
+
+	remove := dispatch.On(d, events.InteractionCreate, func(event *interactions.Create) {
+		interaction := (*api.Interaction)(event)
+		if !strings.HasPrefix(interaction.Data.CustomID, p.prefix) {
+			return
+		}
+
+		select {
+		case turns <- interaction:
+		case <-done:
+		}
+	})
+	defer remove()
+
+	timer := time.NewTimer(p.Timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case interaction := <-turns:
+			p.handle(interaction)
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(p.Timeout)
+		case <-timer.C:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *Paginator) handle(interaction *api.Interaction) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.OwnerID != "" && interaction.InvokingUser().ID != p.OwnerID {
+		p.reply(interaction, &api.InteractionResponseMessages{
+			Type: api.ChannelMessageWithSource,
+			Data: &api.InteractionCallbackDataMessages{Content: "Only the person who opened these pages can turn them.", Flags: api.Ephemeral},
+		})
+		return
+	}
+
+	last := p.count() - 1
+	page := p.page
+
+	switch strings.TrimPrefix(interaction.Data.CustomID, p.prefix) {
+	case actionFirst:
+		page = 0
+	case actionPrevious:
+		page--
+	case actionNext:
+		page++
+	case actionLast:
+		page = last
+	case actionJump:
+		p.reply(interaction, p.jumpForm())
+		return
+	case actionJumpForm:
+		if n, err := strconv.Atoi(strings.TrimSpace(submittedValue(interaction.Data.Components, jumpInputID))); err == nil {
+			page = n - 1
+		}
+	default:
+		return
+	}
+
+	page = max(0, min(page, last))
+
+	previous := p.page
+	p.page = page
+
+	embed, err := p.render()
+	if err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		p.page = previous
+		p.reply(interaction, &api.InteractionResponseMessages{Type: api.DeferredUpdateMessage})
+		return
+	}
+
+	p.reply(interaction, &api.InteractionResponseMessages{
+		Type: api.UpdateMessage,
+		Data: &api.InteractionCallbackDataMessages{Embeds: []*api.Embed{embed}, Components: p.components()},
+	})
+}
+
+func (p *Paginator) reply(interaction *api.Interaction, response any) {
+	if err := interaction.CreateInteractionResponse(response); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+	}
+}
+
+// components - Returns the navigation row for the current page
+func (p *Paginator) components() []*api.Component {
+	last := p.count() - 1
+	button := func(action, label string, disabled bool) *api.Component {
+		return &api.Component{
+			Type:     api.ComponentTypeButton,
+			Style:    api.ButtonSecondary,
+			CustomID: p.prefix + action,
+			Label:    label,
+			Disabled: disabled,
+		}
+	}
+
+	counter := button(actionJump, strconv.Itoa(p.page+1)+"/"+strconv.Itoa(last+1), last == 0)
+	counter.Style = api.ButtonPrimary
+
+	return []*api.Component{
+		{
+			Type: api.ComponentTypeActionRow,
+			Components: []*api.Component{
+				button(actionFirst, "<<", p.page == 0),
+				button(actionPrevious, "<", p.page == 0),
+				counter,
+				button(actionNext, ">", p.page == last),
+				button(actionLast, ">>", p.page == last),
+			},
+		},
+	}
+}
+
+// jumpForm - Returns the modal asking which page to jump to
+func (p *Paginator) jumpForm() *api.InteractionResponseModal {
+	return &api.InteractionResponseModal{
+		CallbackType: api.Modal,
+		Data: &api.InteractionCallbackDataModal{
+			CustomID: p.prefix + actionJumpForm,
+			Title:    "Jump to page",
+			Components: []*api.Component{
+				{
+					Type: api.ComponentTypeActionRow,
+					Components: []*api.Component{
+						{
+							Type:        api.ComponentTypeTextInput,
+							CustomID:    jumpInputID,
+							Style:       api.TextInputShort,
+							Label:       "Page (1-" + strconv.Itoa(p.count()) + ")",
+							MinLength:   1,
+							MaxLength:   len(strconv.Itoa(p.count())),
+							Required:    true,
+							Placeholder: strconv.Itoa(p.page + 1),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// submittedValue - Finds the value of a text input in a modal submission
+func submittedValue(components []*api.Component, customID string) string {
+	for _, component := range components {
+		if component.CustomID == customID {
+			return component.Value
+		}
+		if value := submittedValue(component.Components, customID); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,160 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// MaxChoices - Discord shows at most 25 autocomplete choices
+const MaxChoices = 25
+
+// AutocompleteProvider - suggests choices for the option the user is typing in, given what they have typed so far
+type AutocompleteProvider func(interaction *api.Interaction, options Options, partial string) ([]*api.ApplicationCommandOptionChoice, error)
+
+// Autocomplete - Registers the provider for an autocomplete option of a command path, e.g. Autocomplete("tag show", "name", provider)
+//
+// The choices it returns are truncated to MaxChoices before they are sent.
+func (r *Router) Autocomplete(path, option string, provider AutocompleteProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.autocomplete == nil {
+		r.autocomplete = make(map[string]AutocompleteProvider)
+	}
+	r.autocomplete[normalizePath(path)+" "+option] = provider
+}
+
+func (r *Router) handleAutocomplete(interaction *api.Interaction) error {
+	path, options := CommandPath(&interaction.Data)
+
+	focused, ok := options.Focused()
+	if !ok {
+		return ErrNoRoute
+	}
+
+	r.mu.RLock()
+	provider, ok := r.autocomplete[path+" "+focused.Name]
+	r.mu.RUnlock()
+
+	if !ok {
+		return ErrNoRoute
+	}
+
+	partial := ""
+	if focused.Value != nil {
+		partial = fmt.Sprint(focused.Value)
+	}
+
+	choices, err := provider(interaction, options, partial)
+	if err != nil {
+		return err
+	}
+
+	return interaction.CreateInteractionResponse(api.NewAutocompleteResponse().AddChoices(TruncateChoices(choices)))
+}
+
+// TruncateChoices - Returns at most the first MaxChoices choices, never nil so an empty list is sent as []
+func TruncateChoices(choices []*api.ApplicationCommandOptionChoice) []*api.ApplicationCommandOptionChoice {
+	if len(choices) > MaxChoices {
+		return choices[:MaxChoices]
+	}
+	if choices == nil {
+		return []*api.ApplicationCommandOptionChoice{}
+	}
+
+	return choices
+}
+
+// StringChoices - Builds choices whose names and values are the given strings
+func StringChoices(values ...string) []*api.ApplicationCommandOptionChoice {
+	choices := make([]*api.ApplicationCommandOptionChoice, 0, len(values))
+	for _, value := range values {
+		choices = append(choices, &api.ApplicationCommandOptionChoice{Name: value, Value: value})
+	}
+
+	return choices
+}
+
+// FuzzyScore - Scores how well the candidate matches the query, ignoring case; higher is better and ok is false when it does not match.
+//
+// An exact match beats a prefix match, which beats a match at the start of a word, then anywhere in the candidate,
+// then the query's characters appearing in order with gaps.
+func FuzzyScore(query, candidate string) (score int, ok bool) {
+	query = strings.ToLower(strings.TrimSpace(query))
+	candidate = strings.ToLower(candidate)
+
+	switch {
+	case query == "":
+		return 0, true
+	case candidate == query:
+		return 500, true
+	case strings.HasPrefix(candidate, query):
+		return 400, true
+	case strings.Contains(candidate, " "+query) || strings.Contains(candidate, "-"+query) || strings.Contains(candidate, "_"+query):
+		return 300, true
+	case strings.Contains(candidate, query):
+		return 200, true
+	}
+
+	// Subsequence: every rune of the query in order, scoring tighter matches higher
+	gaps, position := 0, 0
+	for _, r := range query {
+		index := strings.IndexRune(candidate[position:], r)
+		if index < 0 {
+			return 0, false
+		}
+		gaps += index
+		position += index + utf8.RuneLen(r)
+	}
+
+	return max(1, 100-gaps), true
+}
+
+// RankChoices - Returns the choices whose names match the partial input, best first and truncated to MaxChoices
+//
+// Choices that score the same keep their order, so pass them pre-sorted (e.g. by popularity) to break ties.
+func RankChoices(partial string, choices []*api.ApplicationCommandOptionChoice) []*api.ApplicationCommandOptionChoice {
+	type ranked struct {
+		choice *api.ApplicationCommandOptionChoice
+		score  int
+	}
+
+	matches := make([]ranked, 0, len(choices))
+	for _, choice := range choices {
+		if score, ok := FuzzyScore(partial, choice.Name); ok {
+			matches = append(matches, ranked{choice: choice, score: score})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	out := make([]*api.ApplicationCommandOptionChoice, 0, min(len(matches), MaxChoices))
+	for _, match := range matches {
+		if len(out) == MaxChoices {
+			break
+		}
+		out = append(out, match.choice)
+	}
+
+	return out
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestRankChoices(t *testing.T) {
+	choices := StringChoices("Mute Role", "Moderator", "Member", "Admin", "role-mute")
+
+	tests := []struct {
+		name    string
+		partial string
+		want    []string
+	}{
+		{
+			name:    "Empty",
+			partial: "",
+			want:    []string{"Mute Role", "Moderator", "Member", "Admin", "role-mute"},
+		},
+		{
+			name:    "Prefix before word before substring",
+			partial: "mute",
+			want:    []string{"Mute Role", "role-mute"},
+		},
+		{
+			name:    "Subsequence",
+			partial: "mdr",
+			want:    []string{"Moderator"},
+		},
+		{
+			name:    "No match",
+			partial: "owner",
+			want:    []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, choice := range RankChoices(tt.partial, choices) {
+				got = append(got, choice.Name)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("RankChoices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankChoicesTruncates(t *testing.T) {
+	choices := make([]*api.ApplicationCommandOptionChoice, 0, 40)
+	for i := 0; i < 40; i++ {
+		choices = append(choices, &api.ApplicationCommandOptionChoice{Name: fmt.Sprintf("item %d", i), Value: i})
+	}
+
+	if got := len(RankChoices("item", choices)); got != MaxChoices {
+		t.Errorf("len(RankChoices()) = %d, want %d", got, MaxChoices)
+	}
+}
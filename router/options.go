@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"fmt"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// Options - the options a user passed to a command, looked up by name
+//
+// Getters return the zero value for options the user left out; use Has to tell those apart.
+type Options struct {
+	values   map[string]*api.ApplicationCommandInteractionDataOption
+	resolved *api.ResolvedData
+}
+
+// NewOptions - Indexes options by name, resolving users, members, channels, roles and attachments from resolved
+func NewOptions(options []*api.ApplicationCommandInteractionDataOption, resolved *api.ResolvedData) Options {
+	values := make(map[string]*api.ApplicationCommandInteractionDataOption, len(options))
+	for _, option := range options {
+		values[option.Name] = option
+	}
+
+	if resolved == nil {
+		resolved = &api.ResolvedData{}
+	}
+
+	return Options{values: values, resolved: resolved}
+}
+
+// Has - Checks whether the user passed the option
+func (o Options) Has(name string) bool {
+	_, ok := o.values[name]
+	return ok
+}
+
+// Get - Returns the option as sent by Discord
+func (o Options) Get(name string) (*api.ApplicationCommandInteractionDataOption, bool) {
+	option, ok := o.values[name]
+	return option, ok
+}
+
+// Focused - Returns the option the user is typing in, for autocomplete interactions
+func (o Options) Focused() (*api.ApplicationCommandInteractionDataOption, bool) {
+	for _, option := range o.values {
+		if option.Focused {
+			return option, true
+		}
+	}
+
+	return nil, false
+}
+
+// String - Returns a STRING option, or any other option's value formatted as a string
+func (o Options) String(name string) string {
+	option, ok := o.values[name]
+	if !ok || option.Value == nil {
+		return ""
+	}
+
+	if s, ok := option.Value.(string); ok {
+		return s
+	}
+
+	return fmt.Sprint(option.Value)
+}
+
+// Int - Returns an INTEGER option
+func (o Options) Int(name string) int64 {
+	return int64(o.Float(name))
+}
+
+// Float - Returns a NUMBER or INTEGER option
+func (o Options) Float(name string) float64 {
+	if option, ok := o.values[name]; ok {
+		if f, ok := option.Value.(float64); ok {
+			return f
+		}
+	}
+
+	return 0
+}
+
+// Bool - Returns a BOOLEAN option
+func (o Options) Bool(name string) bool {
+	if option, ok := o.values[name]; ok {
+		if b, ok := option.Value.(bool); ok {
+			return b
+		}
+	}
+
+	return false
+}
+
+// ID - Returns the ID passed to a USER, CHANNEL, ROLE, MENTIONABLE or ATTACHMENT option
+func (o Options) ID(name string) api.Snowflake {
+	return api.Snowflake(o.String(name))
+}
+
+// User - Returns the user passed to a USER or MENTIONABLE option
+func (o Options) User(name string) *api.User {
+	if user, ok := o.resolved.Users.Get(o.ID(name)); ok {
+		return &user
+	}
+
+	return nil
+}
+
+// Member - Returns the partial member passed to a USER or MENTIONABLE option, when the command was used in a guild
+func (o Options) Member(name string) *api.GuildMember {
+	if member, ok := o.resolved.Members.Get(o.ID(name)); ok {
+		return &member
+	}
+
+	return nil
+}
+
+// Channel - Returns the partial channel passed to a CHANNEL option
+func (o Options) Channel(name string) *api.Channel {
+	if channel, ok := o.resolved.Channels.Get(o.ID(name)); ok {
+		return &channel
+	}
+
+	return nil
+}
+
+// Role - Returns the role passed to a ROLE or MENTIONABLE option
+func (o Options) Role(name string) *api.Role {
+	if role, ok := o.resolved.Roles.Get(o.ID(name)); ok {
+		return &role
+	}
+
+	return nil
+}
+
+// Attachment - Returns the file passed to an ATTACHMENT option
+func (o Options) Attachment(name string) *api.Attachment {
+	if attachment, ok := o.resolved.Attachments.Get(o.ID(name)); ok {
+		return &attachment
+	}
+
+	return nil
+}
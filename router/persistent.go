@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// ErrNoMessage - returned by State methods for interactions that did not come from a component on a message, e.g. modal submits from a command
+var ErrNoMessage = errors.New("the interaction has no message to keep state for")
+
+// StateStore - keeps the state of persistent components per message, e.g. in a database table keyed by message ID
+//
+// Implementations must be safe for concurrent use.
+type StateStore interface {
+	Load(messageID api.Snowflake) ([]byte, error) // returns nil, nil when no state is saved for the message
+	Save(messageID api.Snowflake, state []byte) error
+	Delete(messageID api.Snowflake) error // deleting state that does not exist is not an error
+}
+
+// PersistentHandler - handles a component on any message, including messages sent before the process started
+type PersistentHandler func(interaction *api.Interaction, params Params, state *State) error
+
+// State - the saved state of the message a persistent component is attached to
+type State struct {
+	MessageID api.Snowflake // the message the component is attached to; empty when the interaction has no message
+	Data      []byte        // the saved state; nil when none has been saved
+
+	store StateStore
+}
+
+// Decode - Decodes the saved state into v, reporting whether any was saved
+func (s *State) Decode(v any) (bool, error) {
+	if s.Data == nil {
+		return false, nil
+	}
+
+	return true, json.Unmarshal(s.Data, v)
+}
+
+// Save - Encodes v and saves it as the message's state
+func (s *State) Save(v any) error {
+	if s.MessageID == "" {
+		return ErrNoMessage
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := s.store.Save(s.MessageID, data); err != nil {
+		return err
+	}
+	s.Data = data
+
+	return nil
+}
+
+// Delete - Removes the message's saved state, e.g. once a poll closes
+func (s *State) Delete() error {
+	if s.MessageID == "" {
+		return ErrNoMessage
+	}
+	if err := s.store.Delete(s.MessageID); err != nil {
+		return err
+	}
+	s.Data = nil
+
+	return nil
+}
+
+// Persistent - Registers the handler for message components whose custom_id matches the pattern, passing it the state saved for the message
+//
+// Nothing is kept in memory per message: the handler is found by custom_id and the state is loaded from the Router's Store,
+// so buttons on messages sent before a restart keep working. Patterns work as they do for Component; a prefix is matched with "prefix:*".
+func (r *Router) Persistent(pattern string, handler PersistentHandler) error {
+	return r.Component(pattern, func(interaction *api.Interaction, params Params) error {
+		state := &State{store: r.stateStore()}
+		if interaction.Message != nil {
+			state.MessageID = interaction.Message.ID
+
+			data, err := state.store.Load(state.MessageID)
+			if err != nil {
+				return err
+			}
+			state.Data = data
+		}
+
+		return handler(interaction, params, state)
+	})
+}
+
+// SaveState - Saves the state for a message, e.g. right after sending a message with persistent components
+func (r *Router) SaveState(messageID api.Snowflake, v any) error {
+	return (&State{MessageID: messageID, store: r.stateStore()}).Save(v)
+}
+
+// stateStore returns the Store, falling back to one in memory
+func (r *Router) stateStore() StateStore {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.Store == nil {
+		r.Store = NewMemoryStateStore()
+	}
+
+	return r.Store
+}
+
+// MemoryStateStore - a StateStore that keeps state in memory, losing it when the process exits
+type MemoryStateStore struct {
+	mu     sync.Mutex
+	states map[api.Snowflake][]byte
+}
+
+// NewMemoryStateStore - Creates an empty MemoryStateStore
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{states: make(map[api.Snowflake][]byte)}
+}
+
+// Load - Returns a copy of the state saved for the message
+func (m *MemoryStateStore) Load(messageID api.Snowflake) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if state, ok := m.states[messageID]; ok {
+		return append([]byte(nil), state...), nil
+	}
+
+	return nil, nil
+}
+
+// Save - Saves a copy of the state for the message
+func (m *MemoryStateStore) Save(messageID api.Snowflake, state []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.states[messageID] = append([]byte(nil), state...)
+
+	return nil
+}
+
+// Delete - Removes the state saved for the message
+func (m *MemoryStateStore) Delete(messageID api.Snowflake) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.states, messageID)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestPersistentSurvivesRestart(t *testing.T) {
+	type poll struct {
+		Votes map[string]int `json:"votes"`
+	}
+
+	store := NewMemoryStateStore()
+	before := New()
+	before.Store = store
+	if err := before.SaveState("42", poll{Votes: map[string]int{"yes": 1}}); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
+
+	// A new Router with the same Store stands in for the process after a restart
+	after := New()
+	after.Store = store
+	var failed error
+	after.ErrorHandler = func(_ *api.Interaction, err error) { failed = err }
+	if err := after.Persistent("poll:{choice}", func(_ *api.Interaction, params Params, state *State) error {
+		p := poll{Votes: map[string]int{}}
+		if _, err := state.Decode(&p); err != nil {
+			return err
+		}
+		p.Votes[params["choice"]]++
+		return state.Save(p)
+	}); err != nil {
+		t.Fatalf("Persistent() error = %v", err)
+	}
+
+	interaction := &api.Interaction{Type: api.InteractionTypeMessageComponent, Message: &api.Message{ID: "42"}}
+	interaction.Data.CustomID = "poll:yes"
+	after.Handle(interaction)
+	if failed != nil {
+		t.Fatalf("Handle() error = %v", failed)
+	}
+
+	data, _ := store.Load("42")
+	if string(data) != `{"votes":{"yes":2}}` {
+		t.Errorf("saved state = %s, want yes counted twice", data)
+	}
+
+	interaction.Message = nil
+	after.Handle(interaction)
+	if failed != ErrNoMessage {
+		t.Errorf("Handle() without a message error = %v, want ErrNoMessage", failed)
+	}
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package router sends each incoming Interaction to the handler registered for its command path or custom_id.
+package router
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/customid"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/dispatch"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events"
+	"github.com/veteran-software/discord-api-wrapper/v10/gateway/events/receive/interactions"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// ErrNoRoute - passed to the ErrorHandler when no handler is registered for an Interaction
+var ErrNoRoute = errors.New("no handler is registered for this interaction")
+
+// CommandHandler - handles an application command, with the options of the invoked subcommand
+type CommandHandler func(interaction *api.Interaction, options Options) error
+
+// ComponentHandler - handles a message component or modal submit, with the parameters its custom_id pattern extracted
+type ComponentHandler func(interaction *api.Interaction, params Params) error
+
+// Params - the values of the {name} placeholders in a custom_id pattern
+type Params map[string]string
+
+type customIDRoute struct {
+	pattern *regexp.Regexp
+	decode  func(customID string) (Params, bool, error) // used instead of pattern for routes added with State
+	handler ComponentHandler
+}
+
+// Router - maps interactions to handlers
+//
+// Commands are matched by their full path, e.g. "config settings set" for the set subcommand of the settings group of /config.
+// Autocomplete providers are matched by command path and the focused option.
+// Components and modals are matched by custom_id pattern, in the order they were added.
+type Router struct {
+	// ErrorHandler receives the errors returned by handlers, ErrNoRoute, and panics recovered from handlers as *dispatch.PanicError; errors are logged when it is nil
+	ErrorHandler func(interaction *api.Interaction, err error)
+	// DisableRecovery lets handler panics crash the calling goroutine, e.g. during development
+	DisableRecovery bool
+	// Store keeps the state of components registered with Persistent; state is kept in memory, and lost on restart, when it is nil
+	Store StateStore
+
+	mu           sync.RWMutex
+	commands     map[string]CommandHandler
+	autocomplete map[string]AutocompleteProvider
+	components   []*customIDRoute
+	modals       []*customIDRoute
+}
+
+// New - Creates an empty Router
+func New() *Router {
+	return &Router{commands: make(map[string]CommandHandler)}
+}
+
+// Command - Registers the handler for a command path: the command name followed by any subcommand group and subcommand, separated by spaces
+//
+// User and message commands are registered by their name.
+func (r *Router) Command(path string, handler CommandHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.commands[normalizePath(path)] = handler
+}
+
+// Component - Registers the handler for message components whose custom_id matches the pattern.
+//
+// In a pattern, {name} matches one or more characters other than ':' and is passed to the handler in Params, and * matches anything,
+// so "page:{n}" matches "page:3" with n=3 and "poll:*" matches every custom_id starting with "poll:".
+func (r *Router) Component(pattern string, handler ComponentHandler) error {
+	route, err := compileRoute(pattern, handler)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components = append(r.components, route)
+
+	return nil
+}
+
+// State - Registers the handler for message components whose custom_id was made by the codec with the given name
+//
+// The values packed into the custom_id are passed to the handler in Params. A custom_id with the name whose signature
+// does not match is passed to the ErrorHandler as customid.ErrBadSignature instead of reaching the handler.
+func (r *Router) State(codec *customid.Codec, name string, handler ComponentHandler) {
+	route := &customIDRoute{
+		decode: func(customID string) (Params, bool, error) {
+			if customid.Name(customID) != name {
+				return nil, false, nil
+			}
+
+			_, values, err := codec.Decode(customID)
+			if err != nil {
+				return nil, false, err
+			}
+
+			return values, true, nil
+		},
+		handler: handler,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.components = append(r.components, route)
+}
+
+// Modal - Registers the handler for modal submits whose custom_id matches the pattern; patterns work as they do for Component
+func (r *Router) Modal(pattern string, handler ComponentHandler) error {
+	route, err := compileRoute(pattern, handler)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.modals = append(r.modals, route)
+
+	return nil
+}
+
+// Attach - Routes every InteractionCreate event from the dispatcher, and returns a function that detaches the Router again
+func (r *Router) Attach(d *dispatch.Dispatcher) (detach func()) {
+	return dispatch.On(d, events.InteractionCreate, func(event *interactions.Create) {
+		r.Handle((*api.Interaction)(event))
+	})
+}
+
+// Handle - Runs the handler registered for the Interaction, passing any error it returns or panic it raises to the ErrorHandler
+func (r *Router) Handle(interaction *api.Interaction) {
+	var err error
+
+	recovered := dispatch.Recover(string(events.InteractionCreate), r.DisableRecovery, func() {
+		switch interaction.Type {
+		case api.InteractionTypeApplicationCommand:
+			err = r.handleCommand(interaction)
+		case api.InteractionTypeApplicationCommandAutocomplete:
+			err = r.handleAutocomplete(interaction)
+		case api.InteractionTypeMessageComponent:
+			err = r.handleCustomID(interaction, r.routes(&r.components))
+		case api.InteractionTypeModalSubmit:
+			err = r.handleCustomID(interaction, r.routes(&r.modals))
+		}
+	})
+	if recovered != nil {
+		err = recovered
+	}
+
+	if err != nil {
+		r.handleError(interaction, err)
+	}
+}
+
+func (r *Router) handleCommand(interaction *api.Interaction) error {
+	path, options := CommandPath(&interaction.Data)
+
+	r.mu.RLock()
+	handler, ok := r.commands[path]
+	r.mu.RUnlock()
+
+	if !ok {
+		return ErrNoRoute
+	}
+
+	return handler(interaction, options)
+}
+
+func (r *Router) handleCustomID(interaction *api.Interaction, routes []*customIDRoute) error {
+	for _, route := range routes {
+		if route.decode != nil {
+			params, ok, err := route.decode(interaction.Data.CustomID)
+			if err != nil {
+				return err
+			}
+			if ok {
+				return route.handler(interaction, params)
+			}
+			continue
+		}
+
+		if params, ok := route.match(interaction.Data.CustomID); ok {
+			return route.handler(interaction, params)
+		}
+	}
+
+	return ErrNoRoute
+}
+
+// routes - Returns the routes as they are now, so handlers run without holding the lock
+func (r *Router) routes(routes *[]*customIDRoute) []*customIDRoute {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return *routes
+}
+
+func (r *Router) handleError(interaction *api.Interaction, err error) {
+	if r.ErrorHandler != nil {
+		r.ErrorHandler(interaction, err)
+		return
+	}
+
+	log.Errorln(log.Discord, log.FuncName(), interaction.Type, interaction.Data.Name, interaction.Data.CustomID, err)
+}
+
+// CommandPath - Returns the path of the invoked command, e.g. "config settings set", and the options of the innermost subcommand
+func CommandPath(data *api.ApplicationCommandData) (string, Options) {
+	path := []string{data.Name}
+	options := data.Options
+
+	for len(options) > 0 {
+		option := options[0]
+		if option.Type != api.OptionTypeSubCommandGroup && option.Type != api.OptionTypeSubCommand {
+			break
+		}
+
+		path = append(path, option.Name)
+		options = option.Options
+	}
+
+	return strings.Join(path, " "), NewOptions(options, &data.Resolved)
+}
+
+func normalizePath(path string) string {
+	return strings.Join(strings.Fields(strings.TrimPrefix(path, "/")), " ")
+}
+
+// placeholder - matches the {name} and * parts of a custom_id pattern
+var placeholder = regexp.MustCompile(`\{(\w+)}|\*`)
+
+func compileRoute(pattern string, handler ComponentHandler) (*customIDRoute, error) {
+	var expr strings.Builder
+	expr.WriteString("^")
+
+	last := 0
+	for _, loc := range placeholder.FindAllStringSubmatchIndex(pattern, -1) {
+		expr.WriteString(regexp.QuoteMeta(pattern[last:loc[0]]))
+		if loc[2] >= 0 {
+			expr.WriteString("(?P<" + pattern[loc[2]:loc[3]] + ">[^:]+)")
+		} else {
+			expr.WriteString(".*")
+		}
+		last = loc[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(pattern[last:]))
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &customIDRoute{pattern: re, handler: handler}, nil
+}
+
+func (c *customIDRoute) match(customID string) (Params, bool) {
+	matches := c.pattern.FindStringSubmatch(customID)
+	if matches == nil {
+		return nil, false
+	}
+
+	params := Params{}
+	for i, name := range c.pattern.SubexpNames() {
+		if name != "" {
+			params[name] = matches[i]
+		}
+	}
+
+	return params, true
+}
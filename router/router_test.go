@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package router
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/customid"
+)
+
+func TestCustomIDRouteMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		customID string
+		want     Params
+		wantOK   bool
+	}{
+		{
+			name:     "Exact",
+			pattern:  "confirm",
+			customID: "confirm",
+			want:     Params{},
+			wantOK:   true,
+		},
+		{
+			name:     "Parameter",
+			pattern:  "page:{n}",
+			customID: "page:3",
+			want:     Params{"n": "3"},
+			wantOK:   true,
+		},
+		{
+			name:     "Parameter Stops At Separator",
+			pattern:  "page:{n}",
+			customID: "page:3:extra",
+			wantOK:   false,
+		},
+		{
+			name:     "Prefix",
+			pattern:  "poll:{id}:*",
+			customID: "poll:42:vote:yes",
+			want:     Params{"id": "42"},
+			wantOK:   true,
+		},
+		{
+			name:     "Literal Dots",
+			pattern:  "a.b",
+			customID: "axb",
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route, err := compileRoute(tt.pattern, nil)
+			if err != nil {
+				t.Fatalf("compileRoute() error = %v", err)
+			}
+
+			got, ok := route.match(tt.customID)
+			if ok != tt.wantOK || (ok && !reflect.DeepEqual(got, tt.want)) {
+				t.Errorf("match() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCommandPath(t *testing.T) {
+	data := &api.ApplicationCommandData{
+		Name: "config",
+		Options: []*api.ApplicationCommandInteractionDataOption{
+			{
+				Name: "settings",
+				Type: api.OptionTypeSubCommandGroup,
+				Options: []*api.ApplicationCommandInteractionDataOption{
+					{
+						Name: "set",
+						Type: api.OptionTypeSubCommand,
+						Options: []*api.ApplicationCommandInteractionDataOption{
+							{Name: "key", Type: api.OptionTypeString, Value: "prefix"},
+							{Name: "count", Type: api.OptionTypeInteger, Value: float64(3)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	path, options := CommandPath(data)
+	if path != "config settings set" {
+		t.Errorf("CommandPath() path = %q, want %q", path, "config settings set")
+	}
+	if options.String("key") != "prefix" || options.Int("count") != 3 || options.Has("missing") {
+		t.Errorf("CommandPath() options = %+v", options.values)
+	}
+}
+
+func TestRouterState(t *testing.T) {
+	codec := customid.NewCodec([]byte("secret"))
+
+	r := New()
+	var got Params
+	r.State(codec, "poll", func(_ *api.Interaction, params Params) error {
+		got = params
+		return nil
+	})
+	var failed error
+	r.ErrorHandler = func(_ *api.Interaction, err error) { failed = err }
+
+	customID, err := codec.Encode("poll", map[string]string{"id": "123", "page": "2"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	interaction := &api.Interaction{Type: api.InteractionTypeMessageComponent}
+	interaction.Data.CustomID = customID
+	r.Handle(interaction)
+	if failed != nil || !reflect.DeepEqual(got, Params{"id": "123", "page": "2"}) {
+		t.Errorf("Handle() params = %v, error = %v", got, failed)
+	}
+
+	got = nil
+	interaction.Data.CustomID = "poll?id=124&page=2"
+	r.Handle(interaction)
+	if got != nil || !errors.Is(failed, customid.ErrBadSignature) {
+		t.Errorf("Handle() of a tampered custom_id = %v, error = %v", got, failed)
+	}
+}
@@ -0,0 +1,262 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+const (
+	// DefaultMaxAttempts - how many times a Scheduler tries to send a message when MaxAttempts is not set
+	DefaultMaxAttempts = 5
+	// DefaultRetryDelay - the wait before the first retry when RetryDelay is not set; it doubles with every attempt
+	DefaultRetryDelay = 5 * time.Second
+
+	// idleWait - how often Run checks the Store when nothing is pending, in case another process added jobs
+	idleWait = time.Minute
+
+	createMessage = "https://discord.com/api/v10/channels/%s/messages"
+)
+
+// ErrNotDelivered - Discord answered with a success status but without the created message
+var ErrNotDelivered = errors.New("message was not delivered")
+
+// StatusError - Discord answered a send with an error status, e.g. 403 for missing permissions or 503 during an outage
+type StatusError struct {
+	StatusCode int    // the HTTP status of the response
+	Body       []byte // the error Discord sent, e.g. {"message": "Missing Permissions", "code": 50013}
+}
+
+// Error - implements the error interface
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("sending the message: status %d: %s", e.StatusCode, bytes.TrimSpace(e.Body))
+}
+
+// Scheduler - sends Jobs when they are due, retrying transient failures with exponential backoff
+type Scheduler struct {
+	Store       Store                                // where pending jobs are kept
+	MaxAttempts int                                  // attempts before a job is dropped; DefaultMaxAttempts when zero
+	RetryDelay  time.Duration                        // the wait before the first retry; DefaultRetryDelay when zero
+	IsTransient func(err error) bool                 // decides which failures are retried; network errors, 429 and 5xx responses and api.ErrInvalidRequestLimit when nil
+	OnSent      func(job *Job, message *api.Message) // called after a job's message is sent
+	OnError     func(job *Job, err error)            // called when a job fails for good; failures are logged when nil
+
+	once sync.Once
+	wake chan struct{}
+	send func(job *Job) (*api.Message, error)
+}
+
+// New - Creates a Scheduler backed by the store
+//
+//goland:noinspection GoUnusedExportedFunction
+func New(store Store) *Scheduler {
+	return &Scheduler{Store: store}
+}
+
+// Schedule - Saves a message to be sent to the channel at the given time; times in the past are sent as soon as Run sees them
+func (s *Scheduler) Schedule(channelID api.Snowflake, payload api.CreateMessageJSON, at time.Time) (*Job, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	job := &Job{ID: hex.EncodeToString(id), ChannelID: channelID, Payload: payload, SendAt: at}
+	if err := s.Store.Save(job); err != nil {
+		return nil, err
+	}
+	s.notify()
+
+	return job, nil
+}
+
+// ScheduleIn - Saves a message to be sent to the channel after the delay
+func (s *Scheduler) ScheduleIn(channelID api.Snowflake, payload api.CreateMessageJSON, delay time.Duration) (*Job, error) {
+	return s.Schedule(channelID, payload, time.Now().Add(delay))
+}
+
+// Cancel - Removes a pending job so it is never sent
+func (s *Scheduler) Cancel(id string) error {
+	if err := s.Store.Delete(id); err != nil {
+		return err
+	}
+	s.notify()
+
+	return nil
+}
+
+// Run - Sends due jobs until the context ends, including jobs saved to the Store before the process started
+//
+// Run one Scheduler per Store; jobs are sent one at a time, in the order they are due.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.init()
+
+	for {
+		wait, err := s.sendDue()
+		if err != nil {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-s.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// sendDue - sends every job that is due and returns how long until the next one
+func (s *Scheduler) sendDue() (time.Duration, error) {
+	jobs, err := s.Store.Pending()
+	if err != nil {
+		return 0, err
+	}
+
+	next := idleWait
+	for _, job := range jobs {
+		if wait := time.Until(job.SendAt); wait > 0 {
+			next = min(next, wait)
+			continue
+		}
+
+		if err = s.deliver(job); err != nil {
+			return 0, err
+		}
+		if job.Attempts > 0 {
+			// Rescheduled for a retry
+			next = min(next, time.Until(job.SendAt))
+		}
+	}
+
+	return max(next, 0), nil
+}
+
+// deliver - sends the job, then deletes it or saves it with its retry time; only Store errors are returned
+func (s *Scheduler) deliver(job *Job) error {
+	message, err := s.send(job)
+	if err == nil && (message == nil || message.ID == "") {
+		err = ErrNotDelivered
+	}
+
+	if err == nil {
+		if s.OnSent != nil {
+			s.OnSent(job, message)
+		}
+		return s.Store.Delete(job.ID)
+	}
+
+	job.Attempts++
+	if s.isTransient(err) && job.Attempts < s.maxAttempts() {
+		job.SendAt = time.Now().Add(s.retryDelay() << (job.Attempts - 1))
+		return s.Store.Save(job)
+	}
+
+	if s.OnError != nil {
+		s.OnError(job, err)
+	} else {
+		log.Errorln(log.Discord, log.FuncName(), "dropping scheduled message", job.ID, "for", job.ChannelID, err)
+	}
+
+	return s.Store.Delete(job.ID)
+}
+
+func (s *Scheduler) init() {
+	s.once.Do(func() {
+		s.wake = make(chan struct{}, 1)
+		if s.send == nil {
+			s.send = sendJob
+		}
+	})
+}
+
+// notify - wakes Run so it sees a job that was just added or removed
+func (s *Scheduler) notify() {
+	s.init()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Scheduler) isTransient(err error) bool {
+	if s.IsTransient != nil {
+		return s.IsTransient(err)
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, api.ErrInvalidRequestLimit)
+}
+
+// sendJob - creates the job's message through api.Rest, returning a StatusError when Discord rejects it
+func sendJob(job *Job) (*api.Message, error) {
+	resp, err := api.Rest.Request(http.MethodPost, fmt.Sprintf(createMessage, job.ChannelID.String()), job.Payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	var message *api.Message
+	if err = api.JSONUnmarshal(body, &message); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+func (s *Scheduler) maxAttempts() int {
+	if s.MaxAttempts > 0 {
+		return s.MaxAttempts
+	}
+
+	return DefaultMaxAttempts
+}
+
+func (s *Scheduler) retryDelay() time.Duration {
+	if s.RetryDelay > 0 {
+		return s.RetryDelay
+	}
+
+	return DefaultRetryDelay
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	"github.com/veteran-software/discord-api-wrapper/v10/discordtest"
+)
+
+func TestSchedulerRetriesTransientErrors(t *testing.T) {
+	store := NewMemoryStore()
+
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	sent := make(chan *Job, 1)
+
+	s := &Scheduler{
+		Store:      store,
+		RetryDelay: time.Millisecond,
+		OnSent: func(job *Job, _ *api.Message) {
+			sent <- job
+		},
+		send: func(job *Job) (*api.Message, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls++
+			if calls < 3 {
+				return nil, &StatusError{StatusCode: 503, Body: []byte(`{"message":"Service Unavailable"}`)}
+			}
+			return &api.Message{ID: "1"}, nil
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go func() {
+		_ = s.Run(ctx)
+	}()
+
+	if _, err := s.ScheduleIn("123", api.CreateMessageJSON{Content: "reminder"}, 5*time.Millisecond); err != nil {
+		t.Fatalf("ScheduleIn() error = %v", err)
+	}
+
+	select {
+	case job := <-sent:
+		if job.Attempts != 2 || job.Payload.Content != "reminder" {
+			t.Errorf("OnSent() job = %+v", job)
+		}
+	case <-ctx.Done():
+		t.Fatal("message was never sent")
+	}
+
+	// Give Run a moment to delete the job after OnSent
+	time.Sleep(10 * time.Millisecond)
+	if pending, _ := store.Pending(); len(pending) != 0 {
+		t.Errorf("Pending() = %d jobs, want 0", len(pending))
+	}
+}
+
+func TestSchedulerDropsPermanentErrors(t *testing.T) {
+	store := NewMemoryStore()
+	permanent := errors.New("missing access")
+	failed := make(chan error, 1)
+
+	s := &Scheduler{
+		Store:   store,
+		OnError: func(_ *Job, err error) { failed <- err },
+		send: func(*Job) (*api.Message, error) {
+			return nil, permanent
+		},
+	}
+
+	if _, err := s.Schedule("123", api.CreateMessageJSON{Content: "late"}, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go func() {
+		_ = s.Run(ctx)
+	}()
+
+	select {
+	case err := <-failed:
+		if !errors.Is(err, permanent) {
+			t.Errorf("OnError() err = %v, want %v", err, permanent)
+		}
+	case <-ctx.Done():
+		t.Fatal("OnError was never called")
+	}
+}
+
+func TestSchedulerDoesNotRetryClientErrors(t *testing.T) {
+	store := NewMemoryStore()
+	failed := make(chan *Job, 1)
+
+	var calls int
+	s := &Scheduler{
+		Store:      store,
+		RetryDelay: time.Millisecond,
+		OnError:    func(job *Job, _ error) { failed <- job },
+		send: func(*Job) (*api.Message, error) {
+			calls++
+			return nil, &StatusError{StatusCode: 403, Body: []byte(`{"message":"Missing Permissions","code":50013}`)}
+		},
+	}
+
+	if _, err := s.Schedule("123", api.CreateMessageJSON{Content: "hi"}, time.Now().Add(-time.Hour)); err != nil {
+		t.Fatalf("Schedule() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go func() {
+		_ = s.Run(ctx)
+	}()
+
+	select {
+	case job := <-failed:
+		if job.Attempts != 1 || calls != 1 {
+			t.Errorf("OnError() after %d attempts and %d sends, want 1", job.Attempts, calls)
+		}
+	case <-ctx.Done():
+		t.Fatal("OnError was never called")
+	}
+}
+
+func TestSendJobReportsStatus(t *testing.T) {
+	server := discordtest.Start(t)
+	server.Reply(http.MethodPost, "/channels/{channel.id}/messages", http.StatusOK, map[string]string{"id": "9"})
+	server.Fail(http.MethodPost, "/channels/{channel.id}/messages", 1, discordtest.Error{Status: http.StatusForbidden, Code: 50013, Message: "Missing Permissions"})
+
+	job := &Job{ChannelID: "123", Payload: api.CreateMessageJSON{Content: "hi"}}
+
+	var statusErr *StatusError
+	if _, err := sendJob(job); !errors.As(err, &statusErr) || statusErr.StatusCode != http.StatusForbidden || (&Scheduler{}).isTransient(err) {
+		t.Errorf("sendJob() error = %v, want a permanent 403 StatusError", err)
+	}
+	if message, err := sendJob(job); err != nil || message == nil || message.ID != "9" {
+		t.Errorf("sendJob() = %+v, %v, want message 9", message, err)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package scheduler delivers messages at a later time, keeping pending messages in a Store so they survive restarts.
+package scheduler
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// Job - a message waiting to be sent
+type Job struct {
+	ID        string                `json:"id"`         // unique ID used to cancel the job
+	ChannelID api.Snowflake         `json:"channel_id"` // the channel the message is sent to
+	Payload   api.CreateMessageJSON `json:"payload"`    // the message to send
+	SendAt    time.Time             `json:"send_at"`    // when the message is due
+	Attempts  int                   `json:"attempts"`   // how many times sending has failed
+}
+
+// Store - keeps pending jobs, e.g. in a database table keyed by Job.ID
+//
+// Implementations must be safe for concurrent use.
+type Store interface {
+	Save(job *Job) error      // inserts or replaces the job with the same ID
+	Delete(id string) error   // removes the job; deleting a job that does not exist is not an error
+	Pending() ([]*Job, error) // returns every job that has not been deleted
+}
+
+// MemoryStore - a Store that keeps jobs in memory, losing them when the process exits
+type MemoryStore struct {
+	mu   sync.Mutex
+	jobs map[string]Job
+}
+
+// NewMemoryStore - Creates an empty MemoryStore
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: make(map[string]Job)}
+}
+
+// Save - Inserts or replaces the job with the same ID
+func (m *MemoryStore) Save(job *Job) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.jobs[job.ID] = *job
+
+	return nil
+}
+
+// Delete - Removes the job with the ID
+func (m *MemoryStore) Delete(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.jobs, id)
+
+	return nil
+}
+
+// Pending - Returns copies of every job, earliest first
+func (m *MemoryStore) Pending() ([]*Job, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	jobs := make([]*Job, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		job := job
+		jobs = append(jobs, &job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].SendAt.Before(jobs[j].SendAt)
+	})
+
+	return jobs, nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package webhookevents receives the events Discord delivers over HTTP to an application's Webhook Events URL.
+package webhookevents
+
+import (
+	"encoding/json"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+// PayloadType - whether a webhook event payload is a ping or an event
+type PayloadType int
+
+//goland:noinspection GoUnusedConst
+const (
+	PayloadPing  PayloadType = iota // PING sent by Discord to test the URL
+	PayloadEvent                    // Event; the Event field holds the event
+)
+
+// EventType - the kind of event in an Event
+type EventType string
+
+//goland:noinspection GoUnusedConst
+const (
+	ApplicationAuthorized   EventType = "APPLICATION_AUTHORIZED"   // the app was added to a server or user account
+	ApplicationDeauthorized EventType = "APPLICATION_DEAUTHORIZED" // the app was removed from a user account
+	EntitlementCreate       EventType = "ENTITLEMENT_CREATE"       // an entitlement was created
+	QuestUserEnrollment     EventType = "QUEST_USER_ENROLLMENT"    // a user enrolled in a Quest; not available to third-party apps
+)
+
+// Payload - the outer body of every request sent to the Webhook Events URL
+type Payload struct {
+	Version       int           `json:"version"`         // version scheme for the webhook event; currently always 1
+	ApplicationID api.Snowflake `json:"application_id"`  // ID of your app
+	Type          PayloadType   `json:"type"`            // type of webhook
+	Event         *Event        `json:"event,omitempty"` // event data payload; only sent when Type is PayloadEvent
+}
+
+// Event - an event delivered to the Webhook Events URL
+type Event struct {
+	Type      EventType       `json:"type"`           // event type
+	Timestamp api.Timestamp   `json:"timestamp"`      // when the event occurred
+	Data      json.RawMessage `json:"data,omitempty"` // data for the event; decode it with Decode
+}
+
+// ApplicationAuthorizedEvent - sent when the app is added to a server or user account
+type ApplicationAuthorizedEvent struct {
+	IntegrationType *api.ApplicationIntegrationType `json:"integration_type,omitempty"` // installation context for the authorization
+	User            api.User                        `json:"user"`                       // user who authorized the app
+	Scopes          []string                        `json:"scopes"`                     // list of scopes the user authorized
+	Guild           *api.Guild                      `json:"guild,omitempty"`            // server the app was authorized for, when installed to a server
+}
+
+// ApplicationDeauthorizedEvent - sent when the app is removed from a user account
+type ApplicationDeauthorizedEvent struct {
+	User api.User `json:"user"` // user who deauthorized the app
+}
+
+// EntitlementCreateEvent - sent when an entitlement is created, e.g. a user buys or is granted an SKU
+type EntitlementCreateEvent struct {
+	api.Entitlement
+}
+
+// Decode - Returns the event's data as *ApplicationAuthorizedEvent, *ApplicationDeauthorizedEvent or *EntitlementCreateEvent
+//
+// Events this package has no type for are returned as json.RawMessage.
+func (e *Event) Decode() (any, error) {
+	var v any
+	switch e.Type {
+	case ApplicationAuthorized:
+		v = &ApplicationAuthorizedEvent{}
+	case ApplicationDeauthorized:
+		v = &ApplicationDeauthorizedEvent{}
+	case EntitlementCreate:
+		v = &EntitlementCreateEvent{}
+	default:
+		return e.Data, nil
+	}
+
+	if err := api.Unmarshal(string(e.Type), e.Data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
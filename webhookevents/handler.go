@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhookevents
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"io"
+	"net/http"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+	log "github.com/veteran-software/nowlive-logging"
+)
+
+// maxBodySize - webhook event bodies are small; anything larger is rejected before it is read
+const maxBodySize = 1 << 20
+
+// Verify - Checks the Ed25519 signature Discord sends with every request, returning the body when it is valid
+//
+// The public key is the one shown on the General Information page of the app. Requests that fail must be answered with 401 Unauthorized.
+func Verify(publicKey ed25519.PublicKey, r *http.Request) ([]byte, bool) {
+	signature, err := hex.DecodeString(r.Header.Get("X-Signature-Ed25519"))
+	if err != nil || len(signature) != ed25519.SignatureSize || len(publicKey) != ed25519.PublicKeySize {
+		return nil, false
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		return nil, false
+	}
+
+	var message bytes.Buffer
+	message.WriteString(r.Header.Get("X-Signature-Timestamp"))
+	message.Write(body)
+
+	if !ed25519.Verify(publicKey, message.Bytes(), signature) {
+		return nil, false
+	}
+
+	return body, true
+}
+
+// Handler - an http.Handler for the Webhook Events URL
+//
+// Pings are answered for you. Each event is answered with 204 No Content straight away, as Discord requires,
+// and OnEvent is then called on its own goroutine; decode the event with Event.Decode.
+type Handler struct {
+	PublicKey ed25519.PublicKey                               // the app's public key, used to verify requests
+	OnEvent   func(applicationID api.Snowflake, event *Event) // receives every event
+}
+
+// NewHandler - Creates a Handler from the hex encoded public key shown on the app's General Information page
+//
+//goland:noinspection GoUnusedExportedFunction
+func NewHandler(publicKey string, onEvent func(applicationID api.Snowflake, event *Event)) (*Handler, error) {
+	key, err := hex.DecodeString(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Handler{PublicKey: key, OnEvent: onEvent}, nil
+}
+
+// ServeHTTP - Verifies and acknowledges the request, then passes events to OnEvent
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, ok := Verify(h.PublicKey, r)
+	if !ok {
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload Payload
+	if err := api.JSONUnmarshal(body, &payload); err != nil {
+		log.Errorln(log.Discord, log.FuncName(), err)
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+
+	if payload.Type == PayloadEvent && payload.Event != nil && h.OnEvent != nil {
+		go h.OnEvent(payload.ApplicationID, payload.Event)
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2022-2024. Veteran Software
+ *
+ *  Discord API Wrapper - A custom wrapper for the Discord REST API developed for a proprietary project.
+ *
+ *  This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public
+ *  License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.
+ *
+ *  This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.
+ *
+ *  You should have received a copy of the GNU General Public License along with this program.
+ *  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package webhookevents
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/veteran-software/discord-api-wrapper/v10/api"
+)
+
+func TestHandler(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := make(chan *Event, 1)
+	h := &Handler{PublicKey: publicKey, OnEvent: func(_ api.Snowflake, event *Event) { events <- event }}
+
+	sign := func(body string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+		r.Header.Set("X-Signature-Timestamp", "1700000000")
+		r.Header.Set("X-Signature-Ed25519", hex.EncodeToString(ed25519.Sign(privateKey, []byte("1700000000"+body))))
+		return r
+	}
+
+	tests := []struct {
+		name    string
+		request *http.Request
+		want    int
+	}{
+		{
+			name:    "Ping",
+			request: sign(`{"version":1,"application_id":"1","type":0}`),
+			want:    http.StatusNoContent,
+		},
+		{
+			name: "Bad signature",
+			request: func() *http.Request {
+				r := sign(`{"version":1,"application_id":"1","type":0}`)
+				r.Header.Set("X-Signature-Timestamp", "1700000001")
+				return r
+			}(),
+			want: http.StatusUnauthorized,
+		},
+		{
+			name:    "Event",
+			request: sign(`{"version":1,"application_id":"1","type":1,"event":{"type":"APPLICATION_DEAUTHORIZED","timestamp":"2024-10-18T14:42:53.064834","data":{"user":{"id":"2","username":"Nelly"}}}}`),
+			want:    http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, tt.request)
+			if w.Code != tt.want {
+				t.Errorf("ServeHTTP() status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+
+	select {
+	case event := <-events:
+		decoded, err := event.Decode()
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		if d, ok := decoded.(*ApplicationDeauthorizedEvent); !ok || d.User.Username != "Nelly" {
+			t.Errorf("Decode() = %#v", decoded)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnEvent was not called")
+	}
+}